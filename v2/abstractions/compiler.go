@@ -0,0 +1,35 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package abstractions
+
+// INDIVIDUAL INTERFACES
+
+// This interface defines the methods supported by all executable types that
+// consist of bytecode instructions along with the tables of literal values and
+// symbols that the instructions refer to by (one based) index.
+type Executable interface {
+	GetBytecode() BytecodeLike
+	GetLiterals() ListLike
+	GetSymbols() ListLike
+}
+
+// This interface defines the methods supported by all types that can be
+// represented as a catalog of attributes.
+type Cataloged interface {
+	AsCatalog() CatalogLike
+}
+
+// CONSOLIDATED INTERFACES
+
+type ProgramLike interface {
+	Executable
+	Cataloged
+}
@@ -63,6 +63,31 @@ type Encapsulated interface {
 	ExtractVersion() VersionLike
 }
 
+type Preserved interface {
+	GetTrivia() TriviaLike
+	SetTrivia(trivia TriviaLike)
+}
+
+type SpanLike interface {
+	GetStartLine() int
+	GetStartPosition() int
+	GetStartOffset() int
+	GetEndLine() int
+	GetEndPosition() int
+	GetEndOffset() int
+}
+
+type Spanned interface {
+	GetSpan() SpanLike
+	SetSpan(span SpanLike)
+}
+
+type TriviaLike interface {
+	GetDigest() string
+	GetIndentation() string
+	GetSegments() []string
+}
+
 // CONSOLIDATED INTERFACES
 
 type CommentLike interface {
@@ -71,6 +96,8 @@ type CommentLike interface {
 
 type ComponentLike interface {
 	Encapsulated
+	Preserved
+	Spanned
 }
 
 type ContextLike interface {
@@ -0,0 +1,54 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package abstractions
+
+import (
+	ele "github.com/bali-nebula/go-component-framework/v2/elements"
+)
+
+// TYPE DEFINITIONS
+
+type (
+	Element = ele.Element
+)
+
+// INDIVIDUAL INTERFACES
+
+type (
+	Complex    = ele.Complex
+	Continuous = ele.Continuous
+	Discrete   = ele.Discrete
+	Lexical    = ele.Lexical
+	Matchable  = ele.Matchable
+	Named      = ele.Named
+	Polarized  = ele.Polarized
+	Segmented  = ele.Segmented
+	Temporal   = ele.Temporal
+	Versioned  = ele.Versioned
+)
+
+// CONSOLIDATED INTERFACES
+
+type (
+	AngleLike       = ele.AngleLike
+	BooleanLike     = ele.BooleanLike
+	CharacterLike   = ele.CharacterLike
+	CitationLike    = ele.CitationLike
+	DurationLike    = ele.DurationLike
+	FloatLike       = ele.FloatLike
+	IntegerLike     = ele.IntegerLike
+	MomentLike      = ele.MomentLike
+	NumberLike      = ele.NumberLike
+	PatternLike     = ele.PatternLike
+	PercentageLike  = ele.PercentageLike
+	ProbabilityLike = ele.ProbabilityLike
+	ResourceLike    = ele.ResourceLike
+)
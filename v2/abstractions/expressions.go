@@ -57,6 +57,7 @@ const (
 // INDIVIDUAL INTERFACES
 
 type BinaryOperationLike interface {
+	Spanned
 	GetFirst() Expression
 	SetFirst(first Expression)
 	GetOperator() Operator
@@ -66,6 +67,7 @@ type BinaryOperationLike interface {
 }
 
 type IntrinsicLike interface {
+	Spanned
 	GetFunction() string
 	SetFunction(function string)
 	GetArguments() Sequential[Expression]
@@ -73,6 +75,7 @@ type IntrinsicLike interface {
 }
 
 type InvocationLike interface {
+	Spanned
 	IsSynchronous() bool
 	GetTarget() Expression
 	SetTarget(target Expression)
@@ -85,6 +88,7 @@ type InvocationLike interface {
 }
 
 type SubcomponentLike interface {
+	Spanned
 	GetComposite() Expression
 	SetComposite(composite Expression)
 	GetIndices() Sequential[Expression]
@@ -92,6 +96,7 @@ type SubcomponentLike interface {
 }
 
 type UnaryOperationLike interface {
+	Spanned
 	GetOperator() Operator
 	SetOperator(operator Operator)
 	GetExpression() Expression
@@ -99,11 +104,13 @@ type UnaryOperationLike interface {
 }
 
 type ValueLike interface {
+	Spanned
 	GetComponent() ComponentLike
 	SetComponent(component ComponentLike)
 }
 
 type VariableLike interface {
+	Spanned
 	GetIdentifier() string
 	SetIdentifier(identifier string)
 }
@@ -0,0 +1,41 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package abstractions
+
+// INDIVIDUAL INTERFACES
+
+// This interface defines the methods supported by all environments that
+// delegate the evaluation of expressions requiring resources outside of the
+// environment (e.g. a document repository or a remote component).
+type Delegating interface {
+	Dereference(reference ComponentLike) ComponentLike
+	Invoke(
+		target ComponentLike,
+		method string,
+		arguments Sequential[ComponentLike],
+		isSynchronous bool,
+	) ComponentLike
+}
+
+// This interface defines the methods supported by all environments that
+// maintain the values of named variables.
+type Scoped interface {
+	IsDefined(variable string) bool
+	GetVariable(variable string) ComponentLike
+	SetVariable(variable string, value ComponentLike)
+}
+
+// CONSOLIDATED INTERFACES
+
+type EnvironmentLike interface {
+	Delegating
+	Scoped
+}
@@ -0,0 +1,50 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package abstractions
+
+// INDIVIDUAL INTERFACES
+
+// This interface defines the methods supported by all hosts that provide the
+// compiled methods for the types of components. The method returns nil if the
+// specified method has no compiled program, in which case the invocation of the
+// method is delegated instead.
+type Dispatching interface {
+	LookupMethod(target ComponentLike, method string) ProgramLike
+}
+
+// This interface defines the methods supported by all hosts that exchange
+// messages and events with other components.
+type Messaging interface {
+	AcceptMessage(message ComponentLike)
+	RejectMessage(message ComponentLike)
+	PublishEvent(event ComponentLike)
+	PostMessage(message ComponentLike, bag ComponentLike)
+	RetrieveMessage(bag ComponentLike) ComponentLike
+}
+
+// This interface defines the methods supported by all hosts that manage the
+// drafts and notarized documents in a document repository. The level passed
+// to CheckoutDraft() may be nil.
+type Repository interface {
+	CheckoutDraft(name ComponentLike, level ComponentLike) ComponentLike
+	SaveDraft(draft ComponentLike) ComponentLike
+	DiscardDraft(draft ComponentLike)
+	NotarizeDraft(draft ComponentLike, name ComponentLike)
+}
+
+// CONSOLIDATED INTERFACES
+
+type HostLike interface {
+	Delegating
+	Dispatching
+	Messaging
+	Repository
+}
@@ -20,6 +20,7 @@ type (
 // INDIVIDUAL INTERFACES
 
 type AcceptClauseLike interface {
+	Spanned
 	GetMessage() Expression
 	SetMessage(message Expression)
 }
@@ -39,9 +40,11 @@ type BlockLike interface {
 }
 
 type BreakClauseLike interface {
+	Spanned
 }
 
 type CheckoutClauseLike interface {
+	Spanned
 	GetRecipient() Recipient
 	SetRecipient(recipient Recipient)
 	GetLevel() Expression
@@ -51,19 +54,23 @@ type CheckoutClauseLike interface {
 }
 
 type ContinueClauseLike interface {
+	Spanned
 }
 
 type DiscardClauseLike interface {
+	Spanned
 	GetDocument() Expression
 	SetDocument(document Expression)
 }
 
 type IfClauseLike interface {
+	Spanned
 	GetBlock() BlockLike
 	SetBlock(block BlockLike)
 }
 
 type LetClauseLike interface {
+	Spanned
 	HasRecipient() bool
 	GetRecipient() (Recipient, Operator)
 	SetRecipient(recipient Recipient, operator Operator)
@@ -72,6 +79,7 @@ type LetClauseLike interface {
 }
 
 type NotarizeClauseLike interface {
+	Spanned
 	GetDocument() Expression
 	SetDocument(document Expression)
 	GetName() Expression
@@ -79,6 +87,7 @@ type NotarizeClauseLike interface {
 }
 
 type OnClauseLike interface {
+	Spanned
 	GetFailure() SymbolLike
 	SetFailure(failure SymbolLike)
 	GetBlocks() Sequential[BlockLike]
@@ -86,6 +95,7 @@ type OnClauseLike interface {
 }
 
 type PostClauseLike interface {
+	Spanned
 	GetMessage() Expression
 	SetMessage(message Expression)
 	GetBag() Expression
@@ -93,16 +103,19 @@ type PostClauseLike interface {
 }
 
 type PublishClauseLike interface {
+	Spanned
 	GetEvent() Expression
 	SetEvent(event Expression)
 }
 
 type RejectClauseLike interface {
+	Spanned
 	GetMessage() Expression
 	SetMessage(message Expression)
 }
 
 type RetrieveClauseLike interface {
+	Spanned
 	GetRecipient() Recipient
 	SetRecipient(recipient Recipient)
 	GetBag() Expression
@@ -110,11 +123,13 @@ type RetrieveClauseLike interface {
 }
 
 type ReturnClauseLike interface {
+	Spanned
 	GetResult() Expression
 	SetResult(result Expression)
 }
 
 type SaveClauseLike interface {
+	Spanned
 	GetDocument() Expression
 	SetDocument(document Expression)
 	GetRecipient() Recipient
@@ -122,6 +137,7 @@ type SaveClauseLike interface {
 }
 
 type SelectClauseLike interface {
+	Spanned
 	GetTarget() Expression
 	SetTarget(control Expression)
 	GetBlocks() Sequential[BlockLike]
@@ -129,6 +145,7 @@ type SelectClauseLike interface {
 }
 
 type StatementLike interface {
+	Spanned
 	GetAnnotation() Annotation
 	SetAnnotation(annotation Annotation)
 	GetMainClause() Clause
@@ -140,16 +157,19 @@ type StatementLike interface {
 }
 
 type ThrowClauseLike interface {
+	Spanned
 	GetException() Expression
 	SetException(exception Expression)
 }
 
 type WhileClauseLike interface {
+	Spanned
 	GetBlock() BlockLike
 	SetBlock(block BlockLike)
 }
 
 type WithClauseLike interface {
+	Spanned
 	GetItem() SymbolLike
 	SetItem(item SymbolLike)
 	GetBlock() BlockLike
@@ -0,0 +1,148 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package ansi
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	osx "os"
+	sts "strings"
+)
+
+// FORMATTER INTERFACE
+
+// This type defines the classes of tokens that may be styled by a theme.
+type Class string
+
+// This enumeration defines the class for each kind of token.
+const (
+	ClassComment    Class = "comment"
+	ClassElement    Class = "element"
+	ClassIdentifier Class = "identifier"
+	ClassIntrinsic  Class = "intrinsic"
+	ClassKeyword    Class = "keyword"
+	ClassNote       Class = "note"
+	ClassString     Class = "string"
+	ClassSymbol     Class = "symbol"
+)
+
+// This type defines a mapping from each class of token to the ANSI terminal
+// escape sequence used to style it. A class that is missing from a theme is
+// not styled.
+type Theme map[Class]string
+
+// This theme uses the 256 color ANSI terminal escape sequences.
+var Theme256 = Theme{
+	ClassComment:    "\033[3;38;5;244m", // Italic gray.
+	ClassElement:    "\033[38;5;141m",   // Purple.
+	ClassIdentifier: "\033[38;5;252m",   // Light gray.
+	ClassIntrinsic:  "\033[38;5;81m",    // Cyan.
+	ClassKeyword:    "\033[1;38;5;204m", // Bold pink.
+	ClassNote:       "\033[38;5;244m",   // Gray.
+	ClassString:     "\033[38;5;114m",   // Green.
+	ClassSymbol:     "\033[38;5;215m",   // Orange.
+}
+
+// This theme does not style anything. It is used when the output is not a
+// color terminal.
+var NoColor = Theme{}
+
+// This function returns the theme that is appropriate for the current
+// terminal. It returns NoColor if the NO_COLOR environment variable is set or
+// the terminal is dumb, and Theme256 otherwise.
+func DefaultTheme() Theme {
+	var _, noColor = osx.LookupEnv("NO_COLOR")
+	if noColor || osx.Getenv("TERM") == "dumb" {
+		return NoColor
+	}
+	return Theme256
+}
+
+// This function returns the class of the specified token type. It returns an
+// empty class for delimiters and other tokens that are never styled.
+func ClassOf(type_ bal.TokenType) Class {
+	switch type_ {
+	case bal.TokenCOMMENT:
+		return ClassComment
+	case bal.TokenANGLE, bal.TokenBOOLEAN, bal.TokenDURATION, bal.TokenMOMENT,
+		bal.TokenNUMBER, bal.TokenPATTERN, bal.TokenPERCENTAGE,
+		bal.TokenPROBABILITY, bal.TokenRESOURCE:
+		return ClassElement
+	case bal.TokenIDENTIFIER:
+		return ClassIdentifier
+	case bal.TokenINTRINSIC:
+		return ClassIntrinsic
+	case bal.TokenKEYWORD:
+		return ClassKeyword
+	case bal.TokenNOTE:
+		return ClassNote
+	case bal.TokenBINARY, bal.TokenBYTECODE, bal.TokenNAME, bal.TokenNARRATIVE,
+		bal.TokenQUOTE, bal.TokenTAG, bal.TokenVERSION:
+		return ClassString
+	case bal.TokenSYMBOL:
+		return ClassSymbol
+	default:
+		return ""
+	}
+}
+
+// This function returns the canonical BDN string for the specified component
+// styled using the specified theme.
+func FormatComponent(component abs.ComponentLike, theme Theme) string {
+	return Highlight(bal.FormatComponent(component), theme)
+}
+
+// This function returns the canonical BDN bytes for the specified component
+// styled using the specified theme and including the POSIX standard trailing
+// EOL.
+func FormatDocument(component abs.ComponentLike, theme Theme) []byte {
+	var document = bal.FormatDocument(component)
+	return []byte(Highlight(string(document), theme))
+}
+
+// This function returns the specified BDN source string with each of its
+// tokens styled using the specified theme. Each styled line is reset before
+// its EOL so that the output may be paged safely. Any source following an
+// invalid token is returned unstyled.
+func Highlight(source string, theme Theme) string {
+	var builder sts.Builder
+	bal.WalkTokens(source, func(text string, token *bal.Token) {
+		if token == nil {
+			builder.WriteString(text)
+			return
+		}
+		builder.WriteString(styleLines(text, theme[ClassOf(token.Type)]))
+	})
+	return builder.String()
+}
+
+// FORMATTER IMPLEMENTATION
+
+// This constant defines the ANSI terminal escape sequence that resets all
+// styling.
+const reset = "\033[0m"
+
+// PRIVATE FUNCTIONS
+
+// This function returns the specified text with each of its lines wrapped in
+// the specified style. An empty style leaves the text unchanged.
+func styleLines(text string, style string) string {
+	if style == "" {
+		return text
+	}
+	var lines = sts.Split(text, bal.EOL)
+	for index, line := range lines {
+		if len(line) > 0 {
+			lines[index] = style + line + reset
+		}
+	}
+	return sts.Join(lines, bal.EOL)
+}
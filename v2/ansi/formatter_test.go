@@ -0,0 +1,77 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package ansi_test
+
+import (
+	ans "github.com/bali-nebula/go-component-framework/v2/ansi"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	reg "regexp"
+	sts "strings"
+	tes "testing"
+)
+
+const testDirectory = "../bali/test/"
+
+var escapes = reg.MustCompile("\033\\[[0-9;]*m")
+
+func TestANSIDocuments(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ../bali/test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var source, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(source)
+			ass.Equal(t, string(source), string(ans.FormatDocument(component, ans.NoColor)), filename)
+			var colored = string(ans.FormatDocument(component, ans.Theme256))
+			ass.Contains(t, colored, "\033[", filename)
+			ass.Equal(t, string(source), escapes.ReplaceAllString(colored, ""), filename)
+		}
+	}
+}
+
+func TestANSIHighlighting(t *tes.T) {
+	var component = bal.ParseComponent(`{
+    with each $index in [1..5] do {
+        checkout $draft from /bali/tests/Procedures
+    }
+}`)
+	var theme = ans.Theme256
+	var style = func(class ans.Class, text string) string {
+		return theme[class] + text + "\033[0m"
+	}
+	var colored = ans.FormatComponent(component, theme)
+	ass.Contains(t, colored, style(ans.ClassKeyword, "with")+" "+style(ans.ClassKeyword, "each"))
+	ass.Contains(t, colored, style(ans.ClassSymbol, "$index"))
+	ass.Contains(t, colored, style(ans.ClassKeyword, "checkout"))
+	ass.Contains(t, colored, style(ans.ClassString, "/bali/tests/Procedures"))
+
+	component = bal.ParseComponent(`[
+    $count: 2
+    $summary: ">
+        First line.
+        Second line.
+    <"
+]  ! A note.`)
+	colored = ans.FormatComponent(component, theme)
+	ass.Contains(t, colored, style(ans.ClassString, `">`)+"\n")
+	ass.Contains(t, colored, style(ans.ClassString, "        First line.")+"\n")
+	ass.Contains(t, colored, style(ans.ClassNote, "! A note."))
+	ass.Equal(t, bal.FormatComponent(component), escapes.ReplaceAllString(colored, ""))
+
+	ass.Equal(t, ans.ClassElement, ans.ClassOf(bal.TokenMOMENT))
+	ass.Equal(t, ans.Class(""), ans.ClassOf(bal.TokenDELIMITER))
+}
@@ -125,12 +125,11 @@ func (v *parser) parseAssociation() (abs.AssociationLike, *Token, bool) {
 	// This must be an association.
 	value, token, ok = v.parseComponent()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("value",
+		var err = v.parseError(token, "value",
 			"$association",
 			"$key",
 			"$value")
-		panic(message)
+		panic(err)
 	}
 	var association = col.Association(key, value)
 	return association, token, true
@@ -171,13 +170,12 @@ func (v *parser) parseCollection() (abs.Collection, *Token, bool) {
 	}
 	_, token, ok = v.parseDelimiter("]")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("]",
+		var err = v.parseError(token, "]",
 			"$collection",
 			"$associations",
 			"$association",
 		)
-		panic(message)
+		panic(err)
 	}
 	return collection, token, true
 }
@@ -206,13 +204,12 @@ func (v *parser) parseInlineAssociations() (abs.AssociationsLike, *Token, bool)
 		}
 		association, token, ok = v.parseAssociation()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("association",
+			var err = v.parseError(token, "association",
 				"$collection",
 				"$associations",
 				"$association",
 			)
-			panic(message)
+			panic(err)
 		}
 	}
 }
@@ -240,13 +237,12 @@ func (v *parser) parseInlineValues() (abs.ValuesLike, *Token, bool) {
 		}
 		value, token, ok = v.parseComponent()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("value",
+			var err = v.parseError(token, "value",
 				"$collection",
 				"$values",
 				"$value",
 			)
-			panic(message)
+			panic(err)
 		}
 	}
 }
@@ -283,14 +279,23 @@ func (v *parser) parseAssociations() (abs.AssociationsLike, *Token, bool) {
 // This method adds the canonical format for the specified collection to the
 // state of the formatter.
 func (v *formatter) formatAssociations(associations abs.AssociationsLike) {
+	var size = associations.GetSize()
+	var inline = v.isInline(size == 1, func(trial *formatter) {
+		trial.formatAssociations(associations)
+	})
 	v.AppendString("[")
 	var iterator = col.AssociationIterator(associations)
-	switch associations.GetSize() {
-	case 0:
+	switch {
+	case size == 0:
 		v.AppendString(":")
-	case 1:
+	case inline:
 		var association = iterator.GetNext()
 		v.formatAssociation(association)
+		for iterator.HasNext() {
+			v.AppendString(", ")
+			association = iterator.GetNext()
+			v.formatAssociation(association)
+		}
 	default:
 		v.depth++
 		for iterator.HasNext() {
@@ -309,47 +314,46 @@ func (v *formatter) formatAssociations(associations abs.AssociationsLike) {
 // was successfully parsed.
 func (v *parser) parseMultilineAssociations() (abs.AssociationsLike, *Token, bool) {
 	var ok bool
+	var found bool
 	var token *Token
 	var association abs.AssociationLike
 	var associations = col.Catalog()
-
-	association, token, ok = v.parseAssociation()
-	if !ok {
-		// This is not a multiline associations.
-		return associations, token, false
-	}
-	// Every association must be followed by an EOL.
-	_, token, ok = v.parseEOL()
-	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("EOL",
-			"$collection",
-			"$associations",
-			"$association",
-		)
-		panic(message)
-	}
-	for {
-		var key = association.GetKey()
-		var value = association.GetValue()
-		associations.SetValue(key, value)
+	var parseAssociation = func() {
 		association, token, ok = v.parseAssociation()
 		if !ok {
 			// No more associations.
-			return associations, token, true
+			return
 		}
 		// Every association must be followed by an EOL.
 		_, token, ok = v.parseEOL()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("EOL",
+			var err = v.parseError(token, "EOL",
 				"$collection",
 				"$associations",
 				"$association",
 			)
-			panic(message)
+			panic(err)
 		}
 	}
+	for {
+		if v.recoverItem("", parseAssociation) {
+			// The invalid association was skipped.
+			found = true
+			continue
+		}
+		if !ok {
+			break
+		}
+		found = true
+		var key = association.GetKey()
+		var value = association.GetValue()
+		associations.SetValue(key, value)
+	}
+	if !found {
+		// This is not a multiline associations.
+		return associations, token, false
+	}
+	return associations, token, true
 }
 
 // This method attempts to parse a values collection with multiline values.
@@ -357,45 +361,44 @@ func (v *parser) parseMultilineAssociations() (abs.AssociationsLike, *Token, boo
 // successfully parsed.
 func (v *parser) parseMultilineValues() (abs.ValuesLike, *Token, bool) {
 	var ok bool
+	var found bool
 	var token *Token
 	var value abs.ComponentLike
 	var values = col.List()
-
-	value, token, ok = v.parseComponent()
-	if !ok {
-		// This is not a multiline values.
-		return values, token, false
-	}
-	// Every value must be followed by an EOL.
-	_, token, ok = v.parseEOL()
-	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("EOL",
-			"$collection",
-			"$values",
-			"$value",
-		)
-		panic(message)
-	}
-	for {
-		values.AddValue(value)
+	var parseValue = func() {
 		value, token, ok = v.parseComponent()
 		if !ok {
 			// No more values.
-			return values, token, true
+			return
 		}
 		// Every value must be followed by an EOL.
 		_, token, ok = v.parseEOL()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("EOL",
+			var err = v.parseError(token, "EOL",
 				"$collection",
 				"$values",
 				"$value",
 			)
-			panic(message)
+			panic(err)
+		}
+	}
+	for {
+		if v.recoverItem("", parseValue) {
+			// The invalid value was skipped.
+			found = true
+			continue
+		}
+		if !ok {
+			break
 		}
+		found = true
+		values.AddValue(value)
 	}
+	if !found {
+		// This is not a multiline values.
+		return values, token, false
+	}
+	return values, token, true
 }
 
 // This method attempts to parse a primitive. It returns the primitive and
@@ -494,14 +497,23 @@ func (v *parser) parseValues() (abs.ValuesLike, *Token, bool) {
 // This method adds the canonical format for the specified collection to the
 // state of the formatter.
 func (v *formatter) formatValues(values abs.ValuesLike) {
+	var size = values.GetSize()
+	var inline = v.isInline(size == 1, func(trial *formatter) {
+		trial.formatValues(values)
+	})
 	v.AppendString("[")
 	var iterator = com.ComponentIterator(values)
-	switch values.GetSize() {
-	case 0:
+	switch {
+	case size == 0:
 		v.AppendString(" ")
-	case 1:
+	case inline:
 		var value = iterator.GetNext()
 		v.formatComponent(value)
+		for iterator.HasNext() {
+			v.AppendString(", ")
+			value = iterator.GetNext()
+			v.formatComponent(value)
+		}
 	default:
 		v.depth++
 		for iterator.HasNext() {
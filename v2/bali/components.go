@@ -11,6 +11,7 @@
 package bali
 
 import (
+	sha "crypto/sha512"
 	fmt "fmt"
 	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
 	col "github.com/bali-nebula/go-component-framework/v2/collections"
@@ -64,6 +65,75 @@ func Context(value abs.Value) abs.ContextLike {
 	return context
 }
 
+// PUBLIC FUNCTIONS
+
+// This function returns the name of the type of the specified entity (e.g.
+// "Moment" or "Set"). It is used by any agent that must treat each type of
+// entity differently since a type switch CANNOT distinguish between abstract
+// "Like" types that support overlapping method sets unless the cases are
+// checked in the right order. It panics if the entity is not a valid entity.
+func GetType(entity abs.Entity) string {
+	switch value := entity.(type) {
+	// The order of these cases is very important since Go only compares the
+	// set of methods supported by each interface. An interface that is a subset
+	// of another interface must be checked AFTER that interface.
+	case abs.BinaryLike:
+		return "Binary"
+	case abs.BytecodeLike:
+		return "Bytecode"
+	case abs.NameLike:
+		return "Name"
+	case abs.NarrativeLike:
+		return "Narrative"
+	case abs.QuoteLike:
+		return "Quote"
+	case abs.VersionLike:
+		return "Version"
+	case abs.DurationLike:
+		return "Duration"
+	case abs.MomentLike:
+		return "Moment"
+	case abs.NumberLike:
+		return "Number"
+	case abs.PercentageLike:
+		return "Percentage"
+	case abs.ProbabilityLike:
+		return "Probability"
+	case abs.AngleLike:
+		return "Angle"
+	case abs.BooleanLike:
+		return "Boolean"
+	case abs.PatternLike:
+		return "Pattern"
+	case abs.ResourceLike:
+		return "Resource"
+	case abs.TagLike:
+		return "Tag"
+	case abs.SymbolLike:
+		return "Symbol"
+	case abs.QueueLike:
+		return "Queue"
+	case abs.SetLike:
+		return "Set"
+	case abs.StackLike:
+		return "Stack"
+	case abs.ValuesLike:
+		return "List"
+	case abs.AssociationsLike:
+		return "Catalog"
+	case abs.IntervalLike:
+		return "Interval"
+	case abs.SpectrumLike:
+		return "Spectrum"
+	case abs.ContinuumLike:
+		return "Continuum"
+	case abs.ProcedureLike:
+		return "Procedure"
+	default:
+		panic(fmt.Sprintf("An invalid entity (of type %T) was found: %v", value, value))
+	}
+}
+
 // PRIVATE METHODS
 
 // This method attempts to parse annotation. It returns the annotation and
@@ -131,6 +201,7 @@ func (v *parser) parseComponent() (abs.ComponentLike, *Token, bool) {
 	var component abs.ComponentLike
 	var context abs.ContextLike
 	var note abs.NoteLike
+	var first = v.peekToken()
 	var entity, token, ok = v.parseEntity()
 	if !ok {
 		return component, token, false
@@ -140,19 +211,38 @@ func (v *parser) parseComponent() (abs.ComponentLike, *Token, bool) {
 	note, token, _ = v.parseNote()         // The note is optional.
 	component = com.ComponentWithContext(entity, context)
 	component.SetNote(note)
+	component.SetSpan(v.makeSpan(first))
+	if v.lossless {
+		component.SetTrivia(v.makeTrivia(component))
+	}
 	return component, token, true
 }
 
 // This method adds the canonical format for the specified component to the
 // state of the formatter.
 func (v *formatter) formatComponent(component abs.ComponentLike) {
+	switch {
+	case v.shaping:
+		// A nested component is cut out of the shape of its parent.
+		v.nested = append(v.nested, component)
+		v.AppendString(placeholder)
+		return
+	case v.lossless && v.appendOriginal(component):
+		return
+	}
+	v.formatEncapsulated(component)
+}
+
+// This method adds the canonical format for the entity, context and note of the
+// specified component to the state of the formatter.
+func (v *formatter) formatEncapsulated(component abs.ComponentLike) {
 	var entity = component.GetEntity()
 	var context = adjustContext(component)
 	v.formatEntity(entity)
 	if component.IsParameterized() {
 		v.formatContext(context)
 	}
-	if component.IsAnnotated() {
+	if component.IsAnnotated() && !v.compact {
 		v.AppendString("  ")
 		var note = component.GetNote()
 		v.formatNote(note)
@@ -181,20 +271,18 @@ func (v *parser) parseContext() (abs.ContextLike, *Token, bool) {
 	} else {
 		context, token, ok = v.parseMultilineParameters()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("parameter",
+			var err = v.parseError(token, "parameter",
 				"$context",
 				"$parameters")
-			panic(message)
+			panic(err)
 		}
 	}
 	_, token, ok = v.parseDelimiter(")")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar(")",
+		var err = v.parseError(token, ")",
 			"$context",
 			"$parameters")
-		panic(message)
+		panic(err)
 	}
 	return context, token, true
 }
@@ -202,12 +290,20 @@ func (v *parser) parseContext() (abs.ContextLike, *Token, bool) {
 // This method adds the canonical format for the specified context to the
 // state of the formatter.
 func (v *formatter) formatContext(context abs.ContextLike) {
+	var inline = v.isInline(context.GetSize() == 1, func(trial *formatter) {
+		trial.formatContext(context)
+	})
 	v.AppendString("(")
 	var iterator = com.ParameterIterator(context)
-	switch context.GetSize() {
-	case 1:
+	switch {
+	case inline:
 		var parameter = iterator.GetNext()
 		v.formatParameter(parameter)
+		for iterator.HasNext() {
+			v.AppendString(", ")
+			parameter = iterator.GetNext()
+			v.formatParameter(parameter)
+		}
 	default:
 		v.depth++
 		for iterator.HasNext() {
@@ -357,47 +453,50 @@ func (v *formatter) formatIdentifier(identifier string) {
 // was successfully parsed.
 func (v *parser) parseInlineParameters() (abs.ContextLike, *Token, bool) {
 	var ok bool
+	var first = true
 	var token *Token
 	var parameter abs.ParameterLike
 	var context = com.Context()
 	_, token, ok = v.parseDelimiter(":")
 	if ok {
 		// A context must have at least one parameter.
-		var message = v.formatError(token)
-		message += generateGrammar("parameter",
+		var err = v.parseError(token, "parameter",
 			"$context",
 			"$parameters",
 			"$parameter",
 			"$symbol",
 			"$value")
-		panic(message)
+		panic(err)
 	}
-	parameter, token, ok = v.parseParameter()
-	if !ok {
-		// This is not an inline context.
-		return context, token, false
+	var parseParameter = func() {
+		parameter, token, ok = v.parseParameter()
+		if !ok && !first {
+			var err = v.parseError(token, "parameter",
+				"$context",
+				"$parameters",
+				"$parameter",
+				"$symbol",
+				"$value")
+			panic(err)
+		}
 	}
 	for {
-		var key = parameter.GetKey()
-		var value = parameter.GetValue()
-		context.SetValue(key, value)
+		if !v.recoverItem(",", parseParameter) {
+			if !ok {
+				// This is not an inline context.
+				return context, token, false
+			}
+			var key = parameter.GetKey()
+			var value = parameter.GetValue()
+			context.SetValue(key, value)
+		}
+		first = false
 		// Every subsequent parameter must be preceded by a ','.
 		_, token, ok = v.parseDelimiter(",")
 		if !ok {
 			// No more parameters.
 			break
 		}
-		parameter, token, ok = v.parseParameter()
-		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("parameter",
-				"$context",
-				"$parameters",
-				"$parameter",
-				"$symbol",
-				"$value")
-			panic(message)
-		}
 	}
 	return context, token, true
 }
@@ -418,39 +517,48 @@ func (v *parser) parseKeyword(keyword string) (string, *Token, bool) {
 // was successfully parsed.
 func (v *parser) parseMultilineParameters() (abs.ContextLike, *Token, bool) {
 	var ok bool
+	var found bool
 	var token *Token
 	var parameter abs.ParameterLike
 	var context = com.Context()
-	parameter, token, ok = v.parseParameter()
-	if !ok {
-		// A context must have at least one parameter.
-		var message = v.formatError(token)
-		message += generateGrammar("parameter",
-			"$context",
-			"$parameters",
-			"$parameter",
-			"$symbol",
-			"$value")
-		panic(message)
-	}
-	for {
-		var key = parameter.GetKey()
-		var value = parameter.GetValue()
-		context.SetValue(key, value)
+	var parseParameter = func() {
+		parameter, token, ok = v.parseParameter()
+		if !ok {
+			if !found {
+				// A context must have at least one parameter.
+				var err = v.parseError(token, "parameter",
+					"$context",
+					"$parameters",
+					"$parameter",
+					"$symbol",
+					"$value")
+				panic(err)
+			}
+			// No more parameters.
+			return
+		}
 		// Every parameter must be followed by an EOL.
 		_, token, ok = v.parseEOL()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("EOL",
+			var err = v.parseError(token, "EOL",
 				"$context",
 				"$parameters")
-			panic(message)
+			panic(err)
+		}
+	}
+	for {
+		if v.recoverItem("", parseParameter) {
+			// The invalid parameter was skipped.
+			found = true
+			continue
 		}
-		parameter, token, ok = v.parseParameter()
 		if !ok {
-			// No more parameters.
 			break
 		}
+		found = true
+		var key = parameter.GetKey()
+		var value = parameter.GetValue()
+		context.SetValue(key, value)
 	}
 	return context, token, true
 }
@@ -473,6 +581,10 @@ func (v *parser) parseNote() (abs.NoteLike, *Token, bool) {
 func (v *formatter) formatNote(note abs.NoteLike) {
 	v.AppendString("! ")
 	v.AppendString(string(note.AsArray()))
+	if v.inline {
+		// A note extends to the end of its line so it cannot be inlined.
+		v.AppendString(EOL)
+	}
 }
 
 // This method attempts to parse a parameter containing a symbol and value. It
@@ -497,7 +609,10 @@ func (v *parser) parseParameter() (abs.ParameterLike, *Token, bool) {
 	// This must be a parameter.
 	value, token, ok = v.parseComponent()
 	if !ok {
-		panic("Expected a value after the ':' character.")
+		var err = v.parseError(token, "value",
+			"$parameter",
+			"$value")
+		panic(err)
 	}
 	var parameter = com.Parameter(symbol, value)
 	return parameter, token, true
@@ -516,6 +631,25 @@ func (v *formatter) formatParameter(parameter abs.ParameterLike) {
 
 // PRIVATE FUNCTIONS
 
+// This constant defines the placeholder for each nested component in the shape
+// of its parent. It cannot appear in a canonical BDN string.
+const placeholder = "\x00"
+
+// This function returns the SHA-512 digest of the specified shape.
+func digestShape(shape string) string {
+	var sum = sha.Sum512([]byte(shape))
+	return string(sum[:])
+}
+
+// This function returns the shape of the specified component, which is its
+// canonical form with each nested component replaced by a placeholder, along
+// with its nested components in the order in which they are formatted.
+func shapeComponent(component abs.ComponentLike) (string, []abs.ComponentLike) {
+	var v = &formatter{inline: true, shaping: true}
+	v.formatEncapsulated(component)
+	return v.GetResult(), v.nested
+}
+
 // This function removes the first and last line delimiters (shown as "xx")
 // and the indentation from each line of the specified multi-line string.
 //
@@ -575,7 +709,7 @@ func adjustEntity(entity abs.Entity, context abs.ContextLike) abs.Entity {
 		var iterator = com.ParameterIterator(context)
 		for iterator.HasNext() {
 			var parameter = iterator.GetNext()
-			if parameter.GetKey().AsString() == "$type" {
+			if parameter.GetKey().AsString() == "type" {
 				var component = parameter.GetValue()
 				var name = component.ExtractName()
 				type_ = name.AsString()
@@ -634,8 +768,10 @@ func adjustContext(component abs.ComponentLike) abs.ContextLike {
 		}
 		component.SetContext(context)
 		var symbol = Symbol("$type")
-		var value = Component(type_)
-		context.SetValue(symbol, value)
+		var value = context.GetValue(symbol)
+		if value == nil || FormatEntity(value.GetEntity()) != type_ {
+			context.SetValue(symbol, Component(type_))
+		}
 	}
 	return context
 }
@@ -29,3 +29,26 @@ func TestRoundtripWithComponents(t *tes.T) {
 		ass.Equal(t, componentStrings[index], s)
 	}
 }
+
+func TestGetType(t *tes.T) {
+	var types = map[string]string{
+		`~π`:           "Angle",
+		`~P1D`:         "Duration",
+		`<2024-03-31>`: "Moment",
+		`5`:            "Number",
+		`50%`:          "Percentage",
+		`"Hello"`:      "Quote",
+		`$symbol`:      "Symbol",
+		`v1.2`:         "Version",
+		`[1, 2]`:       "List",
+		`[:]`:          "Catalog",
+		`[1..5]`:       "Interval",
+		`[1, 2]($type: /bali/types/collections/Set/v1)`: "Set",
+		`{return 5}`: "Procedure",
+	}
+	for source, expected := range types {
+		var component = bal.ParseComponent(source)
+		ass.Equal(t, expected, bal.GetType(component.GetEntity()), source)
+	}
+	ass.Panics(t, func() { bal.GetType(5) })
+}
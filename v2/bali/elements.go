@@ -14,12 +14,9 @@ import (
 	fmt "fmt"
 	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
 	ele "github.com/bali-nebula/go-component-framework/v2/elements"
-	mat "math"
-	cmp "math/cmplx"
+	uti "github.com/bali-nebula/go-component-framework/v2/utilities"
 	uri "net/url"
-	stc "strconv"
-	sts "strings"
-	tim "time"
+	reg "regexp"
 )
 
 // UNIVERSAL CONSTRUCTORS
@@ -30,31 +27,31 @@ func Angle(value abs.Value) abs.AngleLike {
 	var angle abs.AngleLike
 	switch actual := value.(type) {
 	case uint:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case uint8:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case uint16:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case uint32:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case uint64:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case int:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case int8:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case int16:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case int32:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case int64:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case float32:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case float64:
-		angle = ele.AngleFromFloat(float64(actual))
+		angle = ele.Angle().FromFloat(float64(actual))
 	case string:
-		angle = ele.AngleFromString(actual)
+		angle = ele.Angle().FromString(actual)
 	case abs.AngleLike:
 		angle = actual
 	case abs.ComponentLike:
@@ -72,9 +69,9 @@ func Boolean(value abs.Value) abs.BooleanLike {
 	var boolean abs.BooleanLike
 	switch actual := value.(type) {
 	case bool:
-		boolean = ele.BooleanFromBoolean(actual)
+		boolean = ele.Boolean().FromBoolean(actual)
 	case string:
-		boolean = ele.BooleanFromString(actual)
+		boolean = ele.Boolean().FromString(actual)
 	case abs.BooleanLike:
 		boolean = actual
 	case abs.ComponentLike:
@@ -92,27 +89,27 @@ func Duration(value abs.Value) abs.DurationLike {
 	var duration abs.DurationLike
 	switch actual := value.(type) {
 	case uint:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case uint8:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case uint16:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case uint32:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case uint64:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case int:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case int8:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case int16:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case int32:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case int64:
-		duration = ele.DurationFromMilliseconds(int(actual))
+		duration = ele.Duration().FromMilliseconds(int(actual))
 	case string:
-		duration = ele.DurationFromString(actual)
+		duration = ele.Duration().FromString(actual)
 	case abs.DurationLike:
 		duration = actual
 	case abs.ComponentLike:
@@ -130,27 +127,27 @@ func Moment(value abs.Value) abs.MomentLike {
 	var moment abs.MomentLike
 	switch actual := value.(type) {
 	case uint:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case uint8:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case uint16:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case uint32:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case uint64:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case int:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case int8:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case int16:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case int32:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case int64:
-		moment = ele.MomentFromMilliseconds(int(actual))
+		moment = ele.Moment().FromMilliseconds(int(actual))
 	case string:
-		moment = ele.MomentFromString(actual)
+		moment = ele.Moment().FromString(actual)
 	case abs.MomentLike:
 		moment = actual
 	case abs.ComponentLike:
@@ -168,35 +165,35 @@ func Number(value abs.Value) abs.NumberLike {
 	var number abs.NumberLike
 	switch actual := value.(type) {
 	case uint:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case uint8:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case uint16:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case uint32:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case uint64:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case int:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case int8:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case int16:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case int32:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case int64:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case float32:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case float64:
-		number = ele.NumberFromComplex(complex(float64(actual), 0))
+		number = ele.Number().FromComplex(complex(float64(actual), 0))
 	case complex64:
-		number = ele.NumberFromComplex(complex128(actual))
+		number = ele.Number().FromComplex(complex128(actual))
 	case complex128:
-		number = ele.NumberFromComplex(complex128(actual))
+		number = ele.Number().FromComplex(complex128(actual))
 	case string:
-		number = ele.NumberFromString(actual)
+		number = ele.Number().FromString(actual)
 	case abs.NumberLike:
 		number = actual
 	case abs.ComponentLike:
@@ -214,7 +211,7 @@ func Pattern(value abs.Value) abs.PatternLike {
 	var pattern abs.PatternLike
 	switch actual := value.(type) {
 	case string:
-		pattern = ele.PatternFromString(actual)
+		pattern = ele.Pattern().FromString(actual)
 	case abs.PatternLike:
 		pattern = actual
 	case abs.ComponentLike:
@@ -232,31 +229,31 @@ func Percentage(value abs.Value) abs.PercentageLike {
 	var percentage abs.PercentageLike
 	switch actual := value.(type) {
 	case uint:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case uint8:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case uint16:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case uint32:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case uint64:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case int:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case int8:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case int16:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case int32:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case int64:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case float32:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case float64:
-		percentage = ele.PercentageFromFloat(float64(actual))
+		percentage = ele.Percentage().FromFloat(float64(actual))
 	case string:
-		percentage = ele.PercentageFromString(actual)
+		percentage = ele.Percentage().FromString(actual)
 	case abs.PercentageLike:
 		percentage = actual
 	case abs.ComponentLike:
@@ -274,33 +271,33 @@ func Probability(value abs.Value) abs.ProbabilityLike {
 	var probability abs.ProbabilityLike
 	switch actual := value.(type) {
 	case bool:
-		probability = ele.ProbabilityFromBool(actual)
+		probability = ele.Probability().FromBool(actual)
 	case uint:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case uint8:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case uint16:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case uint32:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case uint64:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case int:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case int8:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case int16:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case int32:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case int64:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case float32:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case float64:
-		probability = ele.ProbabilityFromFloat(float64(actual))
+		probability = ele.Probability().FromFloat(float64(actual))
 	case string:
-		probability = ele.ProbabilityFromString(actual)
+		probability = ele.Probability().FromString(actual)
 	case abs.ProbabilityLike:
 		probability = actual
 	case abs.ComponentLike:
@@ -318,9 +315,9 @@ func Resource(value abs.Value) abs.ResourceLike {
 	var resource abs.ResourceLike
 	switch actual := value.(type) {
 	case *uri.URL:
-		resource = ele.ResourceFromURI(actual)
+		resource = ele.Resource().FromURI(actual)
 	case string:
-		resource = ele.ResourceFromString(actual)
+		resource = ele.Resource().FromString(actual)
 	case abs.ResourceLike:
 		resource = actual
 	case abs.ComponentLike:
@@ -344,7 +341,7 @@ func (v *parser) parseAngle() (abs.AngleLike, *Token, bool) {
 		v.backupOne(token)
 		return angle, token, false
 	}
-	angle = ele.AngleFromString(token.Value)
+	angle = ele.Angle().FromString(token.Value)
 	return angle, token, true
 }
 
@@ -365,7 +362,7 @@ func (v *parser) parseBoolean() (abs.BooleanLike, *Token, bool) {
 		v.backupOne(token)
 		return boolean, token, false
 	}
-	boolean = ele.BooleanFromString(token.Value)
+	boolean = ele.Boolean().FromString(token.Value)
 	return boolean, token, true
 }
 
@@ -386,7 +383,7 @@ func (v *parser) parseDuration() (abs.DurationLike, *Token, bool) {
 		v.backupOne(token)
 		return duration, token, false
 	}
-	duration = ele.DurationFromString(token.Value)
+	duration = ele.Duration().FromString(token.Value)
 	return duration, token, true
 }
 
@@ -446,7 +443,7 @@ func (v *parser) parseMoment() (abs.MomentLike, *Token, bool) {
 		v.backupOne(token)
 		return moment, token, false
 	}
-	moment = ele.MomentFromString(token.Value)
+	moment = ele.Moment().FromString(token.Value)
 	return moment, token, true
 }
 
@@ -466,7 +463,7 @@ func (v *parser) parseNumber() (abs.NumberLike, *Token, bool) {
 		v.backupOne(token)
 		return number, token, false
 	}
-	number = ele.NumberFromString(token.Value)
+	number = ele.Number().FromString(token.Value)
 	return number, token, true
 }
 
@@ -487,7 +484,16 @@ func (v *parser) parsePattern() (abs.PatternLike, *Token, bool) {
 		v.backupOne(token)
 		return pattern, token, false
 	}
-	pattern = ele.PatternFromString(token.Value)
+	var matches = uti.PatternMatcher.FindStringSubmatch(token.Value)
+	var _, err = reg.Compile(matches[1])
+	if len(matches[1]) > 0 && err != nil {
+		// The pattern does not contain a valid regular expression.
+		panic(v.parseError(token, "REGEX",
+			"$PATTERN",
+			"$REGEX",
+		))
+	}
+	pattern = ele.Pattern().FromString(token.Value)
 	return pattern, token, true
 }
 
@@ -508,7 +514,7 @@ func (v *parser) parsePercentage() (abs.PercentageLike, *Token, bool) {
 		v.backupOne(token)
 		return percentage, token, false
 	}
-	percentage = ele.PercentageFromString(token.Value)
+	percentage = ele.Percentage().FromString(token.Value)
 	return percentage, token, true
 }
 
@@ -529,7 +535,7 @@ func (v *parser) parseProbability() (abs.ProbabilityLike, *Token, bool) {
 		v.backupOne(token)
 		return probability, token, false
 	}
-	probability = ele.ProbabilityFromString(token.Value)
+	probability = ele.Probability().FromString(token.Value)
 	return probability, token, true
 }
 
@@ -551,7 +557,7 @@ func (v *parser) parseResource() (abs.ResourceLike, *Token, bool) {
 		v.backupOne(token)
 		return resource, token, false
 	}
-	resource = ele.ResourceFromString(token.Value)
+	resource = ele.Resource().FromString(token.Value)
 	return resource, token, true
 }
 
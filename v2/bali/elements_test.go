@@ -120,6 +120,19 @@ func TestNegativeDurations(t *tes.T) {
 	ass.Equal(t, 0, v.GetYears())
 }
 
+func TestMomentDays(t *tes.T) {
+	var days = []string{"02", "09", "10", "19", "20", "29", "30", "31"}
+	for _, day := range days {
+		var source = "<2024-03-" + day + ">"
+		var component = bal.ParseComponent(source)
+		ass.Equal(t, source, bal.FormatComponent(component))
+	}
+	for _, day := range []string{"00", "32"} {
+		var _, err = bal.TryParseComponent("<2024-03-" + day + ">")
+		ass.Error(t, err, day)
+	}
+}
+
 func TestStringMoments(t *tes.T) {
 	var v = bal.Moment("<2009-04-01T15:30:27.123>")
 	ass.Equal(t, 1238599827123, v.AsInteger())
@@ -235,7 +248,7 @@ func TestNegativeImaginaries(t *tes.T) {
 
 func TestNonePattern(t *tes.T) {
 	var v = bal.Pattern("none")
-	ass.Equal(t, `^none$`, v.AsString())
+	ass.Equal(t, `none`, v.AsString())
 
 	var text = ""
 	ass.False(t, v.MatchesText(text))
@@ -252,7 +265,7 @@ func TestNonePattern(t *tes.T) {
 
 func TestAnyPattern(t *tes.T) {
 	var v = bal.Pattern("any")
-	ass.Equal(t, `.*`, v.AsString())
+	ass.Equal(t, `any`, v.AsString())
 
 	var text = ""
 	ass.True(t, v.MatchesText(text))
@@ -269,7 +282,7 @@ func TestAnyPattern(t *tes.T) {
 
 func TestSomePattern(t *tes.T) {
 	var v = bal.Pattern(`"c(.+t)"?`)
-	ass.Equal(t, `c(.+t)`, v.AsString())
+	ass.Equal(t, `"c(.+t)"?`, v.AsString())
 
 	var text = "ct"
 	ass.False(t, v.MatchesText(text))
@@ -349,7 +362,7 @@ func TestOtherProbabilities(t *tes.T) {
 
 func TestResourceWithAuthorityAndPath(t *tes.T) {
 	var v = bal.Resource("<https://craterdog.com/About.html>")
-	ass.Equal(t, "https://craterdog.com/About.html", v.AsString())
+	ass.Equal(t, "<https://craterdog.com/About.html>", v.AsString())
 	ass.Equal(t, "https", v.GetScheme())
 	ass.Equal(t, "craterdog.com", v.GetAuthority())
 	ass.Equal(t, "/About.html", v.GetPath())
@@ -359,7 +372,7 @@ func TestResourceWithAuthorityAndPath(t *tes.T) {
 
 func TestResourceWithPath(t *tes.T) {
 	var v = bal.Resource("<mailto:craterdog@google.com>")
-	ass.Equal(t, "mailto:craterdog@google.com", v.AsString())
+	ass.Equal(t, "<mailto:craterdog@google.com>", v.AsString())
 	ass.Equal(t, "mailto", v.GetScheme())
 	ass.Equal(t, "", v.GetAuthority())
 	ass.Equal(t, "", v.GetPath())
@@ -369,7 +382,7 @@ func TestResourceWithPath(t *tes.T) {
 
 func TestResourceWithAuthorityAndPathAndQuery(t *tes.T) {
 	var v = bal.Resource("<https://craterdog.com/?foo=bar;bar=baz>")
-	ass.Equal(t, "https://craterdog.com/?foo=bar;bar=baz", v.AsString())
+	ass.Equal(t, "<https://craterdog.com/?foo=bar;bar=baz>", v.AsString())
 	ass.Equal(t, "https", v.GetScheme())
 	ass.Equal(t, "craterdog.com", v.GetAuthority())
 	ass.Equal(t, "/", v.GetPath())
@@ -379,7 +392,7 @@ func TestResourceWithAuthorityAndPathAndQuery(t *tes.T) {
 
 func TestResourceWithAuthorityAndPathAndFragment(t *tes.T) {
 	var v = bal.Resource("<https://craterdog.com/#Home>")
-	ass.Equal(t, "https://craterdog.com/#Home", v.AsString())
+	ass.Equal(t, "<https://craterdog.com/#Home>", v.AsString())
 	ass.Equal(t, "https", v.GetScheme())
 	ass.Equal(t, "craterdog.com", v.GetAuthority())
 	ass.Equal(t, "/", v.GetPath())
@@ -389,7 +402,7 @@ func TestResourceWithAuthorityAndPathAndFragment(t *tes.T) {
 
 func TestResourceWithAuthorityAndPathAndQueryAndFragment(t *tes.T) {
 	var v = bal.Resource("<https://craterdog.com/?foo=bar;bar=baz#Home>")
-	ass.Equal(t, "https://craterdog.com/?foo=bar;bar=baz#Home", v.AsString())
+	ass.Equal(t, "<https://craterdog.com/?foo=bar;bar=baz#Home>", v.AsString())
 	ass.Equal(t, "https", v.GetScheme())
 	ass.Equal(t, "craterdog.com", v.GetAuthority())
 	ass.Equal(t, "/", v.GetPath())
@@ -0,0 +1,119 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package bali
+
+import (
+	fmt "fmt"
+	sts "strings"
+)
+
+// PARSE ERROR INTERFACE
+
+// This type defines the structure of a syntax error found by the parser. The
+// Error() method renders the error as plain text, and the Colorized() method
+// renders it using ANSI terminal colors.
+type ParseError struct {
+	Line     int      // The line number of the offending token.
+	Position int      // The position in the line of the offending token.
+	Token    Token    // The offending token.
+	Expected string   // The name of the expected rule or token (if any).
+	Rules    []string // The names of the grammar rules that were being parsed.
+	Message  string   // A plain text description of the error.
+	source   []byte   // The source that was being parsed.
+}
+
+// This method returns the plain text rendering of this parse error including
+// the surrounding source lines and the expected grammar rules.
+func (v *ParseError) Error() string {
+	return v.render(false)
+}
+
+// This method returns the rendering of this parse error using ANSI terminal
+// colors.
+func (v *ParseError) Colorized() string {
+	return v.render(true)
+}
+
+// PARSE ERROR IMPLEMENTATION
+
+// These constants define the ANSI terminal escape sequences used to colorize a
+// parse error.
+const (
+	cyan   = "\033[36m"
+	green  = "\033[32m"
+	yellow = "\033[33m"
+	reset  = "\033[0m"
+)
+
+// This method renders this parse error with or without ANSI terminal colors.
+func (v *ParseError) render(colored bool) string {
+	var color = func(sequence string) string {
+		if colored {
+			return sequence
+		}
+		return ""
+	}
+	var message = v.Message + "\n"
+	var lines = sts.Split(string(v.source), EOL)
+	var line = v.Line
+
+	// Append the source line containing the error and the line before it.
+	message += color(cyan)
+	if line > 1 && line-1 <= len(lines) {
+		message += fmt.Sprintf("%04d: ", line-1) + lines[line-2] + EOL
+	}
+	if line > 0 && line <= len(lines) {
+		message += fmt.Sprintf("%04d: ", line) + lines[line-1] + EOL
+	}
+
+	// Append an arrow pointing to the offending token.
+	message += " " + color(green) + ">>>─"
+	for count := 0; count < v.Position; count++ {
+		message += "─"
+	}
+	message += "⌃" + color(cyan) + "\n"
+
+	// Append the source line following the error.
+	if line > 0 && line < len(lines) {
+		message += fmt.Sprintf("%04d: ", line+1) + lines[line] + EOL
+	}
+	message += color(reset) + "\n"
+
+	// Append the grammar rules that were expected.
+	if len(v.Expected) > 0 {
+		message += "Was expecting '" + v.Expected + "' from:\n"
+		for _, symbol := range v.Rules {
+			message += fmt.Sprintf(
+				"  %v%v: %v%v%v\n\n",
+				color(green),
+				symbol,
+				color(yellow),
+				grammar[symbol],
+				color(reset),
+			)
+		}
+	}
+	return message
+}
+
+// This function recovers from a panic caused by a parse error and stores that
+// error in the specified error variable. Any other panic is passed along.
+func catchParseError(err *error) {
+	var e = recover()
+	if e == nil {
+		return
+	}
+	var parseError, ok = e.(*ParseError)
+	if !ok {
+		panic(e)
+	}
+	*err = parseError
+}
@@ -46,20 +46,18 @@ func (v *parser) parseArguments() (abs.Sequential[abs.Expression], *Token, bool)
 		}
 		argument, token, ok = v.parseExpression()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("expression",
+			var err = v.parseError(token, "expression",
 				"$arguments",
 				"$expression")
-			panic(message)
+			panic(err)
 		}
 	}
 	_, token, ok = v.parseDelimiter(")")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar(")",
+		var err = v.parseError(token, ")",
 			"$intrinsic",
 			"$function")
-		panic(message)
+		panic(err)
 	}
 	return arguments, token, true
 }
@@ -102,13 +100,14 @@ func (v *parser) parseArithmetic(first abs.Expression) (abs.Expression, *Token,
 	}
 	second, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$arithmetic",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
-	expression = exp.Arithmetic(first, operator, second)
+	var arithmetic = exp.Arithmetic(first, operator, second)
+	arithmetic.SetSpan(v.spanFrom(first))
+	expression = arithmetic
 	return expression, token, true
 }
 
@@ -146,13 +145,14 @@ func (v *parser) parseChaining(first abs.Expression) (abs.Expression, *Token, bo
 	}
 	second, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$chaining",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
-	expression = exp.Chaining(first, operator, second)
+	var chaining = exp.Chaining(first, operator, second)
+	chaining.SetSpan(v.spanFrom(first))
+	expression = chaining
 	return expression, token, true
 }
 
@@ -190,13 +190,14 @@ func (v *parser) parseComparison(first abs.Expression) (abs.Expression, *Token,
 	}
 	second, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$comparison",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
-	expression = exp.Comparison(first, operator, second)
+	var comparison = exp.Comparison(first, operator, second)
+	comparison.SetSpan(v.spanFrom(first))
+	expression = comparison
 	return expression, token, true
 }
 
@@ -232,15 +233,16 @@ func (v *parser) parseComplement() (abs.UnaryOperationLike, *Token, bool) {
 		v.backupOne(token) // Put back the operator token.
 		return expression, token, false
 	}
+	var first = token
 	logical, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$complement",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
 	expression = exp.Complement(operator, logical)
+	expression.SetSpan(v.makeSpan(first))
 	return expression, token, true
 }
 
@@ -273,15 +275,16 @@ func (v *parser) parseDereference() (abs.UnaryOperationLike, *Token, bool) {
 		v.backupOne(token) // Put back the operator token.
 		return expression, token, false
 	}
+	var first = token
 	reference, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$dereference",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
 	expression = exp.Dereference(operator, reference)
+	expression.SetSpan(v.makeSpan(first))
 	return expression, token, true
 }
 
@@ -315,13 +318,14 @@ func (v *parser) parseExponential(base abs.Expression) (abs.Expression, *Token,
 	}
 	exponent, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$exponential",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
-	expression = exp.Exponential(base, operator, exponent)
+	var exponential = exp.Exponential(base, operator, exponent)
+	exponential.SetSpan(v.spanFrom(base))
+	expression = exponential
 	return expression, token, true
 }
 
@@ -449,6 +453,7 @@ func (v *parser) parseIntrinsic() (abs.IntrinsicLike, *Token, bool) {
 		return expression, token, false
 	}
 	expression = exp.Intrinsic(function, arguments)
+	expression.SetSpan(v.makeSpan(token))
 	return expression, token, true
 }
 
@@ -480,15 +485,16 @@ func (v *parser) parseInversion() (abs.UnaryOperationLike, *Token, bool) {
 		v.backupOne(token) // Put back the operator token.
 		return expression, token, false
 	}
+	var first = token
 	numeric, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$inversion",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
 	expression = exp.Inversion(operator, numeric)
+	expression.SetSpan(v.makeSpan(first))
 	return expression, token, true
 }
 
@@ -523,24 +529,24 @@ func (v *parser) parseInvocation(target abs.Expression) (abs.Expression, *Token,
 	}
 	message, token, ok = v.parseIdentifier()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("method",
+		var err = v.parseError(token, "method",
 			"$invocation",
 			"$method",
 			"$arguments")
-		panic(message)
+		panic(err)
 	}
 	arguments, token, ok = v.parseArguments()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$invocation",
 			"$method",
 			"$arguments",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
-	expression = exp.Invocation(target, operator, message, arguments)
+	var invocation = exp.Invocation(target, operator, message, arguments)
+	invocation.SetSpan(v.spanFrom(target))
+	expression = invocation
 	return expression, token, true
 }
 
@@ -569,7 +575,9 @@ func (v *parser) parseSubcomponent(composite abs.Expression) (abs.Expression, *T
 	if !ok {
 		return expression, token, false
 	}
-	expression = exp.Subcomponent(composite, indices)
+	var subcomponent = exp.Subcomponent(composite, indices)
+	subcomponent.SetSpan(v.spanFrom(composite))
+	expression = subcomponent
 	return expression, token, true
 }
 
@@ -603,13 +611,14 @@ func (v *parser) parseLogical(first abs.Expression) (abs.Expression, *Token, boo
 	}
 	second, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$logical",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
-	expression = exp.Logical(first, operator, second)
+	var logical = exp.Logical(first, operator, second)
+	logical.SetSpan(v.spanFrom(first))
+	expression = logical
 	return expression, token, true
 }
 
@@ -639,22 +648,22 @@ func (v *parser) parseMagnitude() (abs.UnaryOperationLike, *Token, bool) {
 		// This is not a magnitude expression.
 		return expression, token, false
 	}
+	var first = token
 	numeric, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$magnitude",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
 	_, token, ok = v.parseDelimiter("|")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("|",
+		var err = v.parseError(token, "|",
 			"$magnitude")
-		panic(message)
+		panic(err)
 	}
 	expression = exp.Magnitude(numeric)
+	expression.SetSpan(v.makeSpan(first))
 	return expression, token, true
 }
 
@@ -680,23 +689,23 @@ func (v *parser) parsePrecedence() (abs.UnaryOperationLike, *Token, bool) {
 		// This is not a precedence expression.
 		return expression, token, false
 	}
+	var first = token
 	inner, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$precedence",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
 	_, token, ok = v.parseDelimiter(")")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$precedence",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
 	expression = exp.Precedence(inner)
+	expression.SetSpan(v.makeSpan(first))
 	return expression, token, true
 }
 
@@ -907,6 +916,7 @@ func (v *parser) parseValue() (abs.ValueLike, *Token, bool) {
 		return value, token, false
 	}
 	value = exp.Value(component)
+	value.SetSpan(component.GetSpan())
 	return value, token, true
 }
 
@@ -920,6 +930,7 @@ func (v *parser) parseVariable() (abs.VariableLike, *Token, bool) {
 		return variable, token, false
 	}
 	variable = exp.Variable(token.Value)
+	variable.SetSpan(v.makeSpan(token))
 	return variable, token, true
 }
 
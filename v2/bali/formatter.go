@@ -11,8 +11,10 @@
 package bali
 
 import (
+	fmt "fmt"
 	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
 	sts "strings"
+	utf "unicode/utf8"
 )
 
 // FORMATTER INTERFACE
@@ -23,6 +25,34 @@ func Formatter(indentation int) *formatter {
 	return v
 }
 
+// This constructor creates a new pretty printing formatter using the specified
+// indentation and maximum line width. Each collection, context and procedure is
+// formatted inline if it fits within the maximum line width and on multiple
+// lines otherwise. A maximum line width of zero results in canonical formatting.
+func FormatterWithWidth(indentation int, width int) *formatter {
+	var v = &formatter{indentation: indentation, depth: 0, width: width}
+	return v
+}
+
+// This constructor creates a new compact formatter that formats everything on a
+// single line. It always uses the inline forms, drops all notes, comments and
+// blank lines, and converts each narrative string into the equivalent quote.
+func CompactFormatter() *formatter {
+	var v = &formatter{inline: true, compact: true}
+	return v
+}
+
+// This constructor creates a new lossless formatter. Each component that was
+// parsed by ParseDocumentLosslessly is formatted using the trivia recorded on
+// it, preserving its whitespace, blank lines, comments and original spellings
+// (e.g. "π" rather than "pi"). Only the components whose shapes have changed
+// since they were parsed, and any components that were not parsed losslessly,
+// are formatted canonically.
+func LosslessFormatter() *formatter {
+	var v = &formatter{lossless: true}
+	return v
+}
+
 // This function returns a canonical BDN string for the specified entity.
 func FormatEntity(entity abs.Entity) string {
 	var v = Formatter(0)
@@ -56,12 +86,68 @@ func FormatDocument(component abs.ComponentLike) []byte {
 	return []byte(s)
 }
 
+// This function returns a compact BDN string for the specified component that
+// contains no EOL characters. It panics if the component contains something
+// that cannot be formatted on a single line (e.g. a binary string).
+func FormatCompactComponent(component abs.ComponentLike) string {
+	var result, err = TryFormatCompactComponent(component)
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// This function formats a component like FormatCompactComponent but returns
+// any error rather than panicking.
+func TryFormatCompactComponent(component abs.ComponentLike) (result string, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("The component cannot be formatted compactly: %v", e)
+		}
+	}()
+	var v = CompactFormatter()
+	result = v.FormatComponent(component)
+	return result, nil
+}
+
+// This function returns the BDN bytes for the specified component using a
+// lossless formatter, including the POSIX standard trailing EOL. Formatting an
+// unmodified component that was parsed by ParseDocumentLosslessly returns the
+// original source bytes, and formatting a modified one reformats only the
+// components that were modified.
+func FormatDocumentLosslessly(component abs.ComponentLike) []byte {
+	var v = LosslessFormatter()
+	var s = v.FormatComponent(component) + EOL
+	return []byte(s)
+}
+
+// This function returns a pretty printed BDN string for the specified component
+// that fits within the specified maximum line width wherever possible.
+func FormatComponentWithWidth(component abs.ComponentLike, width int) string {
+	var v = FormatterWithWidth(0, width)
+	return v.FormatComponent(component)
+}
+
+// This function returns pretty printed BDN bytes for the specified component
+// including the POSIX standard trailing EOL.
+func FormatDocumentWithWidth(component abs.ComponentLike, width int) []byte {
+	var s = FormatComponentWithWidth(component, width) + EOL
+	return []byte(s)
+}
+
 // FORMATTER IMPLEMENTATION
 
 // This type defines the structure and methods for a canonical formatting agent.
 type formatter struct {
 	indentation int
 	depth       int
+	width       int    // The maximum line width, or zero for canonical formatting.
+	inline      bool   // Whether or not every node must be formatted inline.
+	compact     bool   // Whether or not the result must fit on a single line.
+	lossless    bool   // Whether or not the trivia recorded on each component is used.
+	margin      string // The whitespace that precedes the indentation of each line.
+	shaping     bool   // Whether or not nested components are replaced by placeholders.
+	nested      []abs.ComponentLike
 	result      sts.Builder
 }
 
@@ -78,11 +164,7 @@ func (v *formatter) AppendString(string_ string) {
 
 // This method appends a properly indented newline to the result.
 func (v *formatter) AppendNewline() {
-	var separator = EOL
-	var levels = v.depth + v.indentation
-	for level := 0; level < levels; level++ {
-		separator += "    "
-	}
+	var separator = EOL + v.getIndent()
 	v.result.WriteString(separator)
 }
 
@@ -104,3 +186,87 @@ func (v *formatter) FormatComponent(component abs.ComponentLike) string {
 	v.formatComponent(component)
 	return v.GetResult()
 }
+
+// This method returns the whitespace that indents each line at the current
+// depth.
+func (v *formatter) getIndent() string {
+	var levels = v.depth + v.indentation
+	return v.margin + sts.Repeat("    ", levels)
+}
+
+// This method returns the whitespace at the start of the current line of the
+// result.
+func (v *formatter) getMargin() string {
+	var result = v.result.String()
+	var line = result[sts.LastIndex(result, EOL)+1:]
+	return line[:len(line)-len(sts.TrimLeft(line, " "))]
+}
+
+// This method returns the number of runes that have been appended to the
+// result since its last EOL character.
+func (v *formatter) getColumn() int {
+	var result = v.result.String()
+	var line = result[sts.LastIndex(result, EOL)+1:]
+	return utf.RuneCountInString(line)
+}
+
+// This method determines whether or not the node that is formatted by the
+// specified function should be formatted inline. A canonical formatter uses
+// the inline form only when the specified canonical flag is set. A pretty
+// printing formatter uses the inline form only when the entire node fits on
+// a single line within the maximum line width.
+func (v *formatter) isInline(canonical bool, format func(trial *formatter)) bool {
+	if v.inline {
+		return true
+	}
+	if v.width == 0 {
+		return canonical
+	}
+	var trial = &formatter{indentation: v.indentation, depth: v.depth, width: v.width, inline: true}
+	format(trial)
+	var result = trial.GetResult()
+	if sts.Contains(result, EOL) {
+		// The node contains something that must span multiple lines.
+		return false
+	}
+	return v.getColumn()+utf.RuneCountInString(result) <= v.width
+}
+
+// This method appends the original source for the specified component to the
+// result if its shape (its canonical form without its nested components) has
+// not changed since it was parsed. Each nested component is formatted into the
+// gap that it left in the original source, so only the nested components that
+// have changed are reformatted. The original lines are reindented to match the
+// current line. It returns whether or not the original source was appended.
+func (v *formatter) appendOriginal(component abs.ComponentLike) bool {
+	var trivia = component.GetTrivia()
+	if trivia == nil {
+		return false
+	}
+	var shape, nested = shapeComponent(component)
+	var segments = trivia.GetSegments()
+	if len(nested) != len(segments)-1 || digestShape(shape) != trivia.GetDigest() {
+		// The component has been modified since it was parsed.
+		return false
+	}
+	var original = trivia.GetIndentation()
+	var current = v.getMargin()
+	for index, segment := range segments {
+		var lines = sts.Split(segment, EOL)
+		for index, line := range lines[1:] {
+			if len(line) > 0 && sts.HasPrefix(line, original) {
+				lines[index+1] = current + line[len(original):]
+			}
+		}
+		v.AppendString(sts.Join(lines, EOL))
+		if index < len(nested) {
+			// Any nested component that is formatted canonically is indented
+			// relative to the current line.
+			var margin, depth = v.margin, v.depth
+			v.margin, v.depth = v.getMargin(), 0
+			v.formatComponent(nested[index])
+			v.margin, v.depth = margin, depth
+		}
+	}
+	return true
+}
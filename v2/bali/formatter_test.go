@@ -0,0 +1,258 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package bali_test
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	sts "strings"
+	tes "testing"
+)
+
+func TestFormattingWithWidthRoundtrips(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ./test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var expected, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(expected)
+			ass.Equal(t, string(expected), string(bal.FormatDocumentWithWidth(component, 0)), filename)
+			for _, width := range []int{20, 40, 80, 1000} {
+				var document = bal.FormatDocumentWithWidth(component, width)
+				component = bal.ParseDocument(document)
+				ass.Equal(t, string(expected), string(bal.FormatDocument(component)), filename)
+			}
+		}
+	}
+}
+
+func TestFormattingWithWidth(t *tes.T) {
+	var component = bal.ParseComponent(`[$name: "Alice", $tags: ["first", "second"], $check: {return true}]`)
+	ass.Equal(t, `[$name: "Alice", $tags: ["first", "second"], $check: {return true}]`,
+		bal.FormatComponentWithWidth(component, 80))
+	ass.Equal(t, `[
+    $name: "Alice"
+    $tags: ["first", "second"]
+    $check: {return true}
+]`, bal.FormatComponentWithWidth(component, 40))
+	ass.Equal(t, `[
+    $name: "Alice"
+    $tags: [
+        "first"
+        "second"
+    ]
+    $check: {
+        return true
+    }
+]`, bal.FormatComponentWithWidth(component, 20))
+
+	component = bal.ParseComponent(`[1, 2]($type: /bali/types/collections/Set/v1, $size: 2)  ! A small set.`)
+	ass.Equal(t, `[1, 2]($type: /bali/types/collections/Set/v1, $size: 2)  ! A small set.`,
+		bal.FormatComponentWithWidth(component, 60))
+	ass.Equal(t, `[1, 2](
+    $type: /bali/types/collections/Set/v1
+    $size: 2
+)  ! A small set.`, bal.FormatComponentWithWidth(component, 40))
+
+	// A note must end its line so the collection containing it cannot be inlined.
+	component = bal.ParseComponent(`[
+    1  ! The first value.
+    2
+]`)
+	ass.Equal(t, `[
+    1  ! The first value.
+    2
+]`, bal.FormatComponentWithWidth(component, 1000))
+}
+
+func TestCompactFormattingRoundtrips(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ./test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var source, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(source)
+			var compact, err = bal.TryFormatCompactComponent(component)
+			if sts.Contains(string(source), "'>") {
+				// Binary strings cannot be formatted on a single line.
+				ass.Error(t, err, filename)
+				continue
+			}
+			ass.NoError(t, err, filename)
+			ass.NotContains(t, compact, bal.EOL, filename)
+			component = bal.ParseComponent(compact)
+			ass.Equal(t, compact, bal.FormatCompactComponent(component), filename)
+		}
+	}
+}
+
+func TestCompactFormatting(t *tes.T) {
+	var component = bal.ParseComponent(`[
+    $name: "Alice"  ! The name.
+    $tags: [
+        "first"
+        "second"
+    ]($type: /bali/types/collections/Set/v1)
+    $check: {
+        !>
+            Make sure it works.
+        <!
+        let $x := 1
+
+        return $x
+    }
+]  ! A person.`)
+	ass.Equal(t,
+		`[$name: "Alice", $tags: ["first", "second"]($type: /bali/types/collections/Set/v1), $check: {let $x := 1; return $x}]`,
+		bal.FormatCompactComponent(component))
+
+	component = bal.ParseComponent(`[
+    $summary: ">
+        A "quoted" word.
+    <"
+]`)
+	var compact = bal.FormatCompactComponent(component)
+	ass.NotContains(t, compact, bal.EOL)
+	ass.Contains(t, compact, `A \"quoted\" word.`)
+	ass.Equal(t, compact, bal.FormatCompactComponent(bal.ParseComponent(compact)))
+
+	component = bal.ParseComponent(`'>
+    abcd
+<'`)
+	var _, err = bal.TryFormatCompactComponent(component)
+	ass.Error(t, err)
+}
+
+func TestLosslessFormatting(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ./test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var source, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocumentLosslessly(source)
+			ass.Equal(t, string(source), string(bal.FormatDocumentLosslessly(component)), filename)
+		}
+	}
+
+	var source = []byte(`[
+  $pi:    π   ! Spelled with a Greek letter.
+  $limit: ∞
+  $check: {
+      !>
+          Keep this comment here.
+      <!
+      return $pi
+
+      return $limit
+  }
+]
+`)
+	var component = bal.ParseDocumentLosslessly(source)
+	ass.Equal(t, string(source), string(bal.FormatDocumentLosslessly(component)))
+
+	// Only the modified component is reformatted.
+	var catalog = component.GetEntity().(abs.CatalogLike)
+	catalog.SetValue(bal.Symbol("$limit"), bal.Component("5"))
+	ass.Equal(t, `[
+  $pi:    π   ! Spelled with a Greek letter.
+  $limit: 5
+  $check: {
+      !>
+          Keep this comment here.
+      <!
+      return $pi
+
+      return $limit
+  }
+]
+`, string(bal.FormatDocumentLosslessly(component)))
+
+	// A new component is indented relative to the line that contains it.
+	catalog.SetValue(bal.Symbol("$limit"), bal.ParseComponent(`[
+    1
+    2
+]`))
+	ass.Equal(t, `[
+  $pi:    π   ! Spelled with a Greek letter.
+  $limit: [
+      1
+      2
+  ]
+  $check: {
+      !>
+          Keep this comment here.
+      <!
+      return $pi
+
+      return $limit
+  }
+]
+`, string(bal.FormatDocumentLosslessly(component)))
+
+	// Modifying a nested collection in place reformats only the modified value.
+	source = []byte(`[
+    $pi:  π
+    $list: [1, 2]
+]
+`)
+	component = bal.ParseDocumentLosslessly(source)
+	catalog = component.GetEntity().(abs.CatalogLike)
+	var list = catalog.GetValue(bal.Symbol("$list")).GetEntity().(abs.ListLike)
+	list.SetValue(2, bal.Component("3"))
+	ass.Equal(t, `[
+    $pi:  π
+    $list: [1, 3]
+]
+`, string(bal.FormatDocumentLosslessly(component)))
+
+	// Adding a value changes the shape of the collection, so it is reformatted
+	// while its unmodified values keep their original spellings.
+	list.AddValue(bal.Component("4"))
+	ass.Equal(t, `[
+    $pi:  π
+    $list: [
+        1
+        3
+        4
+    ]
+]
+`, string(bal.FormatDocumentLosslessly(component)))
+	catalog.SetValue(bal.Symbol("$tau"), bal.Component("τ"))
+	ass.Equal(t, `[
+    $pi: π
+    $list: [
+        1
+        3
+        4
+    ]
+    $tau: τ
+]
+`, string(bal.FormatDocumentLosslessly(component)))
+
+	// The trivia is only recorded when parsing losslessly.
+	component = bal.ParseDocument(source)
+	ass.Nil(t, component.GetTrivia())
+	ass.Equal(t, string(bal.FormatDocument(component)), string(bal.FormatDocumentLosslessly(component)))
+}
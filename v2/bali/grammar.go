@@ -10,10 +10,6 @@
 
 package bali
 
-import (
-	fmt "fmt"
-)
-
 // This map captures the syntax rules for the Bali Document Notation™ (Bali)
 // language grammar. The lowercase identifiers define rules for the grammar and
 // the UPPERCASE identifiers represent tokens returned by the scanner. The
@@ -35,7 +31,7 @@ var grammar = map[string]string{
 	"$CHARACTER":   `ESCAPE | ~('"' | CONTROL)`,
 	"$COMMENT":     `"!>" ANY* "<!"`,
 	"$COMPLEX":     `'(' (RECTANGULAR | POLAR) ')'`,
-	"$DAY":         `'0'..'2' '1'..'9' | '3' '0'..'1'`,
+	"$DAY":         `'0' '1'..'9' | '1'..'2' '0'..'9' | '3' '0'..'1'`,
 	"$DAYS":        `TIMESPAN 'D'`,
 	"$DURATION":    `'~' SIGN? 'P' (WEEKS | YEARS? MONTHS? DAYS? ('T' HOURS? MINUTES? SECONDS?)?)`,
 	"$E":           `'e'`,
@@ -57,7 +53,7 @@ var grammar = map[string]string{
 	"$MOMENT":      `'<' SIGN? YEAR ('-' MONTH ('-' DAY ('T' HOUR (':' MINUTE (':' SECOND FRACTION?)?)?)?)?)? '>'`,
 	"$MONTH":       `'0' '1'..'9' | '1' '0'..'2'`,
 	"$MONTHS":      `TIMESPAN 'M'`,
-	"$NAME":        `('/' IDENTIFIER)+`,
+	"$NAME":        `('/' IDENTIFIER)* '/' (VERSION | IDENTIFIER)`,
 	"$NARRATIVE":   `'"' '>' EOL ANY* EOL SPACE* '<' '"'`,
 	"$NOTE":        `"! " (~CONTROL)*`,
 	"$NUMBER":      `REAL | IMAGINARY | COMPLEX`,
@@ -220,13 +216,3 @@ var grammar = map[string]string{
 	"$whileClause": `"while" condition "do" procedure`,
 	"$withClause":  `"with" "each" item "in" sequence "do" procedure`,
 }
-
-// PRIVATE FUNCTIONS
-
-func generateGrammar(expected string, symbols ...string) string {
-	var message = "Was expecting '" + expected + "' from:\n"
-	for _, symbol := range symbols {
-		message += fmt.Sprintf("  \033[32m%v: \033[33m%v\033[0m\n\n", symbol, grammar[symbol])
-	}
-	return message
-}
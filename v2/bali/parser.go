@@ -11,10 +11,16 @@
 package bali
 
 import (
+	byt "bytes"
+	ctx "context"
 	fmt "fmt"
 	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
 	col "github.com/craterdog/go-collection-framework/v2"
+	io "io"
+	run "runtime"
 	sts "strings"
+	utf "unicode/utf8"
 )
 
 // PARSER INTERFACE
@@ -30,40 +36,45 @@ import (
 //
 // A POSIX compliant file must end with a EOL character before the EOF marker.
 func ParseDocument(document []byte) abs.ComponentLike {
-	var ok bool
-	var token *Token
-	var component abs.ComponentLike
-	var parser = Parser(document)
-	component, token, ok = parser.parseComponent()
-	if ok {
-		_, token, ok = parser.parseEOL() // Required by POSIX.
-		if !ok {
-			var message = parser.formatError(token)
-			message += generateGrammar("EOL",
-				"$source",
-				"$component")
-			panic(message)
-		}
-		_, token, ok = parser.parseEOF()
-		if !ok {
-			var message = parser.formatError(token)
-			message += generateGrammar("EOF",
-				"$source",
-				"$component")
-			panic(message)
-		}
-	} else {
-		var message = parser.formatError(token)
-		message += generateGrammar("component",
-			"$source",
-			"$component",
-			"$entity",
-			"$context")
-		panic(message)
+	var component, err = ParseDocumentWithContext(ctx.Background(), document)
+	if err != nil {
+		panic(err)
 	}
 	return component
 }
 
+// This function parses BDN source bytes like ParseDocument but reads them
+// incrementally from the specified reader (e.g. a file or pipe) so that the
+// document need not be held in memory as a whole. Since the source is never
+// held in memory as a whole, syntax errors are reported without the surrounding
+// source lines.
+func ParseReader(reader io.Reader) abs.ComponentLike {
+	var component, err = ParseReaderWithContext(ctx.Background(), reader)
+	if err != nil {
+		panic(err)
+	}
+	return component
+}
+
+// This function parses the specified BDN source bytes like ParseDocument but
+// stops parsing once the specified context is canceled or its deadline passes.
+// It returns either a *ParseError or the error of the context rather than
+// panicking. The scanner goroutine is always stopped before this function
+// returns.
+func ParseDocumentWithContext(context ctx.Context, document []byte) (abs.ComponentLike, error) {
+	var parser = ParserWithContext(context, document)
+	return parser.parseWithContext(context)
+}
+
+// This function parses the source bytes read from the specified reader like
+// ParseReader but stops parsing once the specified context is canceled or its
+// deadline passes. A read that is already blocked in the reader cannot be
+// interrupted, so the reader should honor the deadline as well.
+func ParseReaderWithContext(context ctx.Context, reader io.Reader) (abs.ComponentLike, error) {
+	var parser = ParserFromReaderWithContext(context, reader)
+	return parser.parseWithContext(context)
+}
+
 // This function parses a source string rather than the bytes from a BDN
 // document file. It is useful when parsing strings within source code.
 func ParseComponent(source string) abs.ComponentLike {
@@ -77,17 +88,18 @@ func ParseEntity(source string) abs.Entity {
 	var token *Token
 	var entity abs.Entity
 	var parser = Parser([]byte(source + EOL))
+	defer parser.cancel()
+	defer parser.convertPanic()
 	entity, token, ok = parser.parseEntity()
 	if !ok {
-		var message = parser.formatError(token)
-		message += generateGrammar("entity",
+		var err = parser.parseError(token, "entity",
 			"element",
 			"string",
 			"range",
 			"collection",
 			"procedure",
 		)
-		panic(message)
+		panic(err)
 	}
 	return entity
 }
@@ -98,56 +110,201 @@ func ParseContext(source string) abs.ContextLike {
 	var token *Token
 	var context abs.ContextLike
 	var parser = Parser([]byte(source))
+	defer parser.cancel()
+	defer parser.convertPanic()
 	context, token, ok = parser.parseContext()
 	if !ok {
-		var message = parser.formatError(token)
-		message += generateGrammar("context",
+		var err = parser.parseError(token, "context",
 			"$component",
 			"$entity",
 			"$context",
 			"$parameters")
-		panic(message)
+		panic(err)
 	}
 	return context
 }
 
+// This function parses the specified BDN source bytes like ParseDocument but
+// returns any syntax error as a *ParseError rather than panicking. It is useful
+// when parsing documents from untrusted sources.
+func TryParseDocument(document []byte) (component abs.ComponentLike, err error) {
+	defer catchParseError(&err)
+	component = ParseDocument(document)
+	return component, err
+}
+
+// This function parses the source bytes read from the specified reader like
+// ParseReader but returns any syntax error as a *ParseError rather than
+// panicking.
+func TryParseReader(reader io.Reader) (component abs.ComponentLike, err error) {
+	defer catchParseError(&err)
+	component = ParseReader(reader)
+	return component, err
+}
+
+// This function parses a source string like ParseComponent but returns any
+// syntax error as a *ParseError rather than panicking.
+func TryParseComponent(source string) (component abs.ComponentLike, err error) {
+	defer catchParseError(&err)
+	component = ParseComponent(source)
+	return component, err
+}
+
+// This function parses an entity like ParseEntity but returns any syntax error
+// as a *ParseError rather than panicking.
+func TryParseEntity(source string) (entity abs.Entity, err error) {
+	defer catchParseError(&err)
+	entity = ParseEntity(source)
+	return entity, err
+}
+
+// This function parses a context like ParseContext but returns any syntax error
+// as a *ParseError rather than panicking.
+func TryParseContext(source string) (context abs.ContextLike, err error) {
+	defer catchParseError(&err)
+	context = ParseContext(source)
+	return context, err
+}
+
+// This function parses the specified BDN source bytes like ParseDocument but
+// also records the trivia for each component: the whitespace, blank lines,
+// comments and original spellings (e.g. "π" rather than "pi") that surround its
+// nested components. Only a component parsed this way can be formatted
+// losslessly. Since the trivia must be recorded and the shape of each component
+// digested, this is slower than ParseDocument.
+func ParseDocumentLosslessly(document []byte) abs.ComponentLike {
+	var parser = Parser(document)
+	parser.lossless = true
+	var component, err = parser.parseWithContext(ctx.Background())
+	if err != nil {
+		panic(err)
+	}
+	return component
+}
+
+// This function parses the specified BDN source bytes like ParseDocument but
+// rather than stopping at the first syntax error it records the error, skips
+// ahead to the end of the line or the next closing delimiter, and continues
+// parsing. It returns the (possibly partial) abstract syntax tree along with
+// the list of syntax errors that were found. Since the scanner stops at the
+// first invalid character, any source following a lexical error is not parsed.
+func ParseDocumentWithDiagnostics(document []byte) (abs.ComponentLike, []*ParseError) {
+	var component abs.ComponentLike
+	var parser = Parser(document)
+	defer parser.cancel()
+	parser.recovering = true
+	parser.recoverItem("", func() {
+		component = parser.parseSource()
+		parser.parseEnd()
+	})
+	return component, parser.diagnostics
+}
+
+// This function parses a source string like ParseComponent but returns a
+// (possibly partial) abstract syntax tree along with the list of all syntax
+// errors that were found.
+func ParseComponentWithDiagnostics(source string) (abs.ComponentLike, []*ParseError) {
+	var document = []byte(source + EOL) // Append the POSIX compliant EOL character.
+	return ParseDocumentWithDiagnostics(document)
+}
+
 // PARSER IMPLEMENTATION
 
 // This constructor creates a new parser using the specified byte array.
 func Parser(source []byte) *parser {
+	return ParserWithContext(ctx.Background(), source)
+}
+
+// This constructor creates a new parser using the specified byte array whose
+// scanner stops once the specified context is done or the parser is canceled.
+func ParserWithContext(context ctx.Context, source []byte) *parser {
+	var scanning, cancel = ctx.WithCancel(context)
+	var tokens = make(chan Token, 256)
+	ScanTokensWithContext(scanning, source, tokens) // Starts scanning in a separate go routine.
+	var p = &parser{
+		source:   source,
+		next:     col.StackWithCapacity[*Token](4),
+		tokens:   tokens,
+		cancel:   cancel,
+		consumed: make([]*Token, 0, 8),
+	}
+	return p
+}
+
+// This constructor creates a new parser that reads its source bytes from the
+// specified reader. The buffered token channel applies back-pressure so that
+// the source is only read as quickly as the tokens are parsed.
+func ParserFromReader(reader io.Reader) *parser {
+	return ParserFromReaderWithContext(ctx.Background(), reader)
+}
+
+// This constructor creates a new parser that reads its source bytes from the
+// specified reader and whose scanner stops once the specified context is done
+// or the parser is canceled.
+func ParserFromReaderWithContext(context ctx.Context, reader io.Reader) *parser {
+	var scanning, cancel = ctx.WithCancel(context)
 	var tokens = make(chan Token, 256)
-	ScanTokens(source, tokens) // Starts scanning in a separate go routine.
+	ScanReaderWithContext(scanning, reader, tokens) // Starts scanning in a separate go routine.
 	var p = &parser{
-		source: source,
-		next:   col.StackWithCapacity[*Token](4),
-		tokens: tokens,
+		next:     col.StackWithCapacity[*Token](4),
+		tokens:   tokens,
+		cancel:   cancel,
+		consumed: make([]*Token, 0, 8),
 	}
 	return p
 }
 
 // This type defines the structure and methods for the parser agent.
 type parser struct {
-	source []byte
-	next   col.StackLike[*Token] // The stack of the retrieved tokens that have been put back.
-	tokens chan Token            // The queue of unread tokens coming from the scanner.
+	source      []byte
+	next        col.StackLike[*Token] // The stack of the retrieved tokens that have been put back.
+	tokens      chan Token            // The queue of unread tokens coming from the scanner.
+	cancel      ctx.CancelFunc        // The function that stops the scanner.
+	consumed    []*Token              // The most recently consumed tokens.
+	end         *Token                // The end of a token stream cut short by a lexical error.
+	recovering  bool                  // Whether or not syntax errors are recovered from.
+	diagnostics []*ParseError         // The syntax errors that have been recovered from.
+	lossless    bool                  // Whether or not the trivia for each component is recorded.
 }
 
 // This method attempts to read the next token from the token stream and return
 // it.
 func (v *parser) nextToken() *Token {
+	var next = v.readToken()
+	if len(v.consumed) == cap(v.consumed) {
+		v.consumed = v.consumed[1:]
+	}
+	v.consumed = append(v.consumed, next)
+	return next
+}
+
+// This method reads the next token from the stack of put back tokens or if it
+// is empty, from the token stream.
+func (v *parser) readToken() *Token {
 	var next *Token
-	if v.next.IsEmpty() {
-		var token, ok = <-v.tokens
-		if !ok {
-			panic("The token channel terminated without an EOF or error token.")
-		}
-		next = &token
-		if next.Type == TokenERROR {
-			var message = v.formatError(next)
-			panic(message)
-		}
-	} else {
+	if !v.next.IsEmpty() {
 		next = v.next.RemoveTop()
+		return next
+	}
+	if v.end != nil {
+		// The scanner stopped at a lexical error so keep returning an EOF.
+		var end = *v.end
+		return &end
+	}
+	var token, ok = <-v.tokens
+	if !ok {
+		panic(tokensTerminated)
+	}
+	next = &token
+	if next.Type == TokenERROR {
+		var err = v.parseError(next, "")
+		if !v.recovering {
+			panic(err)
+		}
+		v.recordError(err)
+		v.end = &Token{Type: TokenEOF, Line: next.Line, Position: next.Position, Offset: next.Offset}
+		var end = *v.end
+		next = &end
 	}
 	return next
 }
@@ -155,34 +312,286 @@ func (v *parser) nextToken() *Token {
 // This method puts back the current token onto the token stream so that it can
 // be retrieved by another parsing method.
 func (v *parser) backupOne(token *Token) {
+	var count = len(v.consumed)
+	if count > 0 && v.consumed[count-1] == token {
+		// The token is no longer consumed.
+		v.consumed = v.consumed[:count-1]
+	}
 	v.next.AddValue(token)
 }
 
-// This method returns an error message containing the context for a parsing
-// error.
-func (v *parser) formatError(token *Token) string {
-	var message = fmt.Sprintf("An unexpected token was received by the parser: %v\n", token)
-	var line = token.Line
-	var lines = sts.Split(string(v.source), EOL)
+// This method returns the next token from the token stream without consuming
+// it.
+func (v *parser) peekToken() *Token {
+	var token = v.nextToken()
+	v.backupOne(token)
+	return token
+}
+
+// This method returns the span of source code from the start of the specified
+// first token through the end of the most recently consumed token.
+func (v *parser) makeSpan(first *Token) abs.SpanLike {
+	var last = v.consumed[len(v.consumed)-1]
+	var endLine = last.Line
+	var endPosition = last.Position
+	var index = sts.LastIndex(last.Value, EOL)
+	switch {
+	case len(last.Value) != last.Length:
+		// The scanner replaced the value of the token with a marker (e.g.
+		// "<EOFL>" or "<BELL>") for at most one single byte control character.
+		endPosition += last.Length
+	case index < 0:
+		endPosition += utf.RuneCountInString(last.Value)
+	default:
+		// The last token spans multiple lines (e.g. a narrative).
+		endLine += sts.Count(last.Value, EOL)
+		endPosition = utf.RuneCountInString(last.Value[index+1:]) + 1
+	}
+	var endOffset = last.Offset + last.Length
+	return com.Span(
+		first.Line, first.Position, first.Offset,
+		endLine, endPosition, endOffset,
+	)
+}
+
+// This method returns the trivia for the specified component, which has just
+// been parsed, or nil if its nested components were not all parsed from the
+// source in the order in which they are formatted (e.g. the sorted values of a
+// set).
+func (v *parser) makeTrivia(component abs.ComponentLike) abs.TriviaLike {
+	var span = component.GetSpan()
+	var start = span.GetStartOffset()
+	var end = span.GetEndOffset()
+	var shape, nested = shapeComponent(component)
+	var segments = make([]string, 0, len(nested)+1)
+	for _, child := range nested {
+		var gap = child.GetSpan()
+		if gap == nil || gap.GetStartOffset() < start || gap.GetEndOffset() > end {
+			return nil
+		}
+		segments = append(segments, string(v.source[start:gap.GetStartOffset()]))
+		start = gap.GetEndOffset()
+	}
+	segments = append(segments, string(v.source[start:end]))
+	start = span.GetStartOffset()
+	var line = v.source[byt.LastIndex(v.source[:start], []byte(EOL))+1 : start]
+	var indentation = string(line[:len(line)-len(byt.TrimLeft(line, " "))])
+	return com.Trivia(digestShape(shape), indentation, segments)
+}
+
+// This method returns the span of source code from the start of the specified
+// expression through the end of the most recently consumed token.
+func (v *parser) spanFrom(expression abs.Expression) abs.SpanLike {
+	var start = expression.(abs.Spanned).GetSpan()
+	var end = v.makeSpan(v.consumed[len(v.consumed)-1])
+	return com.Span(
+		start.GetStartLine(), start.GetStartPosition(), start.GetStartOffset(),
+		end.GetEndLine(), end.GetEndPosition(), end.GetEndOffset(),
+	)
+}
+
+// This method returns a parse error containing the context for a parsing error.
+// The expected string names the rule or token that was expected and the symbols
+// name the grammar rules that were being parsed.
+func (v *parser) parseError(token *Token, expected string, symbols ...string) *ParseError {
+	var err = &ParseError{
+		Line:     token.Line,
+		Position: token.Position,
+		Token:    *token,
+		Expected: expected,
+		Rules:    symbols,
+		Message:  fmt.Sprintf("An unexpected token was received by the parser: %v", token),
+		source:   v.source,
+	}
+	return err
+}
+
+// This method returns the parse error for the specified panic value. A panic
+// raised by an element constructor, e.g. for the moment <2024-02-30> whose
+// token matches the scanner but which is not a valid date, becomes a parse
+// error at the most recently consumed token. It returns nil for a runtime error
+// or any other panic raised by the parser itself, which must be passed along.
+func (v *parser) asParseError(e any) *ParseError {
+	switch actual := e.(type) {
+	case *ParseError:
+		return actual
+	case run.Error:
+		return nil
+	}
+	if e == tokensTerminated {
+		return nil
+	}
+	var token = &Token{Type: TokenEOF, Line: 1, Position: 1}
+	if count := len(v.consumed); count > 0 {
+		token = v.consumed[count-1]
+	}
+	var err = v.parseError(token, "")
+	err.Message = fmt.Sprintf("An invalid token was received by the parser: %v (%v)", token, e)
+	return err
+}
+
+// This method converts any panic raised by an element constructor while parsing
+// into a parse error and panics with it instead. It must be deferred.
+func (v *parser) convertPanic() {
+	var e = recover()
+	if e == nil {
+		return
+	}
+	var err = v.asParseError(e)
+	if err == nil {
+		panic(e)
+	}
+	panic(err)
+}
+
+// This method records the specified parse error as a diagnostic. An error that
+// is reported at the same token as the previous error is a side effect of that
+// error and is ignored.
+func (v *parser) recordError(err *ParseError) {
+	var count = len(v.diagnostics)
+	if count > 0 {
+		var previous = v.diagnostics[count-1]
+		if previous.Line == err.Line && previous.Position == err.Position {
+			return
+		}
+	}
+	v.diagnostics = append(v.diagnostics, err)
+}
+
+// This method calls the specified function to parse the next item in a list.
+// If the parser is recovering from errors, any parse error raised by the
+// function is recorded and the rest of the item is skipped. The separator is
+// the delimiter that separates the items in an inline list, or is empty for a
+// multiline list. It returns whether or not an error was recovered from.
+func (v *parser) recoverItem(separator string, parse func()) (recovered bool) {
+	if !v.recovering {
+		parse()
+		return false
+	}
+	defer func() {
+		var e = recover()
+		if e == nil {
+			return
+		}
+		var err = v.asParseError(e)
+		if err == nil {
+			panic(e)
+		}
+		v.recordError(err)
+		v.synchronize(separator)
+		recovered = true
+	}()
+	parse()
+	return false
+}
 
-	message += "\033[36m"
-	if line > 1 {
-		message += fmt.Sprintf("%04d: ", line-1) + string(lines[line-2]) + EOL
+// This method skips the tokens that remain in an item that could not be parsed.
+// For a multiline list it skips past the next EOL, since the closing delimiter
+// of a multiline list always starts a new line. For an inline list it stops at
+// the next separator, the end of the line or a closing delimiter that has no
+// matching opening delimiter within the skipped tokens. It always stops at the
+// EOF.
+func (v *parser) synchronize(separator string) {
+	var depth int
+	for {
+		var token = v.nextToken()
+		switch token.Type {
+		case TokenEOF:
+			v.backupOne(token)
+			return
+		case TokenEOL:
+			if depth > 0 {
+				continue
+			}
+			if len(separator) > 0 {
+				v.backupOne(token) // An inline list ends with its line.
+			}
+			return
+		case TokenDELIMITER:
+			switch token.Value {
+			case "[", "(", "{":
+				depth++
+			case "]", ")", "}":
+				if depth > 0 {
+					depth--
+					continue
+				}
+				if len(separator) > 0 {
+					v.backupOne(token)
+					return
+				}
+			case separator:
+				if depth == 0 {
+					v.backupOne(token)
+					return
+				}
+			}
+		}
 	}
-	message += fmt.Sprintf("%04d: ", line) + string(lines[line-1]) + EOL
+}
 
-	message += " \033[32m>>>─"
-	var count = 0
-	for count < token.Position {
-		message += "─"
-		count++
+// This method parses the component that makes up an entire source document.
+func (v *parser) parseSource() abs.ComponentLike {
+	var component, token, ok = v.parseComponent()
+	if !ok {
+		var err = v.parseError(token, "component",
+			"$source",
+			"$component",
+			"$entity",
+			"$context")
+		panic(err)
 	}
-	message += "⌃\033[36m\n"
+	return component
+}
 
-	if line < len(lines) {
-		message += fmt.Sprintf("%04d: ", line+1) + string(lines[line]) + EOL
+// This method parses the end of a source document.
+func (v *parser) parseEnd() {
+	var _, token, ok = v.parseEOL() // Required by POSIX.
+	if !ok {
+		var err = v.parseError(token, "EOL",
+			"$source",
+			"$component")
+		panic(err)
+	}
+	_, token, ok = v.parseEOF()
+	if !ok {
+		var err = v.parseError(token, "EOF",
+			"$source",
+			"$component")
+		panic(err)
 	}
-	message += "\033[0m\n"
+}
+
+// This constant defines the panic value used when the token stream ends
+// without an EOF or error token, which happens when the scanner was stopped
+// because its context is done.
+const tokensTerminated = "The token channel terminated without an EOF or error token."
 
-	return message
+// This method parses the source and the end of the token stream, stopping the
+// scanner before it returns. A parse that completes is returned even if the
+// context is done by then. If the parsing was interrupted because the context
+// is done, the error of the context is returned. Any panic other than a syntax
+// error or an interruption is passed along.
+func (v *parser) parseWithContext(context ctx.Context) (component abs.ComponentLike, err error) {
+	defer func() {
+		v.cancel()
+		var e = recover()
+		if e == nil {
+			return
+		}
+		component = nil
+		if e == tokensTerminated && context.Err() != nil {
+			// The parsing was interrupted when the scanner stopped.
+			err = context.Err()
+			return
+		}
+		var parseError = v.asParseError(e)
+		if parseError == nil {
+			panic(e)
+		}
+		err = parseError
+	}()
+	component = v.parseSource()
+	v.parseEnd()
+	return component, err
 }
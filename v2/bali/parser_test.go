@@ -11,12 +11,17 @@
 package bali_test
 
 import (
+	byt "bytes"
+	ctx "context"
 	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
 	bal "github.com/bali-nebula/go-component-framework/v2/bali"
 	ass "github.com/stretchr/testify/assert"
 	osx "os"
 	sts "strings"
 	tes "testing"
+	iot "testing/iotest"
+	tim "time"
 )
 
 const testDirectory = "./test/"
@@ -39,3 +44,234 @@ func TestParsingRoundtrips(t *tes.T) {
 		}
 	}
 }
+
+func TestParsingErrors(t *tes.T) {
+	var component, err = bal.TryParseComponent("[1, 2")
+	ass.Nil(t, component)
+	var parseError, ok = err.(*bal.ParseError)
+	ass.True(t, ok)
+	ass.Equal(t, 1, parseError.Line)
+	ass.Equal(t, bal.TokenEOL, parseError.Token.Type)
+	ass.Equal(t, "]", parseError.Expected)
+	ass.Equal(t, []string{"$collection", "$associations", "$association"}, parseError.Rules)
+	ass.NotContains(t, err.Error(), "\033[")
+	ass.Contains(t, parseError.Colorized(), "\033[36m")
+
+	component, err = bal.TryParseComponent("[1, 2]")
+	ass.Nil(t, err)
+	ass.NotNil(t, component)
+
+	var entity, _ = bal.TryParseEntity("~π")
+	ass.NotNil(t, entity)
+	_, err = bal.TryParseContext("($type: )")
+	ass.NotNil(t, err)
+	ass.Panics(t, func() {
+		bal.ParseComponent("[1, 2")
+	})
+}
+
+func TestParsingInvalidElements(t *tes.T) {
+	// These moments match the scanner but are not valid dates.
+	for _, moment := range []string{"<2024-02-30>", "<2024-02-31>"} {
+		var source = "[\n    $date: " + moment + "\n]"
+		var document = []byte(source + "\n")
+		var checkError = func(err error) {
+			var parseError, ok = err.(*bal.ParseError)
+			ass.True(t, ok, moment)
+			ass.Equal(t, 2, parseError.Line, moment)
+			ass.Equal(t, 12, parseError.Position, moment)
+			ass.Equal(t, bal.TokenMOMENT, parseError.Token.Type, moment)
+		}
+
+		var _, err = bal.TryParseDocument(document)
+		checkError(err)
+		_, err = bal.TryParseReader(byt.NewReader(document))
+		checkError(err)
+		_, err = bal.TryParseComponent(source)
+		checkError(err)
+		_, err = bal.ParseDocumentWithContext(ctx.Background(), document)
+		checkError(err)
+		_, err = bal.ParseReaderWithContext(ctx.Background(), byt.NewReader(document))
+		checkError(err)
+		var _, diagnostics = bal.ParseDocumentWithDiagnostics(document)
+		ass.Equal(t, 1, len(diagnostics), moment)
+		checkError(diagnostics[0])
+		_, diagnostics = bal.ParseComponentWithDiagnostics(source)
+		ass.Equal(t, 1, len(diagnostics), moment)
+		checkError(diagnostics[0])
+
+		_, err = bal.TryParseEntity(moment)
+		ass.IsType(t, &bal.ParseError{}, err, moment)
+		_, err = bal.TryParseContext("($date: " + moment + ")")
+		ass.IsType(t, &bal.ParseError{}, err, moment)
+		ass.Panics(t, func() {
+			bal.ParseContext("($date: " + moment + ")")
+		})
+	}
+	checkScanners(t)
+}
+
+func TestParsingDiagnostics(t *tes.T) {
+	var source = `[
+    1
+    2 3
+    [4, 5 6]
+    7
+]`
+	ass.Panics(t, func() {
+		bal.ParseComponent(source)
+	})
+	var component, diagnostics = bal.ParseComponentWithDiagnostics(source)
+	ass.NotNil(t, component)
+	ass.Equal(t, 2, len(diagnostics))
+	ass.Equal(t, 3, diagnostics[0].Line)
+	ass.Equal(t, "EOL", diagnostics[0].Expected)
+	ass.Equal(t, 4, diagnostics[1].Line)
+	ass.Equal(t, "]", diagnostics[1].Expected)
+
+	component, diagnostics = bal.ParseComponentWithDiagnostics(`[
+    $a: 1 2
+    $b: [1](
+        $c: 3 4
+        $d: 5
+    )
+    $e: {
+        let $x := 6 7
+        let $y := 8
+    }
+]`)
+	ass.NotNil(t, component)
+	ass.Equal(t, 3, len(diagnostics))
+	ass.Equal(t, 2, diagnostics[0].Line)
+	ass.Equal(t, 4, diagnostics[1].Line)
+	ass.Equal(t, 8, diagnostics[2].Line)
+
+	component, diagnostics = bal.ParseComponentWithDiagnostics("[\n    1 §\n    2\n]")
+	ass.Nil(t, component)
+	ass.Equal(t, 1, len(diagnostics))
+	ass.Equal(t, 2, diagnostics[0].Line)
+	ass.Equal(t, bal.TokenERROR, diagnostics[0].Token.Type)
+
+	component, diagnostics = bal.ParseComponentWithDiagnostics("[1, 2]")
+	ass.NotNil(t, component)
+	ass.Equal(t, 0, len(diagnostics))
+}
+
+func TestParsingSpans(t *tes.T) {
+	var source = `{
+    let $x := 1 + 2
+    return $x
+}`
+	var component = bal.ParseComponent(source)
+	var span = component.GetSpan()
+	ass.Equal(t, 1, span.GetStartLine())
+	ass.Equal(t, 1, span.GetStartPosition())
+	ass.Equal(t, 0, span.GetStartOffset())
+	ass.Equal(t, 4, span.GetEndLine())
+	ass.Equal(t, 2, span.GetEndPosition())
+	ass.Equal(t, len(source), span.GetEndOffset())
+
+	var procedure = component.GetEntity().(abs.ProcedureLike)
+	var statements = procedure.AsArray()
+	var clause = statements[0].GetMainClause().(abs.LetClauseLike)
+	span = clause.GetSpan()
+	ass.Equal(t, 2, span.GetStartLine())
+	ass.Equal(t, 5, span.GetStartPosition())
+	ass.Equal(t, 2, span.GetEndLine())
+	ass.Equal(t, 20, span.GetEndPosition())
+	var expression = clause.GetExpression().(abs.BinaryOperationLike)
+	span = expression.GetSpan()
+	ass.Equal(t, 15, span.GetStartPosition())
+	ass.Equal(t, 16, span.GetStartOffset())
+	ass.Equal(t, 20, span.GetEndPosition())
+	ass.Equal(t, 21, span.GetEndOffset())
+	ass.Equal(t, "1 + 2", source[16:21])
+}
+
+func TestParsingReader(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ./test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var expected, _ = osx.ReadFile(filename)
+			var reader = iot.OneByteReader(byt.NewReader(expected))
+			var component = bal.ParseReader(reader)
+			var document = bal.FormatDocument(component)
+			ass.Equal(t, string(expected), string(document))
+		}
+	}
+
+	var reader = iot.TimeoutReader(sts.NewReader("[1, 2]\n"))
+	_, err = bal.TryParseReader(reader)
+	ass.Error(t, err)
+
+	// An empty reader results in a parse error rather than a scanner panic.
+	_, err = bal.TryParseReader(sts.NewReader(""))
+	ass.Error(t, err)
+	_, err = bal.ParseReaderWithContext(ctx.Background(), sts.NewReader(""))
+	var _, ok = err.(*bal.ParseError)
+	ass.True(t, ok)
+	checkScanners(t)
+}
+
+// This type defines a reader that supplies a list that never ends.
+type endless struct {
+	started bool
+}
+
+func (v *endless) Read(bytes []byte) (int, error) {
+	if !v.started {
+		v.started = true
+		return copy(bytes, "[\n"), nil
+	}
+	for i := range bytes {
+		bytes[i] = "    1\n"[i%6]
+	}
+	return len(bytes) - len(bytes)%6, nil
+}
+
+func TestParsingWithContext(t *tes.T) {
+	// A syntax error stops the scanner.
+	var source = []byte("]\n" + sts.Repeat("[1, 2, 3]\n", 1000))
+	var _, err = bal.ParseDocumentWithContext(ctx.Background(), source)
+	var parseError, ok = err.(*bal.ParseError)
+	ass.True(t, ok)
+	ass.Equal(t, 1, parseError.Line)
+	checkScanners(t)
+
+	// A canceled context stops the parser and scanner.
+	var context, cancel = ctx.WithCancel(ctx.Background())
+	cancel()
+	_, err = bal.ParseDocumentWithContext(context, source)
+	ass.Equal(t, ctx.Canceled, err)
+	checkScanners(t)
+
+	// A deadline stops the parsing of an endless stream.
+	context, cancel = ctx.WithTimeout(ctx.Background(), 50*tim.Millisecond)
+	defer cancel()
+	_, err = bal.ParseReaderWithContext(context, &endless{})
+	ass.Equal(t, ctx.DeadlineExceeded, err)
+	checkScanners(t)
+
+	// A parse that completes is returned even if the context is done by then.
+	var component abs.ComponentLike
+	component, err = bal.ParseDocumentWithContext(expired{ctx.Background()}, []byte("[1, 2]\n"))
+	ass.NoError(t, err)
+	ass.Equal(t, "[1, 2]", bal.FormatCompactComponent(component))
+	checkScanners(t)
+}
+
+// This type defines a context that reports an expired deadline without ever
+// signaling that it is done, like one whose deadline passes just after the
+// parsing completes.
+type expired struct {
+	ctx.Context
+}
+
+func (v expired) Err() error {
+	return ctx.DeadlineExceeded
+}
@@ -29,15 +29,16 @@ func (v *parser) parseAcceptClause() (abs.AcceptClauseLike, *Token, bool) {
 		// This is not a accept clause.
 		return clause, token, false
 	}
+	var first = token
 	message, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("message",
+		var err = v.parseError(token, "message",
 			"$acceptClause",
 			"$message")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.AcceptClause(message)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -91,38 +92,35 @@ func (v *parser) parseBlock() (abs.BlockLike, *Token, bool) {
 	var block abs.BlockLike
 	expression, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$ifClause",
 			"$selectClause",
 			"$withClause",
 			"$whileClause",
 			"$onClause",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
 	_, token, ok = v.parseKeyword("do")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("do",
+		var err = v.parseError(token, "do",
 			"$ifClause",
 			"$selectClause",
 			"$withClause",
 			"$whileClause",
 			"$onClause")
-		panic(message)
+		panic(err)
 	}
 	procedure, token, ok = v.parseProcedure()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("procedure",
+		var err = v.parseError(token, "procedure",
 			"$ifClause",
 			"$selectClause",
 			"$withClause",
 			"$whileClause",
 			"$onClause",
 			"$procedure")
-		panic(message)
+		panic(err)
 	}
 	block = pro.Block(expression, procedure)
 	return block, token, true
@@ -149,14 +147,15 @@ func (v *parser) parseBreakClause() (abs.BreakClauseLike, *Token, bool) {
 		// This is not a break clause.
 		return clause, token, false
 	}
+	var first = token
 	_, token, ok = v.parseKeyword("loop")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("loop",
+		var err = v.parseError(token, "loop",
 			"$breakClause")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.BreakClause()
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -180,49 +179,46 @@ func (v *parser) parseCheckoutClause() (abs.CheckoutClauseLike, *Token, bool) {
 		// This is not a checkout clause.
 		return clause, token, false
 	}
+	var first = token
 	recipient, token, ok = v.parseRecipient()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("recipient",
+		var err = v.parseError(token, "recipient",
 			"$checkoutClause",
 			"$recipient")
-		panic(message)
+		panic(err)
 	}
 	_, _, ok = v.parseKeyword("at")
 	if ok {
 		// There is an at level part to this clause.
 		_, token, ok = v.parseKeyword("level")
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("level",
+			var err = v.parseError(token, "level",
 				"$checkoutClause")
-			panic(message)
+			panic(err)
 		}
 		level, token, ok = v.parseExpression()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("ordinal",
+			var err = v.parseError(token, "ordinal",
 				"$checkoutClause",
 				"$ordinal")
-			panic(message)
+			panic(err)
 		}
 	}
 	_, token, ok = v.parseKeyword("from")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("from",
+		var err = v.parseError(token, "from",
 			"$checkoutClause")
-		panic(message)
+		panic(err)
 	}
 	name, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("name",
+		var err = v.parseError(token, "name",
 			"$checkoutClause",
 			"$name")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.CheckoutClause(recipient, level, name)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -253,14 +249,15 @@ func (v *parser) parseContinueClause() (abs.ContinueClauseLike, *Token, bool) {
 		// This is not a continue clause.
 		return clause, token, false
 	}
+	var first = token
 	_, token, ok = v.parseKeyword("loop")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("loop",
+		var err = v.parseError(token, "loop",
 			"$continueClause")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.ContinueClause()
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -282,15 +279,16 @@ func (v *parser) parseDiscardClause() (abs.DiscardClauseLike, *Token, bool) {
 		// This is not a discard clause.
 		return clause, token, false
 	}
+	var first = token
 	document, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("document",
+		var err = v.parseError(token, "document",
 			"$discardClause",
 			"$document")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.DiscardClause(document)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -314,15 +312,16 @@ func (v *parser) parseIfClause() (abs.IfClauseLike, *Token, bool) {
 		// This is not an if clause.
 		return clause, token, false
 	}
+	var first = token
 	block, token, ok = v.parseBlock()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("condition",
+		var err = v.parseError(token, "condition",
 			"$ifClause",
 			"$condition")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.IfClause(block)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -349,11 +348,10 @@ func (v *parser) parseIndices() (abs.Sequential[abs.Expression], *Token, bool) {
 	index, token, ok = v.parseExpression()
 	// There must be at least one index.
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("expression",
+		var err = v.parseError(token, "expression",
 			"$indices",
 			"$expression")
-		panic(message)
+		panic(err)
 	}
 	for {
 		indices.AddValue(index)
@@ -365,19 +363,17 @@ func (v *parser) parseIndices() (abs.Sequential[abs.Expression], *Token, bool) {
 		}
 		index, token, ok = v.parseExpression()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("expression",
+			var err = v.parseError(token, "expression",
 				"$indices",
 				"$expression")
-			panic(message)
+			panic(err)
 		}
 	}
 	_, token, ok = v.parseDelimiter("]")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("]",
+		var err = v.parseError(token, "]",
 			"$indices")
-		panic(message)
+		panic(err)
 	}
 	return indices, token, true
 }
@@ -405,35 +401,29 @@ func (v *parser) parseInlineStatements() (abs.ProcedureLike, *Token, bool) {
 	var token *Token
 	var statement abs.StatementLike
 	var statements = col.List[abs.StatementLike]()
-	statement, token, ok = v.parseStatement()
-	if !ok {
-		// A non-empty statements must have at least one statement.
-		var message = v.formatError(token)
-		message += generateGrammar("statement",
-			"$procedure",
-			"$statements",
-			"$statement",
-		)
-		panic(message)
+	var parseStatement = func() {
+		statement, token, ok = v.parseStatement()
+		if !ok {
+			// A non-empty statements must have at least one statement and every
+			// ';' must be followed by a statement.
+			var err = v.parseError(token, "statement",
+				"$procedure",
+				"$statements",
+				"$statement",
+			)
+			panic(err)
+		}
 	}
 	for {
-		statements.AddValue(statement)
+		if !v.recoverItem(";", parseStatement) {
+			statements.AddValue(statement)
+		}
 		// Every subsequent statement must be preceded by a ';'.
 		_, token, ok = v.parseDelimiter(";")
 		if !ok {
 			// No more statements.
 			return statements, token, true
 		}
-		statement, token, ok = v.parseStatement()
-		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("statement",
-				"$procedure",
-				"$statements",
-				"$statement",
-			)
-			panic(message)
-		}
 	}
 }
 
@@ -446,24 +436,23 @@ func (v *parser) parseLetClause() (abs.LetClauseLike, *Token, bool) {
 	var operator abs.Operator
 	var expression abs.Expression
 	var clause abs.LetClauseLike
+	var first = v.peekToken()
 	// The recipient part is optional.
 	_, _, ok = v.parseKeyword("let")
 	if ok {
 		recipient, token, ok = v.parseRecipient()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("recipient",
+			var err = v.parseError(token, "recipient",
 				"$letClause",
 				"$recipient")
-			panic(message)
+			panic(err)
 		}
 		// The recipient requires an operator.
 		operator, token, ok = v.parseOperator()
 		if !ok || operator < abs.ASSIGN || operator > abs.QUOTIENT {
-			var message = v.formatError(token)
-			message += generateGrammar("operator",
+			var err = v.parseError(token, "operator",
 				"$letClause")
-			panic(message)
+			panic(err)
 		}
 	}
 	expression, token, ok = v.parseExpression()
@@ -472,6 +461,7 @@ func (v *parser) parseLetClause() (abs.LetClauseLike, *Token, bool) {
 		return clause, token, false
 	}
 	clause = pro.LetClauseWithRecipient(recipient, operator, expression)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -623,27 +613,46 @@ func (v *formatter) formatMainClause(mainClause abs.Clause) {
 // of statements for each blank line.
 func (v *parser) parseMultilineStatements() (abs.ProcedureLike, *Token, bool) {
 	var ok bool
+	var found bool
 	var token *Token
 	var statement abs.StatementLike
 	var statements = col.List[abs.StatementLike]()
-	for {
+	var parseStatement = func() {
 		// An optional statement allows blank lines.
 		statement, _, _ = v.parseStatement()
 		// Every optional statement must be followed by an EOL.
 		_, token, ok = v.parseEOL()
 		if !ok {
-			if statements.IsEmpty() {
-				var message = v.formatError(token)
-				message += generateGrammar("statement",
+			if !found {
+				var err = v.parseError(token, "statement",
 					"$procedure",
 					"$statements",
 					"$statement",
 				)
-				panic(message)
+				panic(err)
+			}
+			var closed = token.Type == TokenDELIMITER && token.Value == "}"
+			if v.recovering && !closed && token.Type != TokenEOF {
+				// Skip the rest of the line rather than ending the statements.
+				var err = v.parseError(token, "EOL",
+					"$procedure",
+					"$statements",
+				)
+				panic(err)
 			}
+		}
+	}
+	for {
+		if v.recoverItem("", parseStatement) {
+			// The invalid statement was skipped.
+			found = true
+			continue
+		}
+		if !ok {
 			// There were no more statements in this statements.
 			return statements, token, true
 		}
+		found = true
 		statements.AddValue(statement)
 	}
 }
@@ -661,30 +670,29 @@ func (v *parser) parseNotarizeClause() (abs.NotarizeClauseLike, *Token, bool) {
 		// This is not a notarize clause.
 		return clause, token, false
 	}
+	var first = token
 	document, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("document",
+		var err = v.parseError(token, "document",
 			"$notarizeClause",
 			"$document")
-		panic(message)
+		panic(err)
 	}
 	_, token, ok = v.parseKeyword("as")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("as",
+		var err = v.parseError(token, "as",
 			"$notarizeClause")
-		panic(message)
+		panic(err)
 	}
 	name, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("name",
+		var err = v.parseError(token, "name",
 			"$notarizeClause",
 			"$name")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.NotarizeClause(document, name)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -713,13 +721,13 @@ func (v *parser) parseOnClause() (abs.OnClauseLike, *Token, bool) {
 		// This is not an on clause.
 		return clause, token, false
 	}
+	var first = token
 	failure, token, ok = v.parseSymbol()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("failure",
+		var err = v.parseError(token, "failure",
 			"$onClause",
 			"$failure")
-		panic(message)
+		panic(err)
 	}
 	for {
 		_, token, ok = v.parseKeyword("matching")
@@ -728,23 +736,22 @@ func (v *parser) parseOnClause() (abs.OnClauseLike, *Token, bool) {
 		}
 		block, token, ok = v.parseBlock()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("pattern",
+			var err = v.parseError(token, "pattern",
 				"$onClause",
 				"$pattern")
-			panic(message)
+			panic(err)
 		}
 		blocks.AddValue(block)
 	}
 	// There must be at least one matching block expression.
 	if blocks.IsEmpty() {
-		var message = v.formatError(token)
-		message += generateGrammar("pattern",
+		var err = v.parseError(token, "pattern",
 			"$onClause",
 			"$pattern")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.OnClause(failure, blocks)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -778,30 +785,29 @@ func (v *parser) parsePostClause() (abs.PostClauseLike, *Token, bool) {
 		// This is not a post clause.
 		return clause, token, false
 	}
+	var first = token
 	message, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("message",
+		var err = v.parseError(token, "message",
 			"$postClause",
 			"$message")
-		panic(message)
+		panic(err)
 	}
 	_, token, ok = v.parseKeyword("to")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("to",
+		var err = v.parseError(token, "to",
 			"$postClause")
-		panic(message)
+		panic(err)
 	}
 	bag, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("bag",
+		var err = v.parseError(token, "bag",
 			"$postClause",
 			"$bag")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.PostClause(message, bag)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -840,23 +846,21 @@ func (v *parser) parseProcedure() (abs.ProcedureLike, *Token, bool) {
 		procedure, token, ok = v.parseInlineStatements()
 	}
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("statements",
+		var err = v.parseError(token, "statements",
 			"$procedure",
 			"$statements",
 			"$statement",
 		)
-		panic(message)
+		panic(err)
 	}
 	_, token, ok = v.parseDelimiter("}")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("}",
+		var err = v.parseError(token, "}",
 			"$procedure",
 			"$statements",
 			"$statement",
 		)
-		panic(message)
+		panic(err)
 	}
 	return procedure, token, true
 }
@@ -864,10 +868,34 @@ func (v *parser) parseProcedure() (abs.ProcedureLike, *Token, bool) {
 // This method adds the canonical format for the specified procedure to the
 // state of the formatter.
 func (v *formatter) formatProcedure(procedure abs.ProcedureLike) {
+	var size = procedure.GetSize()
+	var inline = v.isInline(false, func(trial *formatter) {
+		trial.formatProcedure(procedure)
+	})
 	v.AppendString("{")
-	switch procedure.GetSize() {
-	case 0:
+	switch {
+	case size == 0:
 		v.AppendString(" ")
+	case inline:
+		var separator string
+		var iterator = col.Iterator[abs.StatementLike](procedure)
+		for iterator.HasNext() {
+			var statement = iterator.GetNext()
+			if statement == nil {
+				if !v.compact {
+					// A blank line cannot be inlined.
+					v.AppendString(EOL)
+				}
+				continue
+			}
+			v.AppendString(separator)
+			v.formatStatement(statement)
+			separator = "; "
+		}
+		if len(separator) == 0 {
+			// The procedure contained only blank lines.
+			v.AppendString(" ")
+		}
 	default:
 		var iterator = col.Iterator[abs.StatementLike](procedure)
 		v.depth++
@@ -899,15 +927,16 @@ func (v *parser) parsePublishClause() (abs.PublishClauseLike, *Token, bool) {
 		// This is not a publish clause.
 		return clause, token, false
 	}
+	var first = token
 	event, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("event",
+		var err = v.parseError(token, "event",
 			"$publishClause",
 			"$event")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.PublishClause(event)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -957,15 +986,16 @@ func (v *parser) parseRejectClause() (abs.RejectClauseLike, *Token, bool) {
 		// This is not a reject clause.
 		return clause, token, false
 	}
+	var first = token
 	message, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("message",
+		var err = v.parseError(token, "message",
 			"$rejectClause",
 			"$message")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.RejectClause(message)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -990,30 +1020,29 @@ func (v *parser) parseRetrieveClause() (abs.RetrieveClauseLike, *Token, bool) {
 		// This is not a retrieve clause.
 		return clause, token, false
 	}
+	var first = token
 	recipient, token, ok = v.parseRecipient()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("recipient",
+		var err = v.parseError(token, "recipient",
 			"$retrieveClause",
 			"$recipient")
-		panic(message)
+		panic(err)
 	}
 	_, token, ok = v.parseKeyword("from")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("from",
+		var err = v.parseError(token, "from",
 			"$retrieveClause")
-		panic(message)
+		panic(err)
 	}
 	bag, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("bag",
+		var err = v.parseError(token, "bag",
 			"$retrieveClause",
 			"$bag")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.RetrieveClause(recipient, bag)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -1040,15 +1069,16 @@ func (v *parser) parseReturnClause() (abs.ReturnClauseLike, *Token, bool) {
 		// This is not a return clause.
 		return clause, token, false
 	}
+	var first = token
 	result, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("result",
+		var err = v.parseError(token, "result",
 			"$returnClause",
 			"$result")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.ReturnClause(result)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -1073,30 +1103,29 @@ func (v *parser) parseSaveClause() (abs.SaveClauseLike, *Token, bool) {
 		// This is not a save clause.
 		return clause, token, false
 	}
+	var first = token
 	document, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("document",
+		var err = v.parseError(token, "document",
 			"$saveClause",
 			"$document")
-		panic(message)
+		panic(err)
 	}
 	_, token, ok = v.parseKeyword("as")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("as",
+		var err = v.parseError(token, "as",
 			"$saveClause")
-		panic(message)
+		panic(err)
 	}
 	recipient, token, ok = v.parseRecipient()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("recipient",
+		var err = v.parseError(token, "recipient",
 			"$saveClause",
 			"$recipient")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.SaveClause(document, recipient)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -1125,13 +1154,13 @@ func (v *parser) parseSelectClause() (abs.SelectClauseLike, *Token, bool) {
 		// This is not a select clause.
 		return clause, token, false
 	}
+	var first = token
 	target, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("target",
+		var err = v.parseError(token, "target",
 			"$selectClause",
 			"$target")
-		panic(message)
+		panic(err)
 	}
 	for {
 		_, token, ok = v.parseKeyword("matching")
@@ -1140,23 +1169,22 @@ func (v *parser) parseSelectClause() (abs.SelectClauseLike, *Token, bool) {
 		}
 		block, token, ok = v.parseBlock()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("pattern",
+			var err = v.parseError(token, "pattern",
 				"$selectClause",
 				"$pattern")
-			panic(message)
+			panic(err)
 		}
 		blocks.AddValue(block)
 	}
 	// There must be at least one matching block expression.
 	if blocks.IsEmpty() {
-		var message = v.formatError(token)
-		message += generateGrammar("pattern",
+		var err = v.parseError(token, "pattern",
 			"$selectClause",
 			"$pattern")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.SelectClause(target, blocks)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -1189,12 +1217,12 @@ func (v *parser) parseStatement() (abs.StatementLike, *Token, bool) {
 	if ok {
 		_, token, ok = v.parseEOL()
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("EOL",
+			var err = v.parseError(token, "EOL",
 				"$statement")
-			panic(message)
+			panic(err)
 		}
 	}
+	var first = v.peekToken()
 	mainClause, token, ok = v.parseMainClause()
 	if !ok {
 		// This is not a statement.
@@ -1205,6 +1233,7 @@ func (v *parser) parseStatement() (abs.StatementLike, *Token, bool) {
 	statement = pro.StatementWithHandler(mainClause, onClause)
 	statement.SetAnnotation(annotation)
 	statement.SetNote(note)
+	statement.SetSpan(v.makeSpan(first))
 	return statement, token, true
 }
 
@@ -1212,7 +1241,7 @@ func (v *parser) parseStatement() (abs.StatementLike, *Token, bool) {
 // state of the formatter.
 func (v *formatter) formatStatement(statement abs.StatementLike) {
 	var annotation = statement.GetAnnotation()
-	if annotation != nil {
+	if annotation != nil && !v.compact {
 		v.formatAnnotation(annotation)
 		v.AppendNewline()
 	}
@@ -1223,7 +1252,7 @@ func (v *formatter) formatStatement(statement abs.StatementLike) {
 		v.formatOnClause(onClause)
 	}
 	var note = statement.GetNote()
-	if note != nil {
+	if note != nil && !v.compact {
 		v.formatNote(note)
 	}
 }
@@ -1240,15 +1269,16 @@ func (v *parser) parseThrowClause() (abs.ThrowClauseLike, *Token, bool) {
 		// This is not a throw clause.
 		return clause, token, false
 	}
+	var first = token
 	exception, token, ok = v.parseExpression()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("exception",
+		var err = v.parseError(token, "exception",
 			"$throwClause",
 			"$exception")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.ThrowClause(exception)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -1272,15 +1302,16 @@ func (v *parser) parseWhileClause() (abs.WhileClauseLike, *Token, bool) {
 		// This is not a while clause.
 		return clause, token, false
 	}
+	var first = token
 	block, token, ok = v.parseBlock()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("condition",
+		var err = v.parseError(token, "condition",
 			"$whileClause",
 			"$condition")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.WhileClause(block)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
@@ -1305,37 +1336,35 @@ func (v *parser) parseWithClause() (abs.WithClauseLike, *Token, bool) {
 		// This is not a with clause.
 		return clause, token, false
 	}
+	var first = token
 	_, token, ok = v.parseKeyword("each")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("each",
+		var err = v.parseError(token, "each",
 			"$withClause")
-		panic(message)
+		panic(err)
 	}
 	item, token, ok = v.parseSymbol()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("item",
+		var err = v.parseError(token, "item",
 			"$withClause",
 			"$item")
-		panic(message)
+		panic(err)
 	}
 	_, token, ok = v.parseKeyword("in")
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("in",
+		var err = v.parseError(token, "in",
 			"$withClause")
-		panic(message)
+		panic(err)
 	}
 	block, token, ok = v.parseBlock()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("sequence",
+		var err = v.parseError(token, "sequence",
 			"$withClause",
 			"$sequence")
-		panic(message)
+		panic(err)
 	}
 	clause = pro.WithClause(item, block)
+	clause.SetSpan(v.makeSpan(first))
 	return clause, token, true
 }
 
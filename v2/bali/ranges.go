@@ -16,10 +16,9 @@ import (
 	ele "github.com/bali-nebula/go-component-framework/v2/elements"
 	ran "github.com/bali-nebula/go-component-framework/v2/ranges"
 	str "github.com/bali-nebula/go-component-framework/v2/strings"
-	mat "math"
+	uti "github.com/bali-nebula/go-component-framework/v2/utilities"
 	ref "reflect"
 	stc "strconv"
-	utf "unicode/utf8"
 )
 
 // UNIVERSAL CONSTRUCTORS
@@ -29,25 +28,25 @@ func Character(value abs.Value) abs.CharacterLike {
 	var character abs.CharacterLike
 	switch actual := value.(type) {
 	case uint:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case uint8:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case uint16:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case uint32:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case uint64:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case int:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case int8:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case int16:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case int32:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case int64:
-		character = ele.CharacterFromInteger(int(actual))
+		character = ele.Character().FromInteger(int(actual))
 	case abs.CharacterLike:
 		character = actual
 	default:
@@ -62,25 +61,25 @@ func Integer(value abs.Value) abs.IntegerLike {
 	var integer abs.IntegerLike
 	switch actual := value.(type) {
 	case uint:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case uint8:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case uint16:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case uint32:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case uint64:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case int:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case int8:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case int16:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case int32:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case int64:
-		integer = ele.IntegerFromInteger(int(actual))
+		integer = ele.Integer().FromInteger(int(actual))
 	case abs.IntegerLike:
 		integer = actual
 	default:
@@ -95,29 +94,29 @@ func Float(value abs.Value) abs.FloatLike {
 	var real_ abs.FloatLike
 	switch actual := value.(type) {
 	case uint:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case uint8:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case uint16:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case uint32:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case uint64:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case int:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case int8:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case int16:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case int32:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case int64:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case float32:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case float64:
-		real_ = ele.FloatFromFloat(float64(actual))
+		real_ = ele.Float().FromFloat(float64(actual))
 	case abs.FloatLike:
 		real_ = actual
 	default:
@@ -176,52 +175,52 @@ func Interval(first abs.Value, extent abs.Extent, last abs.Value, context abs.Co
 	var entity abs.Entity
 	switch actual := first.(type) {
 	case uint:
-		var actualFirst = ele.IntegerFromInteger(int(first.(uint)))
-		var actualLast = ele.IntegerFromInteger(int(last.(uint)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(uint)))
+		var actualLast = ele.Integer().FromInteger(int(last.(uint)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case uint8:
-		var actualFirst = ele.IntegerFromInteger(int(first.(uint8)))
-		var actualLast = ele.IntegerFromInteger(int(last.(uint8)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(uint8)))
+		var actualLast = ele.Integer().FromInteger(int(last.(uint8)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case uint16:
-		var actualFirst = ele.IntegerFromInteger(int(first.(uint16)))
-		var actualLast = ele.IntegerFromInteger(int(last.(uint16)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(uint16)))
+		var actualLast = ele.Integer().FromInteger(int(last.(uint16)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case uint32:
-		var actualFirst = ele.IntegerFromInteger(int(first.(uint32)))
-		var actualLast = ele.IntegerFromInteger(int(last.(uint32)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(uint32)))
+		var actualLast = ele.Integer().FromInteger(int(last.(uint32)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case uint64:
-		var actualFirst = ele.IntegerFromInteger(int(first.(uint64)))
-		var actualLast = ele.IntegerFromInteger(int(last.(uint64)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(uint64)))
+		var actualLast = ele.Integer().FromInteger(int(last.(uint64)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case int:
-		var actualFirst = ele.IntegerFromInteger(int(first.(int)))
-		var actualLast = ele.IntegerFromInteger(int(last.(int)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(int)))
+		var actualLast = ele.Integer().FromInteger(int(last.(int)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case int8:
-		var actualFirst = ele.IntegerFromInteger(int(first.(int8)))
-		var actualLast = ele.IntegerFromInteger(int(last.(int8)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(int8)))
+		var actualLast = ele.Integer().FromInteger(int(last.(int8)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case int16:
-		var actualFirst = ele.IntegerFromInteger(int(first.(int16)))
-		var actualLast = ele.IntegerFromInteger(int(last.(int16)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(int16)))
+		var actualLast = ele.Integer().FromInteger(int(last.(int16)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case int32:
-		var actualFirst = ele.IntegerFromInteger(int(first.(int32)))
-		var actualLast = ele.IntegerFromInteger(int(last.(int32)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(int32)))
+		var actualLast = ele.Integer().FromInteger(int(last.(int32)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case int64:
-		var actualFirst = ele.IntegerFromInteger(int(first.(int64)))
-		var actualLast = ele.IntegerFromInteger(int(last.(int64)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(int64)))
+		var actualLast = ele.Integer().FromInteger(int(last.(int64)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case float32:
-		var actualFirst = ele.IntegerFromInteger(int(first.(float32)))
-		var actualLast = ele.IntegerFromInteger(int(last.(float32)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(float32)))
+		var actualLast = ele.Integer().FromInteger(int(last.(float32)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case float64:
-		var actualFirst = ele.IntegerFromInteger(int(first.(float64)))
-		var actualLast = ele.IntegerFromInteger(int(last.(float64)))
+		var actualFirst = ele.Integer().FromInteger(int(first.(float64)))
+		var actualLast = ele.Integer().FromInteger(int(last.(float64)))
 		entity = ran.Interval(actualFirst, extent, actualLast)
 	case abs.DurationLike:
 		var actualFirst = first.(abs.DurationLike)
@@ -247,52 +246,52 @@ func Continuum(first abs.Value, extent abs.Extent, last abs.Value, context abs.C
 	var entity abs.Entity
 	switch actual := first.(type) {
 	case uint:
-		var actualFirst = ele.FloatFromFloat(float64(first.(uint)))
-		var actualLast = ele.FloatFromFloat(float64(last.(uint)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(uint)))
+		var actualLast = ele.Float().FromFloat(float64(last.(uint)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case uint8:
-		var actualFirst = ele.FloatFromFloat(float64(first.(uint8)))
-		var actualLast = ele.FloatFromFloat(float64(last.(uint8)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(uint8)))
+		var actualLast = ele.Float().FromFloat(float64(last.(uint8)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case uint16:
-		var actualFirst = ele.FloatFromFloat(float64(first.(uint16)))
-		var actualLast = ele.FloatFromFloat(float64(last.(uint16)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(uint16)))
+		var actualLast = ele.Float().FromFloat(float64(last.(uint16)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case uint32:
-		var actualFirst = ele.FloatFromFloat(float64(first.(uint32)))
-		var actualLast = ele.FloatFromFloat(float64(last.(uint32)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(uint32)))
+		var actualLast = ele.Float().FromFloat(float64(last.(uint32)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case uint64:
-		var actualFirst = ele.FloatFromFloat(float64(first.(uint64)))
-		var actualLast = ele.FloatFromFloat(float64(last.(uint64)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(uint64)))
+		var actualLast = ele.Float().FromFloat(float64(last.(uint64)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case int:
-		var actualFirst = ele.FloatFromFloat(float64(first.(int)))
-		var actualLast = ele.FloatFromFloat(float64(last.(int)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(int)))
+		var actualLast = ele.Float().FromFloat(float64(last.(int)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case int8:
-		var actualFirst = ele.FloatFromFloat(float64(first.(int8)))
-		var actualLast = ele.FloatFromFloat(float64(last.(int8)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(int8)))
+		var actualLast = ele.Float().FromFloat(float64(last.(int8)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case int16:
-		var actualFirst = ele.FloatFromFloat(float64(first.(int16)))
-		var actualLast = ele.FloatFromFloat(float64(last.(int16)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(int16)))
+		var actualLast = ele.Float().FromFloat(float64(last.(int16)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case int32:
-		var actualFirst = ele.FloatFromFloat(float64(first.(int32)))
-		var actualLast = ele.FloatFromFloat(float64(last.(int32)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(int32)))
+		var actualLast = ele.Float().FromFloat(float64(last.(int32)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case int64:
-		var actualFirst = ele.FloatFromFloat(float64(first.(int64)))
-		var actualLast = ele.FloatFromFloat(float64(last.(int64)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(int64)))
+		var actualLast = ele.Float().FromFloat(float64(last.(int64)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case float32:
-		var actualFirst = ele.FloatFromFloat(float64(first.(float32)))
-		var actualLast = ele.FloatFromFloat(float64(last.(float32)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(float32)))
+		var actualLast = ele.Float().FromFloat(float64(last.(float32)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case float64:
-		var actualFirst = ele.FloatFromFloat(float64(first.(float64)))
-		var actualLast = ele.FloatFromFloat(float64(last.(float64)))
+		var actualFirst = ele.Float().FromFloat(float64(first.(float64)))
+		var actualLast = ele.Float().FromFloat(float64(last.(float64)))
 		entity = ran.Continuum(actualFirst, extent, actualLast)
 	case abs.PercentageLike:
 		var actualFirst = first.(abs.PercentageLike)
@@ -341,17 +340,14 @@ func (v *parser) parseEndpoint() (abs.Primitive, *Token, bool) {
 	if !ok {
 		endpoint, token, ok = v.parseQuote()
 	}
-	if !ok {
-		endpoint, token, ok = v.parseFloat()
-	}
 	if !ok {
 		endpoint, token, ok = v.parseInteger()
 	}
 	if !ok {
-		endpoint, token, ok = v.parseResource()
+		endpoint, token, ok = v.parseFloat()
 	}
 	if !ok {
-		endpoint, token, ok = v.parseCharacter()
+		endpoint, token, ok = v.parseResource()
 	}
 	if !ok {
 		endpoint, token, ok = v.parseSymbol()
@@ -443,20 +439,18 @@ func (v *parser) parseRange() (abs.Range, *Token, bool) {
 	}
 	last, token, ok = v.parseEndpoint()
 	if !ok {
-		var message = v.formatError(token)
-		message += generateGrammar("primitive",
+		var err = v.parseError(token, "primitive",
 			"$range",
 			"$primitive")
-		panic(message)
+		panic(err)
 	}
 	right, token, ok = v.parseDelimiter("]")
 	if !ok {
 		right, token, ok = v.parseDelimiter(")")
 		if !ok {
-			var message = v.formatError(token)
-			message += generateGrammar("bracket",
+			var err = v.parseError(token, "bracket",
 				"$range")
-			panic(message)
+			panic(err)
 		}
 	}
 	switch {
@@ -473,6 +467,14 @@ func (v *parser) parseRange() (abs.Range, *Token, bool) {
 		var message = fmt.Sprintf("Expected valid range brackets but received:%q and %q\n", left, right)
 		panic(message)
 	}
+	// An integer endpoint is promoted to a real number if the other endpoint is
+	// a real number (e.g. an infinite endpoint).
+	switch {
+	case ele.GetType(first) == "Integer" && ele.GetType(last) == "Float":
+		first = ele.Float().FromFloat(float64(first.(abs.IntegerLike).AsInteger()))
+	case ele.GetType(first) == "Float" && ele.GetType(last) == "Integer":
+		last = ele.Float().FromFloat(float64(last.(abs.IntegerLike).AsInteger()))
+	}
 	if ref.TypeOf(first) != ref.TypeOf(last) {
 		var message = fmt.Sprintf("The range endpoints have two different types: %T and %T\n", first, last)
 		panic(message)
@@ -579,25 +581,17 @@ func (v *parser) parseInteger() (abs.IntegerLike, *Token, bool) {
 		v.backupOne(token)
 		return number, token, false
 	}
-	var matches = bytesToStrings(realScanner.FindSubmatch([]byte(token.Value)))
-	if len(matches) > 0 {
-		// The token is a real number.
-		v.backupOne(token)
-		return number, token, false
-	}
-	var err any
-	var integer int64
-	matches = bytesToStrings(integerScanner.FindSubmatch([]byte(token.Value)))
-	if len(matches) == 0 {
+	var match = uti.IntegerMatcher.FindString(token.Value)
+	if match != token.Value {
 		// The token is not an integer.
 		v.backupOne(token)
 		return number, token, false
 	}
-	integer, err = stc.ParseInt(matches[0], 10, 0)
+	var integer, err = stc.ParseInt(match, 10, 0)
 	if err != nil {
 		panic(fmt.Sprintf("The integer was not parsable: %v", err))
 	}
-	number = ele.IntegerFromInteger(integer)
+	number = ele.Integer().FromInteger(int(integer))
 	return number, token, true
 }
 
@@ -613,31 +607,30 @@ func (v *formatter) formatInteger(number abs.IntegerLike) {
 func (v *parser) parseFloat() (abs.FloatLike, *Token, bool) {
 	var real_ abs.FloatLike
 	var token = v.nextToken()
-	if token.Type != TokenREAL {
+	if token.Type != TokenNUMBER {
+		// The token is not numerical.
+		v.backupOne(token)
+		return real_, token, false
+	}
+	var match = uti.FloatMatcher.FindString(token.Value)
+	if match != token.Value {
+		// The token is not a real number.
 		v.backupOne(token)
 		return real_, token, false
 	}
-	var real_ = ele.FloatFromString(token.Value)
+	real_ = ele.Float().FromString(match)
 	return real_, token, true
 }
 
 // This method adds the canonical format for the specified real number to the
 // state of the formatter.
 func (v *formatter) formatFloat(real_ abs.FloatLike) {
-	v.AppendString(real_.AsString())
-}
-
-// This method attempts to parse a character. It returns the character and whether or not
-// the character was successfully parsed.
-func (v *parser) parseCharacter() (abs.CharacterLike, *Token, bool) {
-	var character abs.CharacterLike
-	var token = v.nextToken()
-	if token.Type != TokenCHARACTER {
-		v.backupOne(token)
-		return character, token, false
+	if real_.IsInfinite() && !real_.IsNegative() {
+		// A positive infinite endpoint is always signed.
+		v.AppendString("+∞")
+		return
 	}
-	var character = ele.CharacterFromString(token.Value)
-	return character, token, true
+	v.AppendString(real_.AsString())
 }
 
 // This method adds the canonical format for the specified character to the state of
@@ -27,7 +27,7 @@ var firstPattern, lastPattern = bal.Pattern(`"ca+d"?`), bal.Pattern(`"ca+t"?`)
 var firstPercentage, lastPercentage = bal.Percentage(-10), bal.Percentage(150)
 var firstProbability, lastProbability = bal.Probability(false), bal.Probability(true)
 var firstQuote, lastQuote = bal.Quote(`"High Five"`), bal.Quote(`"High Seven"`)
-var firstReal, lastReal = bal.Real(0), bal.Real(1.23456)
+var firstReal, lastReal = bal.Float(0), bal.Float(1.23456)
 var firstResource, lastResource = bal.Resource("<https://craterdog.com>"), bal.Resource("<https://google.com>")
 var firstString, lastString = "aa", "fe"
 var firstSymbol, lastSymbol = bal.Symbol("$bar"), bal.Symbol("$foo")
@@ -11,9 +11,12 @@
 package bali
 
 import (
+	bufio "bufio"
 	byt "bytes"
+	ctx "context"
 	//fmt "fmt"
 	uti "github.com/bali-nebula/go-component-framework/v2/utilities"
+	io "io"
 	reg "regexp"
 	sts "strings"
 	uni "unicode"
@@ -60,13 +63,93 @@ const (
 // of bytes. The scanner will automatically generating tokens that match the
 // corresponding regular expressions.
 func ScanTokens(source []byte, tokens chan Token) *scanner {
-	var v = &scanner{source: source, line: 1, position: 1, tokens: tokens}
+	return ScanTokensWithContext(ctx.Background(), source, tokens)
+}
+
+// This function creates a new scanner like ScanTokens that stops scanning and
+// closes the token channel once the specified context is done.
+func ScanTokensWithContext(context ctx.Context, source []byte, tokens chan Token) *scanner {
+	var v = &scanner{context: context, source: source, line: 1, position: 1, tokens: tokens}
 	go v.generateTokens() // Start scanning in the background.
 	return v
 }
 
+// This function creates a new scanner that reads its source bytes from the
+// specified reader a line at a time. Since sending a token to a full channel
+// blocks, the source is only read as quickly as the tokens are received.
+func ScanReader(reader io.Reader, tokens chan Token) *scanner {
+	return ScanReaderWithContext(ctx.Background(), reader, tokens)
+}
+
+// This function creates a new scanner like ScanReader that stops scanning and
+// closes the token channel once the specified context is done. A read that is
+// already in progress cannot be interrupted.
+func ScanReaderWithContext(context ctx.Context, reader io.Reader, tokens chan Token) *scanner {
+	var v = &scanner{context: context, reader: bufio.NewReader(reader), line: 1, position: 1, tokens: tokens}
+	go v.generateTokens() // Start scanning in the background.
+	return v
+}
+
+// This function scans the specified BDN source string and calls the specified
+// function for each consecutive segment of it. A segment is either the source
+// of a token, which is passed along with the token, or the source between two
+// tokens (e.g. whitespace), which is passed with a nil token. Any source following an
+// invalid token is passed as a single segment with a nil token. It is used to
+// highlight the tokens in a source string.
+func WalkTokens(source string, visit func(text string, token *Token)) {
+	var tokens = make(chan Token, 256)
+	ScanTokens([]byte(source), tokens)
+	var next = 0
+	for token := range tokens {
+		if token.Type == TokenEOF || token.Type == TokenERROR {
+			break
+		}
+		if token.Length == 0 {
+			// The token does not appear in the source.
+			continue
+		}
+		if next < token.Offset {
+			visit(source[next:token.Offset], nil)
+		}
+		next = token.Offset + token.Length
+		visit(source[token.Offset:next], &token)
+	}
+	for range tokens {
+		// Drain any remaining tokens so the scanner can finish.
+	}
+	if next < len(source) {
+		visit(source[next:], nil)
+	}
+}
+
 // SCANNER IMPLEMENTATION
 
+// This private function returns the specified source bytes up to and including
+// the first closing delimiter that begins a line (after any spaces). Matching a
+// multi-line token against only these bytes keeps the scanning linear in the
+// length of the source bytes. If the source bytes do not begin with the opening
+// delimiter followed by an EOL character, no multi-line token can match so no
+// bytes are returned. Otherwise a quote, for example, would be bounded by the
+// closing delimiter of some later narrative.
+func boundedTo(source []byte, opening, closing string) []byte {
+	var eol = []byte(EOL)
+	if !byt.HasPrefix(source, []byte(opening+EOL)) {
+		return nil
+	}
+	var offset = 0
+	for {
+		var index = byt.Index(source[offset:], eol)
+		if index < 0 {
+			return source
+		}
+		offset += index + len(eol)
+		var line = byt.TrimLeft(source[offset:], " ")
+		if byt.HasPrefix(line, []byte(closing)) {
+			return source[:len(source)-len(line)+len(closing)]
+		}
+	}
+}
+
 // This private function converts an array of byte arrays into an array of
 // strings.
 func bytesToStrings(bytes [][]byte) []string {
@@ -77,6 +160,52 @@ func bytesToStrings(bytes [][]byte) []string {
 	return strings
 }
 
+// This private function returns an array containing the token that the
+// specified matcher finds at the beginning of the specified source bytes, or
+// nil if there is none. The submatches are not needed by the scanner so only
+// the indices of the whole match are found, which is much faster.
+func matchToken(matcher *reg.Regexp, source []byte) []string {
+	var indices = matcher.FindIndex(source)
+	if indices == nil {
+		return nil
+	}
+	return []string{string(source[:indices[1]])}
+}
+
+// This private function determines whether or not the specified bytes begin
+// with a decimal digit.
+func isDigit(bytes []byte) bool {
+	return len(bytes) > 0 && bytes[0] >= '0' && bytes[0] <= '9'
+}
+
+// This type defines a set of runes. Nearly every rune in the source bytes is an
+// ASCII rune so those are kept in a bit set that can be checked very quickly.
+type runeSet struct {
+	ascii  [2]uint64 // One bit for each ASCII rune.
+	others string    // The non-ASCII runes.
+}
+
+// This private function returns the set of runes in the specified string.
+func runeSetOf(runes string) runeSet {
+	var set runeSet
+	for _, r := range runes {
+		if r < utf.RuneSelf {
+			set.ascii[r>>6] |= 1 << (r & 63)
+		} else {
+			set.others += string(r)
+		}
+	}
+	return set
+}
+
+// This method determines whether or not the specified rune is in this set.
+func (v runeSet) contains(r rune) bool {
+	if r < utf.RuneSelf {
+		return r >= 0 && v.ascii[r>>6]&(1<<(r&63)) != 0
+	}
+	return sts.ContainsRune(v.others, r)
+}
+
 // This type defines the structure and methods for the scanner agent. The source
 // bytes can be viewed like this:
 //
@@ -86,21 +215,64 @@ func bytesToStrings(bytes [][]byte) []string {
 // Runes can be one to eight bytes long.
 
 type scanner struct {
+	context   ctx.Context // The context that stops the scanning when it is done.
 	source    []byte
-	firstByte int // The zero based index of the first possible byte in the next token.
-	nextByte  int // The zero based index of the next possible byte in the next token.
-	line      int // The line number in the source bytes of the next rune.
-	position  int // The position in the current line of the first rune in the next token.
+	firstByte int  // The zero based index of the first possible byte in the next token.
+	nextByte  int  // The zero based index of the next possible byte in the next token.
+	lineEnd   int  // The zero based index of the byte following the current line.
+	line      int  // The line number in the source bytes of the next rune.
+	position  int  // The position in the current line of the first rune in the next token.
+	leading   rune // The first rune in the next token.
+	discarded int  // The number of source bytes that have been read and discarded.
+	reader    *bufio.Reader
+	failure   error // Any error that occurred while reading the source bytes.
 	tokens    chan Token
 }
 
+// These variables define the runes that may begin each token type. The scanner
+// only tries to match the token types that may begin with the next rune in the
+// source bytes. Identifiers may begin with any letter so they are handled
+// separately.
+var (
+	leadersANGLE       = runeSetOf("~")
+	leadersBINARY      = runeSetOf("'")
+	leadersBOOLEAN     = runeSetOf("ft")
+	leadersBYTECODE    = runeSetOf("'")
+	leadersCOMMENT     = runeSetOf("!")
+	leadersDELIMITER   = runeSetOf("≠~}|{^][@?>=<;:/.-,+*)(&")
+	leadersDURATION    = runeSetOf("~")
+	leadersEOL         = runeSetOf(EOL)
+	leadersINTRINSIC   = runeSetOf("ACDELU")
+	leadersKEYWORD     = runeSetOf("AIMNOSXabcdefilmnoprstw")
+	leadersMOMENT      = runeSetOf("<")
+	leadersNAME        = runeSetOf("/")
+	leadersNARRATIVE   = runeSetOf(`"`)
+	leadersNOTE        = runeSetOf("!")
+	leadersNUMBER      = runeSetOf("(+-0123456789eiptuπτφ∞")
+	leadersPATTERN     = runeSetOf(`"an`)
+	leadersPERCENTAGE  = runeSetOf("+-0123456789eptπτφ")
+	leadersPROBABILITY = runeSetOf(".1")
+	leadersQUOTE       = runeSetOf(`"`)
+	leadersRESOURCE    = runeSetOf("<")
+	leadersSYMBOL      = runeSetOf("$")
+	leadersTAG         = runeSetOf("#")
+	leadersVERSION     = runeSetOf("v")
+	leadersWHITESPACE  = runeSetOf(" ")
+)
+
 // This method determines whether or not the scanner is at the end of the source
 // bytes and adds an EOF token with the current scanner information to the token
 // channel if it is at the end.
 func (v *scanner) atEOF() bool {
 	if v.nextByte == len(v.source) {
-		// The last byte in a POSIX standard file must be an EOL character.
-		if byt.HasPrefix(v.source[v.nextByte-1:], []byte(EOL)) {
+		if v.failure != nil {
+			// The source bytes could not be read in their entirety.
+			v.emitToken(v.failure.Error(), TokenERROR)
+			return true
+		}
+		// The last byte in a POSIX standard file must be an EOL character,
+		// unless the file is empty.
+		if len(v.source) == 0 || byt.HasSuffix(v.source, []byte(EOL)) {
 			v.emitToken("", TokenEOF)
 			return true
 		}
@@ -116,13 +288,28 @@ func (v *scanner) atError() {
 	v.emitToken(string(character), TokenERROR)
 }
 
+// This method returns the source bytes from the next byte through the end of
+// the current line. Only multi-line tokens span lines so every other token is
+// matched against just these bytes, which keeps each match short.
+func (v *scanner) currentLine() []byte {
+	if v.lineEnd <= v.nextByte {
+		var index = byt.Index(v.source[v.nextByte:], []byte(EOL))
+		if index < 0 {
+			v.lineEnd = len(v.source)
+		} else {
+			v.lineEnd = v.nextByte + index + len(EOL)
+		}
+	}
+	return v.source[v.nextByte:v.lineEnd]
+}
+
 // This method adds a token of the specified type with the current scanner
 // information to the token channel. It then resets the first byte index to the
 // next byte index position. It returns the token type of the type added to the
 // channel.
 func (v *scanner) emitToken(tValue string, tType TokenType) {
 	var byteCount = len(tValue)
-	var runeCount = sts.Count(tValue, "") - 1 // Empty string adds one to count.
+	var runeCount = utf.RuneCountInString(tValue)
 	var eolCount = sts.Count(tValue, EOL)
 	var lastEOL = sts.LastIndex(tValue, EOL) + 1 // Convert to ordinal indexing.
 	if tType != TokenWHITESPACE {
@@ -147,9 +334,13 @@ func (v *scanner) emitToken(tValue string, tType TokenType) {
 				tValue = "<VTAB>"
 			}
 		}
-		var token = Token{tType, tValue, v.line, v.position}
+		var token = Token{tType, tValue, v.line, v.position, v.discarded + v.firstByte, byteCount}
 		//fmt.Println(token)
-		v.tokens <- token
+		select {
+		case v.tokens <- token:
+		case <-v.context.Done():
+			// The token is dropped since nobody is receiving it.
+		}
 	}
 	v.nextByte += byteCount
 	v.firstByte = v.nextByte
@@ -169,46 +360,64 @@ func (v *scanner) generateTokens() {
 	close(v.tokens)
 }
 
+// This method determines whether or not a token with the specified leading
+// runes may begin with the next rune in the source bytes.
+func (v *scanner) mayBegin(leaders runeSet) bool {
+	return leaders.contains(v.leading)
+}
+
 // This method attempts to scan any token starting with the next rune in the
-// source bytes. It checks for each type of token as the cases for the switch
-// statement. If that token type is found, this method returns true and skips
-// the rest of the cases.  If no valid token is found, or if the scanner is at
-// the end of the source bytes, this method returns false.
+// source bytes. It dispatches on that rune and checks for each type of token
+// that may begin with it as the cases for the switch statement. If that token
+// type is found, this method returns true and skips the rest of the cases. If
+// no valid token is found, or if the scanner is at the end of the source bytes,
+// this method returns false. Since each matcher only examines the bytes of the
+// token it matches, the scanning is linear in the length of the source bytes.
 func (v *scanner) processToken() bool {
+	if v.context.Err() != nil {
+		// Scanning was canceled.
+		return false
+	}
+	if v.nextByte == len(v.source) {
+		v.readLines("")
+	}
+	v.leading, _ = utf.DecodeRune(v.source[v.nextByte:])
 	switch {
 	// Check for end-of-file marker.
 	case v.atEOF():
 		return false
 	// Must be scanned first.
-	case v.scanWHITESPACE():
-	case v.scanINTRINSIC():
-	case v.scanKEYWORD():
+	case v.mayBegin(leadersWHITESPACE) && v.scanWHITESPACE():
+	case v.mayBegin(leadersINTRINSIC) && v.scanINTRINSIC():
+	case v.mayBegin(leadersKEYWORD) && v.scanKEYWORD():
 	// Annotation token types.
-	case v.scanNOTE():
-	case v.scanCOMMENT():
+	case v.mayBegin(leadersNOTE) && v.scanNOTE():
+	case v.mayBegin(leadersCOMMENT) && v.scanCOMMENT():
 	// Element token types.
-	case v.scanANGLE():
-	case v.scanBOOLEAN():
-	case v.scanDURATION():
-	case v.scanMOMENT():
-	case v.scanPATTERN():
-	case v.scanPERCENTAGE():
-	case v.scanPROBABILITY():
-	case v.scanRESOURCE():
+	case v.mayBegin(leadersANGLE) && v.scanANGLE():
+	case v.mayBegin(leadersBOOLEAN) && v.scanBOOLEAN():
+	case v.mayBegin(leadersDURATION) && v.scanDURATION():
+	case v.mayBegin(leadersMOMENT) && v.scanMOMENT():
+	case v.mayBegin(leadersPATTERN) && v.scanPATTERN():
+	case v.mayBegin(leadersPERCENTAGE) && v.scanPERCENTAGE():
+	case v.mayBegin(leadersPROBABILITY) && v.scanPROBABILITY():
+	case v.mayBegin(leadersRESOURCE) && v.scanRESOURCE():
 	// String token types.
-	case v.scanBINARY():
-	case v.scanBYTECODE():
-	case v.scanNAME():
-	case v.scanNARRATIVE():
-	case v.scanQUOTE():
-	case v.scanSYMBOL():
-	case v.scanTAG():
-	case v.scanVERSION():
+	case v.mayBegin(leadersBINARY) && v.scanBINARY():
+	case v.mayBegin(leadersBYTECODE) && v.scanBYTECODE():
+	case v.mayBegin(leadersNAME) && v.scanNAME():
+	case v.mayBegin(leadersNARRATIVE) && v.scanNARRATIVE():
+	case v.mayBegin(leadersQUOTE) && v.scanQUOTE():
+	case v.mayBegin(leadersSYMBOL) && v.scanSYMBOL():
+	case v.mayBegin(leadersTAG) && v.scanTAG():
+	case v.mayBegin(leadersVERSION) && v.scanVERSION():
 	// Must be scanned last.
-	case v.scanNUMBER():
-	case v.scanIDENTIFIER():
-	case v.scanDELIMITER():
-	case v.scanEOL():
+	case v.mayBegin(leadersNUMBER) && v.scanNUMBER():
+	case uni.IsLetter(v.leading) && v.scanIDENTIFIER():
+	case v.mayBegin(leadersDELIMITER) && v.scanDELIMITER():
+	case v.mayBegin(leadersEOL) && v.scanEOL():
+	// Read the rest of a multi-line token.
+	case sts.ContainsRune(`!"'`, v.leading) && v.readLines("<"+string(v.leading)):
 	// No valid token was found.
 	default:
 		v.atError()
@@ -218,11 +427,49 @@ func (v *scanner) processToken() bool {
 	return true
 }
 
+// This method reads the next line of source bytes from the reader (if there is
+// one) and appends it to the unscanned source bytes. If a closing delimiter is
+// specified, lines are read until one containing the closing delimiter has
+// been read. The source bytes that have already been scanned are discarded. It
+// returns true if any lines were read.
+func (v *scanner) readLines(closing string) bool {
+	if v.reader == nil {
+		return false
+	}
+	var count = len(v.source)
+	for v.reader != nil {
+		var line, err = v.reader.ReadBytes('\n')
+		if err != nil {
+			if err != io.EOF {
+				v.failure = err
+			}
+			v.reader = nil // There is nothing left to read.
+		}
+		v.source = append(v.source, line...)
+		if byt.Contains(line, []byte(closing)) {
+			break
+		}
+	}
+	if len(v.source) == count {
+		return false
+	}
+	v.lineEnd = 0 // The current line may have been extended.
+	if v.firstByte > len(v.source)/2 {
+		// Discard the scanned source bytes.
+		var unscanned = copy(v.source, v.source[v.firstByte:])
+		v.source = v.source[:unscanned]
+		v.discarded += v.firstByte
+		v.nextByte -= v.firstByte
+		v.firstByte = 0
+	}
+	return true
+}
+
 // This method adds a new angle token with the current scanner information
 // to the token channel. It returns true if a new angle token was found.
 func (v *scanner) scanANGLE() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.AngleMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.AngleMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenANGLE)
 		return true
@@ -233,8 +480,8 @@ func (v *scanner) scanANGLE() bool {
 // This method adds a new binary token with the current scanner information
 // to the token channel. It returns true if a new binary token was found.
 func (v *scanner) scanBINARY() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.BinaryMatcher.FindSubmatch(s))
+	var s = boundedTo(v.source[v.nextByte:], "'>", "<'")
+	var matches = matchToken(uti.BinaryMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenBINARY)
 		return true
@@ -245,8 +492,8 @@ func (v *scanner) scanBINARY() bool {
 // This method adds a new boolean token with the current scanner information
 // to the token channel. It returns true if a new boolean token was found.
 func (v *scanner) scanBOOLEAN() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.BooleanMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.BooleanMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenBOOLEAN)
 		return true
@@ -257,8 +504,8 @@ func (v *scanner) scanBOOLEAN() bool {
 // This method adds a new bytecode token with the current scanner information
 // to the token channel. It returns true if a new bytecode token was found.
 func (v *scanner) scanBYTECODE() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.BytecodeMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.BytecodeMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenBYTECODE)
 		return true
@@ -269,8 +516,8 @@ func (v *scanner) scanBYTECODE() bool {
 // This method adds a new comment token with the current scanner information
 // to the token channel. It returns true if a new comment token was found.
 func (v *scanner) scanCOMMENT() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.CommentMatcher.FindSubmatch(s))
+	var s = boundedTo(v.source[v.nextByte:], "!>", "<!")
+	var matches = matchToken(uti.CommentMatcher, s)
 	//matches = scanComment(s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenCOMMENT)
@@ -282,8 +529,8 @@ func (v *scanner) scanCOMMENT() bool {
 // This method adds a new delimiter token with the current scanner information
 // to the token channel. It returns true if a new delimiter token was found.
 func (v *scanner) scanDELIMITER() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.DelimiterMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.DelimiterMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenDELIMITER)
 		return true
@@ -294,8 +541,8 @@ func (v *scanner) scanDELIMITER() bool {
 // This method adds a new duration token with the current scanner information
 // to the token channel. It returns true if a new duration token was found.
 func (v *scanner) scanDURATION() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.DurationMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.DurationMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenDURATION)
 		return true
@@ -306,10 +553,9 @@ func (v *scanner) scanDURATION() bool {
 // This method adds a new EOL token with the current scanner information
 // to the token channel. It returns true if a new EOL token was found.
 func (v *scanner) scanEOL() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.EolMatcher.FindSubmatch(s))
-	if len(matches) > 0 {
-		v.emitToken(matches[0], TokenEOL)
+	// An EOL character is matched directly since it is the most common token.
+	if byt.HasPrefix(v.source[v.nextByte:], []byte(EOL)) {
+		v.emitToken(EOL, TokenEOL)
 		return true
 	}
 	return false
@@ -318,8 +564,8 @@ func (v *scanner) scanEOL() bool {
 // This method adds a new identifier token with the current scanner information
 // to the token channel. It returns true if a new identifier token was found.
 func (v *scanner) scanIDENTIFIER() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.IdentifierMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.IdentifierMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenIDENTIFIER)
 		return true
@@ -330,8 +576,8 @@ func (v *scanner) scanIDENTIFIER() bool {
 // This method adds a new intrinsic token with the current scanner information
 // to the token channel. It returns true if a new intrinsic token was found.
 func (v *scanner) scanINTRINSIC() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.IntrinsicMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.IntrinsicMatcher, s)
 	if len(matches) > 0 {
 		// Check to see if the match is part of an identifier.
 		var r, _ = utf.DecodeRune(v.source[v.nextByte+len(matches[0]):])
@@ -346,8 +592,8 @@ func (v *scanner) scanINTRINSIC() bool {
 // This method adds a new keyword token with the current scanner information
 // to the token channel. It returns true if a new keyword token was found.
 func (v *scanner) scanKEYWORD() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.KeywordMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.KeywordMatcher, s)
 	if len(matches) > 0 {
 		// Check to see if the match is part of an identifier.
 		var r, _ = utf.DecodeRune(v.source[v.nextByte+len(matches[0]):])
@@ -362,8 +608,8 @@ func (v *scanner) scanKEYWORD() bool {
 // This method adds a new moment token with the current scanner information
 // to the token channel. It returns true if a new moment token was found.
 func (v *scanner) scanMOMENT() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.MomentMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.MomentMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenMOMENT)
 		return true
@@ -374,8 +620,8 @@ func (v *scanner) scanMOMENT() bool {
 // This method adds a new name token with the current scanner information
 // to the token channel. It returns true if a new name token was found.
 func (v *scanner) scanNAME() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.NameMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.NameMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenNAME)
 		return true
@@ -386,8 +632,8 @@ func (v *scanner) scanNAME() bool {
 // This method adds a new narrative token with the current scanner information
 // to the token channel. It returns true if a new narrative token was found.
 func (v *scanner) scanNARRATIVE() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.NarrativeMatcher.FindSubmatch(s))
+	var s = boundedTo(v.source[v.nextByte:], `">`, `<"`)
+	var matches = matchToken(uti.NarrativeMatcher, s)
 	//matches = scanNarrative(s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenNARRATIVE)
@@ -399,8 +645,8 @@ func (v *scanner) scanNARRATIVE() bool {
 // This method adds a new note token with the current scanner information
 // to the token channel. It returns true if a new note token was found.
 func (v *scanner) scanNOTE() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.NoteMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.NoteMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenNOTE)
 		return true
@@ -411,8 +657,8 @@ func (v *scanner) scanNOTE() bool {
 // This method adds a new number token with the current scanner information
 // to the token channel. It returns true if a new number token was found.
 func (v *scanner) scanNUMBER() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.NumberMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.NumberMatcher, s)
 	if len(matches) > 0 {
 		// Check to see if the match is part of an identifier or keyword.
 		var r, _ = utf.DecodeRune(v.source[v.nextByte+len(matches[0]):])
@@ -427,8 +673,8 @@ func (v *scanner) scanNUMBER() bool {
 // This method adds a new pattern token with the current scanner information
 // to the token channel. It returns true if a new pattern token was found.
 func (v *scanner) scanPATTERN() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.PatternMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.PatternMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenPATTERN)
 		return true
@@ -439,8 +685,8 @@ func (v *scanner) scanPATTERN() bool {
 // This method adds a new percentage token with the current scanner information
 // to the token channel. It returns true if a new percentage token was found.
 func (v *scanner) scanPERCENTAGE() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.PercentageMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.PercentageMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenPERCENTAGE)
 		return true
@@ -451,16 +697,17 @@ func (v *scanner) scanPERCENTAGE() bool {
 // This method adds a new probability token with the current scanner information
 // to the token channel. It returns true if a new probability token was found.
 func (v *scanner) scanPROBABILITY() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.ProbabilityMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.ProbabilityMatcher, s)
 	if len(matches) > 0 {
-		// Check to see if the match is part of a range.
+		// Check to see if the match is part of a range or a number.
+		var next = v.source[v.nextByte+len(matches[0]):]
 		if matches[0] != "1." ||
-			byt.HasPrefix(v.source[v.nextByte+len(matches[0]):], []byte("..")) || // "1...x" is ok.
-			!byt.HasPrefix(v.source[v.nextByte+len(matches[0]):], []byte(".")) { // "1.x" is ok.
+			byt.HasPrefix(next, []byte("..")) || // "1...x" is ok.
+			!(byt.HasPrefix(next, []byte(".")) || isDigit(next)) { // "1.x" is ok.
 			v.emitToken(matches[0], TokenPROBABILITY)
 			return true
-		} // "1..x" is NOT ok.
+		} // "1..x" and "1.5" are NOT ok.
 	}
 	return false
 }
@@ -468,8 +715,8 @@ func (v *scanner) scanPROBABILITY() bool {
 // This method adds a new quote token with the current scanner information
 // to the token channel. It returns true if a new quote token was found.
 func (v *scanner) scanQUOTE() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.QuoteMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.QuoteMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenQUOTE)
 		return true
@@ -480,8 +727,8 @@ func (v *scanner) scanQUOTE() bool {
 // This method adds a new resource token with the current scanner information
 // to the token channel. It returns true if a new resource token was found.
 func (v *scanner) scanRESOURCE() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.ResourceMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.ResourceMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenRESOURCE)
 		return true
@@ -492,8 +739,8 @@ func (v *scanner) scanRESOURCE() bool {
 // This method adds a new symbol token with the current scanner information
 // to the token channel. It returns true if a new symbol token was found.
 func (v *scanner) scanSYMBOL() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.SymbolMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.SymbolMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenSYMBOL)
 		return true
@@ -504,8 +751,8 @@ func (v *scanner) scanSYMBOL() bool {
 // This method adds a new tag token with the current scanner information
 // to the token channel. It returns true if a new tag token was found.
 func (v *scanner) scanTAG() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.TagMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.TagMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenTAG)
 		return true
@@ -516,8 +763,8 @@ func (v *scanner) scanTAG() bool {
 // This method adds a new version token with the current scanner information
 // to the token channel. It returns true if a new version token was found.
 func (v *scanner) scanVERSION() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.VersionMatcher.FindSubmatch(s))
+	var s = v.currentLine()
+	var matches = matchToken(uti.VersionMatcher, s)
 	if len(matches) > 0 {
 		v.emitToken(matches[0], TokenVERSION)
 		return true
@@ -528,111 +775,12 @@ func (v *scanner) scanVERSION() bool {
 // This method adds a new whitespace token with the current scanner information
 // to the token channel. It returns true if a new whitespace token was found.
 func (v *scanner) scanWHITESPACE() bool {
-	var s = v.source[v.nextByte:]
-	var matches = bytesToStrings(uti.WhitespaceMatcher.FindSubmatch(s))
-	if len(matches) > 0 {
-		v.emitToken(matches[0], TokenWHITESPACE)
+	// Whitespace is matched directly since it is the most common token.
+	var s = v.currentLine()
+	var count = len(s) - len(byt.TrimLeft(s, " "))
+	if count > 0 {
+		v.emitToken(string(s[:count]), TokenWHITESPACE)
 		return true
 	}
 	return false
 }
-
-/*
-// COMMENT SYNTAX
-
-// This function returns for the specified string an array of the matching
-// subgroups for a commment. The first string in the array is the entire matched
-// string. Since a comment can be recursive a regular expression is not used in
-// this implementation.
-func scanComment(v []byte) []string {
-	var result []string
-	var space = []byte(" ")
-	var eol = []byte(EOL)
-	var bangAngle = []byte("!>" + EOL)
-	var angleBang = []byte("<!")
-	if !byt.HasPrefix(v, bangAngle) {
-		return result
-	}
-	var angleBangAllowed = false
-	var current = 3 // Skip the leading '!>\n' characters.
-	var last = 0
-	var level = 1
-	for level > 0 {
-		var s = v[current:]
-		switch {
-		case len(s) == 0:
-			return result
-		case byt.HasPrefix(s, eol):
-			angleBangAllowed = true
-			last = current
-			current++
-		case byt.HasPrefix(s, bangAngle):
-			current += 3 // Skip the '!>\n' characters.
-			level++      // Start a nested comment.
-		case byt.HasPrefix(s, angleBang):
-			if !angleBangAllowed {
-				return result
-			}
-			current += 2 // Skip the '<!' characters.
-			level--      // Terminate the current comment.
-		default:
-			if angleBangAllowed && !byt.HasPrefix(s, space) {
-				angleBangAllowed = false
-			}
-			current++ // Accept the next character.
-		}
-	}
-	result = append(result, string(v[:current])) // Includes bang delimeters.
-	result = append(result, string(v[3:last]))   // Excludes bang delimeters.
-	return result
-}
-
-// NARRATIVE STRING SYNTAX
-
-// This function returns for the specified string an array of the matching
-// subgroups for a narrative string. The first string in the array is the entire
-// matched string. Since a narrative can be recursive a regular expression is
-// not used in this implementation.
-func scanNarrative(v []byte) []string {
-	var result []string
-	var quoteAngle = []byte(`">` + EOL)
-	var angleQuote = []byte(`<"`)
-	var space = []byte(" ")
-	var eol = []byte(EOL)
-	if !byt.HasPrefix(v, quoteAngle) {
-		return result
-	}
-	var angleQuoteAllowed = false
-	var current = 3 // Skip the leading '">\n' characters.
-	var last = 0
-	var level = 1
-	for level > 0 {
-		var s = v[current:]
-		switch {
-		case len(s) == 0:
-			return result
-		case byt.HasPrefix(s, eol):
-			angleQuoteAllowed = true
-			last = current
-			current++
-		case byt.HasPrefix(s, quoteAngle):
-			current += 3 // Skip the '">\n' characters.
-			level++      // Start a nested narrative.
-		case byt.HasPrefix(s, angleQuote):
-			if !angleQuoteAllowed {
-				return result
-			}
-			current += 2 // Skip the '<"' characters.
-			level--      // Terminate the current narrative.
-		default:
-			if angleQuoteAllowed && !byt.HasPrefix(s, space) {
-				angleQuoteAllowed = false
-			}
-			current++ // Accept the next character.
-		}
-	}
-	result = append(result, string(v[:current])) // Includes quote delimeters.
-	result = append(result, string(v[3:last]))   // Excludes quote delimeters.
-	return result
-}
-*/
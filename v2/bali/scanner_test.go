@@ -0,0 +1,180 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package bali_test
+
+import (
+	ctx "context"
+	fmt "fmt"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	run "runtime"
+	sts "strings"
+	tes "testing"
+	tim "time"
+)
+
+func scanTokens(source string) []bal.Token {
+	var tokens = make(chan bal.Token, 16)
+	bal.ScanTokens([]byte(source), tokens)
+	var result []bal.Token
+	for token := range tokens {
+		result = append(result, token)
+	}
+	return result
+}
+
+func TestScanningNarratives(t *tes.T) {
+	var tokens = scanTokens(`[
+    ">
+        first
+    <"
+    ">
+        second
+    <"
+]
+`)
+	ass.Equal(t, 9, len(tokens))
+	ass.Equal(t, bal.TokenNARRATIVE, tokens[2].Type)
+	ass.Equal(t, "\">\n        first\n    <\"", tokens[2].Value)
+	ass.Equal(t, bal.TokenNARRATIVE, tokens[4].Type)
+	ass.Equal(t, 5, tokens[4].Line)
+	ass.Equal(t, bal.TokenEOF, tokens[8].Type)
+}
+
+func TestScanningComments(t *tes.T) {
+	var tokens = scanTokens(`!>
+    first
+<!
+!>
+    second
+<!
+[ ]
+`)
+	ass.Equal(t, bal.TokenCOMMENT, tokens[0].Type)
+	ass.Equal(t, "!>\n    first\n<!", tokens[0].Value)
+	ass.Equal(t, bal.TokenCOMMENT, tokens[2].Type)
+	ass.Equal(t, 4, tokens[2].Line)
+}
+
+func TestScanningLengths(t *tes.T) {
+	// The length of a token is its length in the source, not of its value.
+	var tokens = scanTokens("[π]\n")
+	ass.Equal(t, 5, len(tokens))
+	ass.Equal(t, 2, tokens[1].Length)
+	ass.Equal(t, bal.TokenEOF, tokens[4].Type)
+	ass.Equal(t, "<EOFL>", tokens[4].Value)
+	ass.Equal(t, 0, tokens[4].Length)
+
+	tokens = scanTokens("[\a]\n")
+	ass.Equal(t, bal.TokenERROR, tokens[1].Type)
+	ass.Equal(t, "<BELL>", tokens[1].Value)
+	ass.Equal(t, 1, tokens[1].Length)
+}
+
+func TestWalkingTokens(t *tes.T) {
+	var source = "[\n    $pi: π  ! Half a turn.\n    $bell: \a\n]\n"
+	var texts []string
+	var types []bal.TokenType
+	bal.WalkTokens(source, func(text string, token *bal.Token) {
+		texts = append(texts, text)
+		if token != nil {
+			types = append(types, token.Type)
+		}
+	})
+	// Every byte of the source is passed along exactly once.
+	ass.Equal(t, source, sts.Join(texts, ""))
+	ass.Equal(t, "π", texts[6])
+	ass.Equal(t, " \a\n]\n", texts[len(texts)-1])
+	ass.Equal(t, []bal.TokenType{
+		bal.TokenDELIMITER, bal.TokenEOL, bal.TokenSYMBOL, bal.TokenDELIMITER,
+		bal.TokenNUMBER, bal.TokenNOTE, bal.TokenEOL, bal.TokenSYMBOL, bal.TokenDELIMITER,
+	}, types)
+}
+
+// This function fails the test if any scanner goroutines are still running.
+// Since a stopped goroutine may take a moment to exit, it retries for a while.
+func checkScanners(t *tes.T) {
+	var stacks []byte
+	for i := 0; i < 100; i++ {
+		stacks = make([]byte, 1<<20)
+		stacks = stacks[:run.Stack(stacks, true)]
+		if !sts.Contains(string(stacks), "bali.(*scanner)") {
+			return
+		}
+		tim.Sleep(10 * tim.Millisecond)
+	}
+	t.Errorf("A scanner goroutine is still running:\n%s", stacks)
+}
+
+func TestScanningWithContext(t *tes.T) {
+	var context, cancel = ctx.WithCancel(ctx.Background())
+	var tokens = make(chan bal.Token, 4)
+	var source = sts.Repeat("[1, 2, 3]\n", 1000)
+	bal.ScanTokensWithContext(context, []byte(source), tokens)
+	for len(tokens) < cap(tokens) {
+		tim.Sleep(tim.Millisecond) // Wait for the scanner to block.
+	}
+	cancel()
+	var count int
+	for range tokens {
+		count++
+	}
+	ass.True(t, count <= cap(tokens)+1)
+	checkScanners(t)
+}
+
+// The scanning rate (ns/byte) should remain constant as the document grows.
+func BenchmarkScanning(b *tes.B) {
+	var catalog, err = osx.ReadFile(testDirectory + "benchmark/catalog.bali")
+	if err != nil {
+		panic("Could not find the ./test/benchmark/catalog.bali file.")
+	}
+	var sizes = []int{1 << 10, 10 << 10, 100 << 10, 1 << 20, 10 << 20}
+	var rates = make([]float64, len(sizes))
+	for index, size := range sizes {
+		// Whole catalogs are repeated so that no token is ever split.
+		var source = sts.Repeat(string(catalog), max(1, size/len(catalog)))
+		b.Run(fmt.Sprintf("%dKB", size>>10), func(b *tes.B) {
+			b.SetBytes(int64(len(source)))
+			for i := 0; i < b.N; i++ {
+				var tokens = scanTokens(source)
+				var last = tokens[len(tokens)-1]
+				if last.Type != bal.TokenEOF {
+					b.Fatalf("Unexpected token: %v", last)
+				}
+			}
+			var elapsed = b.Elapsed().Nanoseconds()
+			var rate = float64(elapsed) / float64(b.N*len(source))
+			b.ReportMetric(rate, "ns/byte")
+			rates[index] = rate // The last run uses the most iterations.
+		})
+	}
+	checkRates(b, rates)
+}
+
+// This function fails the benchmark if the slowest scanning rate is more than
+// twice the fastest one. Any sizes that were not benchmarked are ignored.
+func checkRates(b *tes.B, rates []float64) {
+	var fastest, slowest float64
+	for _, rate := range rates {
+		if rate == 0 {
+			continue
+		}
+		if fastest == 0 || rate < fastest {
+			fastest = rate
+		}
+		slowest = max(slowest, rate)
+	}
+	if slowest > 2*fastest {
+		b.Errorf("The scanning rate is not constant: %v ns/byte", rates)
+	}
+}
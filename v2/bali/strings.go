@@ -17,7 +17,6 @@ import (
 	uti "github.com/bali-nebula/go-component-framework/v2/utilities"
 	stc "strconv"
 	sts "strings"
-	uni "unicode"
 )
 
 // UNIVERSAL CONSTRUCTORS
@@ -28,9 +27,9 @@ func Binary(value abs.Value) abs.BinaryLike {
 	var binary abs.BinaryLike
 	switch actual := value.(type) {
 	case int:
-		binary = str.BinaryFromBytes(uti.RandomBytes(actual))
+		binary = str.BinaryFromArray(uti.RandomBytes(actual))
 	case []byte:
-		binary = str.BinaryFromBytes(actual)
+		binary = str.BinaryFromArray(actual)
 	case abs.Sequential[byte]:
 		binary = str.BinaryFromSequence(actual)
 	case string:
@@ -68,6 +67,28 @@ func Bytecode(value abs.Value) abs.BytecodeLike {
 	return bytecode
 }
 
+// This constructor returns a new name string initialized with the specified
+// value.
+func Name(value abs.Value) abs.NameLike {
+	var name abs.NameLike
+	switch actual := value.(type) {
+	case []abs.Identifier:
+		name = str.NameFromArray(actual)
+	case abs.Sequential[abs.Identifier]:
+		name = str.NameFromSequence(actual)
+	case string:
+		name = str.NameFromString(actual)
+	case abs.ComponentLike:
+		name = actual.GetEntity().(abs.NameLike)
+	default:
+		var message = fmt.Sprintf(
+			"The value (of type %T) cannot be converted to a name string: %v",
+			actual, actual)
+		panic(message)
+	}
+	return name
+}
+
 // This constructor returns a new narrative string initialized with the specified
 // value.
 func Narrative(value abs.Value) abs.NarrativeLike {
@@ -78,7 +99,12 @@ func Narrative(value abs.Value) abs.NarrativeLike {
 	case abs.Sequential[abs.Line]:
 		narrative = str.NarrativeFromSequence(actual)
 	case string:
-		narrative = str.NarrativeFromString(actual)
+		var matches = uti.NarrativeMatcher.FindStringSubmatch(actual)
+		if len(matches) == 0 || matches[0] != actual {
+			var message = fmt.Sprintf("The string is not a narrative string: %v", actual)
+			panic(message)
+		}
+		narrative = str.NarrativeFromString(trimIndentation(actual))
 	case abs.ComponentLike:
 		narrative = actual.GetEntity().(abs.NarrativeLike)
 	default:
@@ -100,7 +126,13 @@ func Quote(value abs.Value) abs.QuoteLike {
 	case abs.Sequential[rune]:
 		quote = str.QuoteFromSequence(actual)
 	case string:
-		quote = str.QuoteFromString(actual)
+		var matches = uti.QuoteMatcher.FindStringSubmatch(actual)
+		if len(matches) == 0 || matches[0] != actual {
+			var message = fmt.Sprintf("The string is not a quote string: %v", actual)
+			panic(message)
+		}
+		var unquoted, _ = stc.Unquote(actual)
+		quote = str.Quote(unquoted) // The unquoted string may contain EOL characters.
 	case abs.ComponentLike:
 		quote = actual.GetEntity().(abs.QuoteLike)
 	default:
@@ -118,7 +150,7 @@ func Symbol(value abs.Value) abs.SymbolLike {
 	var symbol abs.SymbolLike
 	switch actual := value.(type) {
 	case string:
-		symbol = str.SymbolFromString(actual)
+		symbol = str.SymbolFromString(sts.TrimPrefix(actual, "$"))
 	case abs.SymbolLike:
 		symbol = actual
 	case abs.ComponentLike:
@@ -163,7 +195,7 @@ func Tag(value abs.Value) abs.TagLike {
 	case []byte:
 		tag = str.TagFromBytes(actual)
 	case string:
-		tag = str.TagFromString(actual)
+		tag = str.TagFromString(sts.TrimPrefix(actual, "#"))
 	case abs.TagLike:
 		tag = actual
 	case abs.ComponentLike:
@@ -185,7 +217,7 @@ func Version(value abs.Value) abs.VersionLike {
 	case abs.Sequential[abs.Ordinal]:
 		version = str.VersionFromSequence(actual)
 	case string:
-		version = str.VersionFromString(actual)
+		version = str.VersionFromString(sts.TrimPrefix(actual, "v"))
 	case abs.ComponentLike:
 		version = actual.GetEntity().(abs.VersionLike)
 	default:
@@ -214,25 +246,38 @@ func (v *parser) parseBinary() (abs.BinaryLike, *Token, bool) {
 		v.backupOne(token)
 		return binary, token, false
 	}
-	var matches = bytesToStrings(binaryScanner.FindSubmatch([]byte(token.Value)))
-	// Remove all whitespace and the "'>" and "<'" delimiters.
-	binary = str.BinaryFromString(sts.Map(func(r rune) rune {
-		if uni.IsSpace(r) {
-			return -1
-		}
-		return r
-	}, matches[1]))
+	binary = str.BinaryFromString(token.Value)
 	return binary, token, true
 }
 
 const lineLength = 60 // 60 base 64 characters encode 45 bytes per line.
 
+// This method attempts to parse a name string. It returns the name string
+// and whether or not the name string was successfully parsed.
+func (v *parser) parseName() (abs.NameLike, *Token, bool) {
+	var token *Token
+	var name abs.NameLike
+	token = v.nextToken()
+	if token.Type != TokenNAME {
+		v.backupOne(token)
+		return name, token, false
+	}
+	name = str.NameFromString(token.Value)
+	return name, token, true
+}
+
+// This method adds the canonical format for the specified string to the state
+// of the formatter.
 func (v *formatter) formatName(name abs.NameLike) {
+	v.AppendString(name.AsString())
 }
 
 // This method adds the canonical format for the specified string to the state
 // of the formatter.
 func (v *formatter) formatBinary(binary abs.BinaryLike) {
+	if v.compact {
+		panic("A binary string cannot be formatted on a single line.")
+	}
 	v.AppendString("'>")
 	v.depth++
 	var s = binary.AsString()
@@ -263,14 +308,7 @@ func (v *parser) parseBytecode() (abs.BytecodeLike, *Token, bool) {
 		v.backupOne(token)
 		return bytecode, token, false
 	}
-	var matches = bytesToStrings(bytecodeScanner.FindSubmatch([]byte(token.Value)))
-	// Remove all whitespace and the "'" delimiters.
-	bytecode = str.BytecodeFromString(sts.Map(func(r rune) rune {
-		if uni.IsSpace(r) {
-			return -1
-		}
-		return r
-	}, matches[1]))
+	bytecode = str.BytecodeFromString(token.Value)
 	return bytecode, token, true
 }
 
@@ -308,6 +346,11 @@ func (v *parser) parseNarrative() (abs.NarrativeLike, *Token, bool) {
 // of the formatter.
 func (v *formatter) formatNarrative(narrative abs.NarrativeLike) {
 	var s = narrative.AsString()
+	if v.compact {
+		// A quote with escaped EOL characters is the single line equivalent.
+		v.formatQuote(str.Quote(s))
+		return
+	}
 	var lines = sts.Split(s, EOL)
 	v.AppendString(`">`)
 	v.depth++
@@ -334,10 +377,10 @@ func (v *parser) parseQuote() (abs.QuoteLike, *Token, bool) {
 		v.backupOne(token)
 		return quote, token, false
 	}
-	var matches = bytesToStrings(quoteScanner.FindSubmatch([]byte(token.Value)))
+	var matches = bytesToStrings(uti.QuoteMatcher.FindSubmatch([]byte(token.Value)))
 	// We must unquote the full token string properly.
 	var unquoted, _ = stc.Unquote(matches[0])
-	quote = str.QuoteFromString(unquoted)
+	quote = str.Quote(unquoted) // The unquoted string may contain EOL characters.
 	return quote, token, true
 }
 
@@ -398,7 +441,7 @@ func (v *parser) parseSymbol() (abs.SymbolLike, *Token, bool) {
 		v.backupOne(token)
 		return symbol, token, false
 	}
-	var matches = bytesToStrings(symbolScanner.FindSubmatch([]byte(token.Value)))
+	var matches = bytesToStrings(uti.SymbolMatcher.FindSubmatch([]byte(token.Value)))
 	symbol = str.SymbolFromString(matches[1]) // Remove the leading '$'.
 	return symbol, token, true
 }
@@ -422,7 +465,7 @@ func (v *parser) parseTag() (abs.TagLike, *Token, bool) {
 		v.backupOne(token)
 		return tag, token, false
 	}
-	var matches = bytesToStrings(tagScanner.FindSubmatch([]byte(token.Value)))
+	var matches = bytesToStrings(uti.TagMatcher.FindSubmatch([]byte(token.Value)))
 	tag = str.TagFromString(matches[1]) // Remove the leading "#".
 	return tag, token, true
 }
@@ -445,7 +488,7 @@ func (v *parser) parseVersion() (abs.VersionLike, *Token, bool) {
 		v.backupOne(token)
 		return version, token, false
 	}
-	var matches = bytesToStrings(versionScanner.FindSubmatch([]byte(token.Value)))
+	var matches = bytesToStrings(uti.VersionMatcher.FindSubmatch([]byte(token.Value)))
 	version = str.VersionFromString(matches[1]) // Remove the leading "v".
 	return version, token, true
 }
@@ -28,6 +28,8 @@ type Token struct {
 	Value    string
 	Line     int // The line number of the token in the input string.
 	Position int // The position in the line of the first rune of the token.
+	Offset   int // The zero based index of the first byte of the token in the input string.
+	Length   int // The number of bytes of the token in the input string.
 }
 
 // This method returns the canonical string version of this token.
@@ -0,0 +1,459 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package cbor
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	mat "math"
+	utf "unicode/utf8"
+)
+
+// DECODER INTERFACE
+
+// This function returns the component that is encoded in the specified bytes.
+// It panics if the bytes are not a valid encoding of a component.
+func DecodeComponent(bytes []byte) abs.ComponentLike {
+	var component, err = TryDecodeComponent(bytes)
+	if err != nil {
+		panic(err)
+	}
+	return component
+}
+
+// This function returns the entity that is encoded in the specified bytes. It
+// panics if the bytes are not a valid encoding of an entity.
+func DecodeEntity(bytes []byte) abs.Entity {
+	var entity, err = TryDecodeEntity(bytes)
+	if err != nil {
+		panic(err)
+	}
+	return entity
+}
+
+// This function decodes a component like DecodeComponent but returns any error
+// rather than panicking. It is useful when decoding bytes from untrusted
+// sources.
+func TryDecodeComponent(bytes []byte) (abs.ComponentLike, error) {
+	var v = &decoder{bytes: bytes}
+	var component, err = v.decodeComponent()
+	if err == nil {
+		err = v.decodeEnd()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return component, nil
+}
+
+// This function decodes an entity like DecodeEntity but returns any error
+// rather than panicking.
+func TryDecodeEntity(bytes []byte) (abs.Entity, error) {
+	var v = &decoder{bytes: bytes}
+	var entity, err = v.decodeEntity()
+	if err == nil {
+		err = v.decodeEnd()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// DECODER IMPLEMENTATION
+
+// This constant defines the maximum depth of nested components that will be
+// decoded. It protects the decoder from running out of stack space.
+const maximumDepth = 1000
+
+// This type defines the structure and methods for a binary decoding agent.
+type decoder struct {
+	bytes []byte
+	next  int // The index of the next byte to be decoded.
+	depth int // The current depth of nested components.
+}
+
+// This method decodes the next component from the bytes.
+func (v *decoder) decodeComponent() (abs.ComponentLike, error) {
+	if v.next < len(v.bytes) && v.bytes[v.next] == majorTag|25 {
+		var next = v.next
+		var _, tag, err = v.decodeHead()
+		if err != nil {
+			return nil, err
+		}
+		if Tag(tag) == TagComponent {
+			return v.decodeParameterized()
+		}
+		v.next = next // Put back the tag for the entity.
+	}
+	var entity, err = v.decodeEntity()
+	if err != nil {
+		return nil, err
+	}
+	return com.Component(entity), nil
+}
+
+// This method verifies that all of the bytes have been decoded.
+func (v *decoder) decodeEnd() error {
+	if v.next < len(v.bytes) {
+		return fmt.Errorf("Found %v unexpected bytes following the encoding.", len(v.bytes)-v.next)
+	}
+	return nil
+}
+
+// This method decodes the next entity from the bytes.
+func (v *decoder) decodeEntity() (abs.Entity, error) {
+	v.depth++
+	defer func() { v.depth-- }()
+	if v.depth > maximumDepth {
+		return nil, fmt.Errorf("The encoding is nested more than %v levels deep.", maximumDepth)
+	}
+	if v.isNumber() {
+		var float, err = v.decodeFloat()
+		if err != nil {
+			return nil, err
+		}
+		return bal.Number(float), nil
+	}
+	var major, argument, err = v.decodeHead()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case majorBytes:
+		return v.decodeBytes(argument)
+	case majorArray:
+		return v.decodeValues(TagList, argument)
+	case majorMap:
+		return v.decodeAssociations(argument)
+	case majorTag:
+		return v.decodeTagged(Tag(argument))
+	case majorSimple:
+		switch argument {
+		case uint64(simpleTrue &^ majorSimple):
+			return bal.Boolean(true), nil
+		case uint64(simpleFalse &^ majorSimple):
+			return bal.Boolean(false), nil
+		}
+	}
+	return nil, fmt.Errorf("An unexpected CBOR item (major type %v) was found at byte %v.", major>>5, v.next)
+}
+
+// This method determines whether or not the next CBOR item in the bytes is an
+// integer or a floating point value.
+func (v *decoder) isNumber() bool {
+	if v.next >= len(v.bytes) {
+		return false
+	}
+	var initial = v.bytes[v.next]
+	var major = initial & 0xe0
+	return major == majorUnsigned || major == majorNegative ||
+		initial == majorSimple|26 || initial == majorSimple|27
+}
+
+// This method decodes the next CBOR integer or single or double precision
+// floating point value from the bytes.
+func (v *decoder) decodeFloat() (float64, error) {
+	if !v.isNumber() {
+		return 0, fmt.Errorf("A CBOR number was expected at byte %v.", v.next)
+	}
+	var initial = v.bytes[v.next]
+	var major, argument, err = v.decodeHead()
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case major == majorUnsigned:
+		return float64(argument), nil
+	case major == majorNegative:
+		return -1 - float64(argument), nil
+	case initial == majorSimple|26:
+		return float64(mat.Float32frombits(uint32(argument))), nil
+	default:
+		return mat.Float64frombits(argument), nil
+	}
+}
+
+// This method decodes the next CBOR integer from the bytes.
+func (v *decoder) decodeInteger() (int, error) {
+	var major, argument, err = v.decodeHead()
+	if err == nil && major != majorUnsigned && major != majorNegative {
+		err = fmt.Errorf("A CBOR integer was expected at byte %v.", v.next)
+	}
+	if err == nil && argument > mat.MaxInt64 {
+		err = fmt.Errorf("A CBOR integer is out of range at byte %v.", v.next)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if major == majorNegative {
+		return -1 - int(argument), nil
+	}
+	return int(argument), nil
+}
+
+// This method decodes the next CBOR head from the bytes and returns its major
+// type and argument.
+func (v *decoder) decodeHead() (byte, uint64, error) {
+	if v.next >= len(v.bytes) {
+		return 0, 0, fmt.Errorf("The encoding ended unexpectedly.")
+	}
+	var initial = v.bytes[v.next]
+	v.next++
+	var major = initial & 0xe0
+	var argument = uint64(initial & 0x1f)
+	var size int
+	switch {
+	case argument < 24:
+		return major, argument, nil
+	case argument == 24:
+		size = 1
+	case argument == 25:
+		size = 2
+	case argument == 26:
+		size = 4
+	case argument == 27:
+		size = 8
+	default:
+		return 0, 0, fmt.Errorf("An unsupported CBOR head (%#x) was found at byte %v.", initial, v.next-1)
+	}
+	if v.next+size > len(v.bytes) {
+		return 0, 0, fmt.Errorf("The encoding ended unexpectedly.")
+	}
+	argument = 0
+	for _, byte_ := range v.bytes[v.next : v.next+size] {
+		argument = argument<<8 | uint64(byte_)
+	}
+	v.next += size
+	return major, argument, nil
+}
+
+// This method decodes a catalog containing the specified number of
+// associations from the bytes.
+func (v *decoder) decodeAssociations(size uint64) (abs.CatalogLike, error) {
+	var catalog = col.Catalog()
+	for index := uint64(0); index < size; index++ {
+		var key, err = v.decodeEntity()
+		if err != nil {
+			return nil, err
+		}
+		var value abs.ComponentLike
+		value, err = v.decodeComponent()
+		if err != nil {
+			return nil, err
+		}
+		catalog.SetValue(key, value)
+	}
+	return catalog, nil
+}
+
+// This method decodes the entity, context and note of a parameterized or
+// annotated component from the bytes.
+func (v *decoder) decodeParameterized() (abs.ComponentLike, error) {
+	var major, size, err = v.decodeHead()
+	if err == nil && (major != majorArray || size != 3) {
+		err = fmt.Errorf("A tagged component must contain an array of three items.")
+	}
+	if err != nil {
+		return nil, err
+	}
+	var entity abs.Entity
+	entity, err = v.decodeEntity()
+	if err != nil {
+		return nil, err
+	}
+	var context abs.ContextLike
+	if v.next < len(v.bytes) && v.bytes[v.next] == simpleNull {
+		v.next++
+	} else {
+		context, err = v.decodeContext()
+		if err != nil {
+			return nil, err
+		}
+	}
+	var note abs.NoteLike
+	if v.next < len(v.bytes) && v.bytes[v.next] == simpleNull {
+		v.next++
+	} else {
+		var text string
+		text, err = v.decodeText()
+		if err != nil {
+			return nil, err
+		}
+		note = com.Note(text)
+	}
+	var component = com.ComponentWithContext(entity, context)
+	component.SetNote(note)
+	return component, nil
+}
+
+// This method decodes a map of context parameters from the bytes.
+func (v *decoder) decodeContext() (abs.ContextLike, error) {
+	var major, size, err = v.decodeHead()
+	if err == nil && major != majorMap {
+		err = fmt.Errorf("A component context must be a map.")
+	}
+	if err != nil {
+		return nil, err
+	}
+	var context = com.Context()
+	for index := uint64(0); index < size; index++ {
+		var key abs.Entity
+		key, err = v.decodeEntity()
+		if err != nil {
+			return nil, err
+		}
+		var symbol, ok = key.(abs.SymbolLike)
+		if !ok {
+			return nil, fmt.Errorf("A context parameter must be keyed by a symbol.")
+		}
+		var value abs.ComponentLike
+		value, err = v.decodeComponent()
+		if err != nil {
+			return nil, err
+		}
+		context.SetValue(symbol, value)
+	}
+	return context, nil
+}
+
+// This method decodes a binary string containing the specified number of bytes
+// from the bytes.
+func (v *decoder) decodeBytes(size uint64) (abs.BinaryLike, error) {
+	if size > uint64(len(v.bytes)-v.next) {
+		return nil, fmt.Errorf("The encoding ended unexpectedly.")
+	}
+	var bytes = make([]byte, size)
+	copy(bytes, v.bytes[v.next:])
+	v.next += int(size)
+	return str.BinaryFromArray(bytes), nil
+}
+
+// This method decodes an entity wrapped in the specified tag from the bytes.
+func (v *decoder) decodeTagged(tag Tag) (abs.Entity, error) {
+	switch tag {
+	case TagAngle:
+		var float, err = v.decodeFloat()
+		if err != nil {
+			return nil, err
+		}
+		return bal.Angle(float), nil
+	case TagDuration, TagMoment:
+		var integer, err = v.decodeInteger()
+		if err != nil {
+			return nil, err
+		}
+		if tag == TagDuration {
+			return bal.Duration(integer), nil
+		}
+		return bal.Moment(integer), nil
+	case TagNumber:
+		var major, size, err = v.decodeHead()
+		if err == nil && (major != majorArray || size != 2) {
+			err = fmt.Errorf("A tagged number must contain an array of two numbers.")
+		}
+		var parts [2]float64
+		for index := range parts {
+			if err != nil {
+				return nil, err
+			}
+			parts[index], err = v.decodeFloat()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return bal.Number(complex(parts[0], parts[1])), nil
+	case TagList, TagQueue, TagSet, TagStack:
+		var major, size, err = v.decodeHead()
+		if err == nil && major != majorArray {
+			err = fmt.Errorf("A tagged collection must contain an array.")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return v.decodeValues(tag, size)
+	case TagCatalog:
+		var major, size, err = v.decodeHead()
+		if err == nil && major != majorMap {
+			err = fmt.Errorf("A tagged catalog must contain a map.")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return v.decodeAssociations(size)
+	case TagComponent:
+		return nil, fmt.Errorf("A tagged component was found where an entity was expected.")
+	}
+	var source, err = v.decodeText()
+	if err != nil {
+		return nil, err
+	}
+	var entity abs.Entity
+	entity, err = bal.TryParseEntity(source)
+	if err != nil {
+		return nil, err
+	}
+	var actual = tagOf(entity)
+	if actual != tag || actual == TagList || actual == TagCatalog {
+		return nil, fmt.Errorf("The tag %#x does not match the type of the entity: %v", uint64(tag), source)
+	}
+	return entity, nil
+}
+
+// This method decodes a CBOR text string from the bytes. A byte string is
+// accepted as well since a string that is not valid UTF-8 is encoded as one.
+func (v *decoder) decodeText() (string, error) {
+	var major, size, err = v.decodeHead()
+	if err == nil && major != majorText && major != majorBytes {
+		err = fmt.Errorf("A CBOR text string was expected at byte %v.", v.next)
+	}
+	if err != nil {
+		return "", err
+	}
+	if size > uint64(len(v.bytes)-v.next) {
+		return "", fmt.Errorf("The encoding ended unexpectedly.")
+	}
+	var text = string(v.bytes[v.next : v.next+int(size)])
+	v.next += int(size)
+	if major == majorText && !utf.ValidString(text) {
+		return "", fmt.Errorf("A CBOR text string contains invalid UTF-8.")
+	}
+	return text, nil
+}
+
+// This method decodes a collection of the type identified by the specified tag
+// containing the specified number of values from the bytes.
+func (v *decoder) decodeValues(tag Tag, size uint64) (abs.Entity, error) {
+	var list = col.List()
+	for index := uint64(0); index < size; index++ {
+		var value, err = v.decodeComponent()
+		if err != nil {
+			return nil, err
+		}
+		list.AddValue(value)
+	}
+	var collection abs.Entity
+	switch tag {
+	case TagQueue:
+		collection = col.QueueFromSequence(list)
+	case TagSet:
+		collection = col.SetFromSequence(list)
+	case TagStack:
+		collection = col.StackFromSequence(list)
+	default:
+		collection = list
+	}
+	return collection, nil
+}
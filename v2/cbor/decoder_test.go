@@ -0,0 +1,149 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package cbor_test
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	cbo "github.com/bali-nebula/go-component-framework/v2/cbor"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	sts "strings"
+	tes "testing"
+)
+
+const testDirectory = "../bali/test/"
+
+func TestCBORRoundtrips(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ../bali/test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var source, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(source)
+			var bytes = cbo.EncodeComponent(component)
+			if !sts.HasPrefix(string(source), "{") {
+				// A procedure is encoded as its source string.
+				ass.True(t, len(bytes) < len(source), filename)
+			}
+			component = cbo.DecodeComponent(bytes)
+			ass.Equal(t, string(source), string(bal.FormatDocument(component)), filename)
+		}
+	}
+}
+
+func TestCBOREncoding(t *tes.T) {
+	var component = bal.ParseComponent(`[true, 5]`)
+	var bytes = cbo.EncodeComponent(component)
+	ass.Equal(t, []byte{0x82, 0xf5, 0xfb, 0x40, 0x14, 0, 0, 0, 0, 0, 0}, bytes)
+
+	var encodings = map[string][]byte{
+		`(3, 4i)`:      {0xd9, 0xba, 0x0d, 0x82, 0xfb, 0x40, 0x08, 0, 0, 0, 0, 0, 0, 0xfb, 0x40, 0x10, 0, 0, 0, 0, 0, 0},
+		`~0.5`:         {0xd9, 0xba, 0x01, 0xfb, 0x3f, 0xe0, 0, 0, 0, 0, 0, 0},
+		`~PT1S`:        {0xd9, 0xba, 0x07, 0x19, 0x03, 0xe8},
+		`<1969-12-31>`: {0xd9, 0xba, 0x0a, 0x3a, 0x05, 0x26, 0x5b, 0xff},
+	}
+	for source, expected := range encodings {
+		var entity = bal.ParseEntity(source)
+		ass.Equal(t, expected, cbo.EncodeEntity(entity), source)
+		ass.Equal(t, source, bal.FormatEntity(cbo.DecodeEntity(expected)), source)
+	}
+
+	var binary = str.BinaryFromArray([]byte{1, 2, 3})
+	bytes = cbo.EncodeEntity(binary)
+	ass.Equal(t, []byte{0x43, 1, 2, 3}, bytes)
+	ass.Equal(t, bal.FormatEntity(binary), bal.FormatEntity(cbo.DecodeEntity(bytes)))
+
+	component = bal.ParseComponent(`~π($units: "radians")  ! Half a turn.`)
+	bytes = cbo.EncodeComponent(component)
+	component = cbo.DecodeComponent(bytes)
+	ass.Equal(t, `~π($units: "radians")  ! Half a turn.`, bal.FormatComponent(component))
+
+	var entity = cbo.DecodeEntity(cbo.EncodeEntity(bal.ParseEntity("<2024-03-31>")))
+	ass.Equal(t, `<2024-03-31>`, bal.FormatEntity(entity))
+}
+
+func TestCBORErrors(t *tes.T) {
+	var encodings = [][]byte{
+		{},                            // Nothing to decode.
+		{0x82, 0xf5},                  // A missing value.
+		{0xf5, 0xf5},                  // An extra value.
+		{0xd9, 0xba, 0x0b, 0x61, 'x'}, // An invalid name.
+		{0xd9, 0xba, 0x0a, 0x61, '5'}, // A moment encoded as text.
+		{0xd9, 0xba, 0x0a, 0xfb, 0, 0, 0, 0, 0, 0, 0, 0}, // A moment encoded as a float.
+		{0xd9, 0xba, 0x0d, 0x81, 0x01},                   // A number with only one part.
+		{0xd9, 0xba, 0x00, 0xf5},                         // A component without an array.
+		{0x7a, 0xff, 0xff, 0xff, 0xff},                   // A text string that is too long.
+		{0x5a, 0xff, 0xff, 0xff, 0xff},                   // A byte string that is too long.
+		{0xf6},                                           // A null entity.
+		{0xd9, 0xba, 0x13, 0x62, 0xff, 0xfe},             // Invalid UTF-8.
+	}
+	for index, encoding := range encodings {
+		var _, err = cbo.TryDecodeComponent(encoding)
+		ass.Error(t, err, index)
+	}
+	var nested = append([]byte(sts.Repeat("\x81", 2000)), 0xf5)
+	var _, err = cbo.TryDecodeComponent(nested)
+	ass.Error(t, err)
+}
+
+// This function parses the specified source string and returns the
+// corresponding component, or nil if the source is not valid.
+func parseComponent(source string) (component abs.ComponentLike) {
+	defer func() {
+		if recover() != nil {
+			component = nil
+		}
+	}()
+	component = bal.ParseComponent(source)
+	bal.FormatComponent(component) // Make sure the component can be formatted.
+	return component
+}
+
+func FuzzCBORRoundtrip(f *tes.F) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ../bali/test directory.")
+	}
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var source, _ = osx.ReadFile(filename)
+			f.Add(sts.TrimSuffix(string(source), bal.EOL))
+		}
+	}
+	f.Add(`[true, 5]`)
+	f.Add(`[$key: "value"]($type: /bali/types/Example/v1)  ! A note.`)
+	f.Add("{ !> a\x88b <! return 1 }")
+	f.Add("{\n    !>\n        a\x88b\n    <!\n    return 1\n}")
+	f.Add("'>\n    yiXwRMG7OzG8P8y21A6lTFKWU6sFel5vCaYw1kslyq0gLkJTMHu5iNaFRwZ\n<'")
+	f.Fuzz(func(t *tes.T, source string) {
+		var component = parseComponent(source)
+		if component == nil {
+			t.Skip("The source is not a valid component.")
+		}
+		var expected = bal.FormatComponent(component)
+		var bytes = cbo.EncodeComponent(component)
+		var decoded, err = cbo.TryDecodeComponent(bytes)
+		if err != nil {
+			t.Fatalf("The encoding of %q could not be decoded: %v", source, err)
+		}
+		var actual = bal.FormatComponent(decoded)
+		if actual != expected {
+			t.Fatalf("The round trip changed %q into %q.", expected, actual)
+		}
+	})
+}
@@ -0,0 +1,317 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package cbor
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	mat "math"
+	utf "unicode/utf8"
+)
+
+// ENCODER INTERFACE
+
+// This type defines the CBOR tags that identify the type of each encoded
+// entity. The tags are private to this encoding and start at 0xBA00.
+type Tag uint16
+
+// This enumeration defines the tag for each type of entity. Lists, catalogs and
+// binary strings are encoded as untagged arrays, maps and byte strings, so
+// TagList and TagCatalog are accepted by the decoder but are never written by
+// the encoder. TagBinary is only written for a binary string whose base 64
+// encoding is not canonical.
+const (
+	TagComponent Tag = 0xBA00 + iota
+	TagAngle
+	TagBinary
+	TagBoolean
+	TagBytecode
+	TagCatalog
+	TagContinuum
+	TagDuration
+	TagInterval
+	TagList
+	TagMoment
+	TagName
+	TagNarrative
+	TagNumber
+	TagPattern
+	TagPercentage
+	TagProbability
+	TagProcedure
+	TagQueue
+	TagQuote
+	TagResource
+	TagSet
+	TagSpectrum
+	TagStack
+	TagSymbol
+	TagTag
+	TagVersion
+)
+
+// This function returns the binary encoding of the specified component. The
+// encoding is valid CBOR (see RFC 8949) and uses the following mapping:
+//
+//   - A list is encoded as an array of its values and a catalog is encoded as
+//     a map of its associations.
+//   - A set, queue or stack is encoded as an array wrapped in a tag that
+//     identifies its collection type.
+//   - A boolean is encoded as the simple value true or false.
+//   - A binary string is encoded as a byte string, unless its base 64 encoding
+//     is not canonical (e.g. it has non-zero padding bits) in which case it is
+//     encoded as its BDN string wrapped in a TagBinary tag.
+//   - A real number is encoded as a floating point value and any other number
+//     is encoded as an array containing its real and imaginary parts wrapped
+//     in a TagNumber tag.
+//   - An angle is encoded as its value in radians wrapped in a TagAngle tag.
+//   - A moment is encoded as the integer number of milliseconds since the UNIX
+//     epoch wrapped in a TagMoment tag, and a duration is encoded as its
+//     integer number of milliseconds wrapped in a TagDuration tag.
+//   - Any other entity is encoded as its canonical BDN string wrapped in a tag
+//     that identifies its type (e.g. TagVersion).
+//   - A BDN string or note that is not valid UTF-8 (e.g. a narrative
+//     containing arbitrary bytes) is encoded as a byte string rather than as
+//     CBOR text.
+//   - A component with a context or note is wrapped in a TagComponent tag
+//     around an array containing its entity, a map of its parameters (or null)
+//     and its note (or null).
+//
+// Since the canonical BDN string of each entity is determined by its value, a
+// decoded component formats identically to the original component.
+func EncodeComponent(component abs.ComponentLike) []byte {
+	var v = &encoder{}
+	v.encodeComponent(component)
+	return v.result
+}
+
+// This function returns the binary encoding of the specified entity.
+func EncodeEntity(entity abs.Entity) []byte {
+	var v = &encoder{}
+	v.encodeEntity(entity)
+	return v.result
+}
+
+// ENCODER IMPLEMENTATION
+
+// These constants define the CBOR major types that are used by the encoding.
+const (
+	majorUnsigned byte = 0 << 5
+	majorNegative byte = 1 << 5
+	majorBytes    byte = 2 << 5
+	majorText     byte = 3 << 5
+	majorArray    byte = 4 << 5
+	majorMap      byte = 5 << 5
+	majorTag      byte = 6 << 5
+	majorSimple   byte = 7 << 5
+)
+
+// These constants define the CBOR simple values that are used by the encoding.
+const (
+	simpleFalse byte = majorSimple | 20
+	simpleTrue  byte = majorSimple | 21
+	simpleNull  byte = majorSimple | 22
+	simpleFloat byte = majorSimple | 27 // A double precision floating point value.
+)
+
+// This type defines the structure and methods for a binary encoding agent.
+type encoder struct {
+	result []byte
+}
+
+// This method appends a CBOR head with the specified major type and argument
+// to the result using the shortest possible form.
+func (v *encoder) appendHead(major byte, argument uint64) {
+	switch {
+	case argument < 24:
+		v.result = append(v.result, major|byte(argument))
+	case argument <= 0xff:
+		v.result = append(v.result, major|24, byte(argument))
+	case argument <= 0xffff:
+		v.result = append(v.result, major|25, byte(argument>>8), byte(argument))
+	case argument <= 0xffffffff:
+		v.result = append(v.result, major|26,
+			byte(argument>>24), byte(argument>>16), byte(argument>>8), byte(argument))
+	default:
+		v.result = append(v.result, major|27,
+			byte(argument>>56), byte(argument>>48), byte(argument>>40), byte(argument>>32),
+			byte(argument>>24), byte(argument>>16), byte(argument>>8), byte(argument))
+	}
+}
+
+// This method appends the specified integer to the result as a CBOR unsigned or
+// negative integer.
+func (v *encoder) appendInteger(integer int) {
+	if integer < 0 {
+		v.appendHead(majorNegative, uint64(-1-integer))
+		return
+	}
+	v.appendHead(majorUnsigned, uint64(integer))
+}
+
+// This method appends the specified float to the result as a CBOR double
+// precision floating point value.
+func (v *encoder) appendFloat(float float64) {
+	var bits = mat.Float64bits(float)
+	v.result = append(v.result, simpleFloat,
+		byte(bits>>56), byte(bits>>48), byte(bits>>40), byte(bits>>32),
+		byte(bits>>24), byte(bits>>16), byte(bits>>8), byte(bits))
+}
+
+// This method appends the specified string to the result as CBOR text, or as a
+// byte string if it is not valid UTF-8 since CBOR text must be.
+func (v *encoder) appendText(text string) {
+	var major = majorText
+	if !utf.ValidString(text) {
+		major = majorBytes
+	}
+	v.appendHead(major, uint64(len(text)))
+	v.result = append(v.result, text...)
+}
+
+// This method appends the binary encoding of the specified component to the
+// result.
+func (v *encoder) encodeComponent(component abs.ComponentLike) {
+	if !component.IsParameterized() && !component.IsAnnotated() {
+		v.encodeEntity(component.GetEntity())
+		return
+	}
+	v.appendHead(majorTag, uint64(TagComponent))
+	v.appendHead(majorArray, 3)
+	v.encodeEntity(component.GetEntity())
+	if component.IsParameterized() {
+		var context = component.GetContext()
+		v.appendHead(majorMap, uint64(context.GetSize()))
+		var iterator = com.ParameterIterator(context)
+		for iterator.HasNext() {
+			var parameter = iterator.GetNext()
+			v.encodeEntity(parameter.GetKey())
+			v.encodeComponent(parameter.GetValue())
+		}
+	} else {
+		v.result = append(v.result, simpleNull)
+	}
+	if component.IsAnnotated() {
+		v.appendText(string(component.GetNote().AsArray()))
+	} else {
+		v.result = append(v.result, simpleNull)
+	}
+}
+
+// This method appends the binary encoding of the specified entity to the
+// result.
+func (v *encoder) encodeEntity(entity abs.Entity) {
+	var tag = tagOf(entity)
+	switch value := entity.(type) {
+	case abs.ValuesLike:
+		if tag != TagList {
+			v.appendHead(majorTag, uint64(tag))
+		}
+		v.appendHead(majorArray, uint64(value.GetSize()))
+		var iterator = com.ComponentIterator(value)
+		for iterator.HasNext() {
+			v.encodeComponent(iterator.GetNext())
+		}
+	case abs.AssociationsLike:
+		v.appendHead(majorMap, uint64(value.GetSize()))
+		var iterator = col.AssociationIterator(value)
+		for iterator.HasNext() {
+			var association = iterator.GetNext()
+			v.encodeEntity(association.GetKey())
+			v.encodeComponent(association.GetValue())
+		}
+	default:
+		switch tag {
+		case TagAngle:
+			v.appendHead(majorTag, uint64(tag))
+			v.appendFloat(value.(abs.AngleLike).AsFloat())
+		case TagBinary:
+			var bytes = value.(abs.BinaryLike).AsArray()
+			var source = bal.FormatEntity(value)
+			if source != bal.FormatEntity(str.BinaryFromArray(bytes)) {
+				// The decoded bytes would not reproduce the same string.
+				v.appendHead(majorTag, uint64(tag))
+				v.appendText(source)
+				return
+			}
+			v.appendHead(majorBytes, uint64(len(bytes)))
+			v.result = append(v.result, bytes...)
+		case TagBoolean:
+			if value.(abs.BooleanLike).AsBoolean() {
+				v.result = append(v.result, simpleTrue)
+			} else {
+				v.result = append(v.result, simpleFalse)
+			}
+		case TagDuration:
+			v.appendHead(majorTag, uint64(tag))
+			v.appendInteger(value.(abs.DurationLike).AsInteger())
+		case TagMoment:
+			v.appendHead(majorTag, uint64(tag))
+			v.appendInteger(value.(abs.MomentLike).AsInteger())
+		case TagNumber:
+			var number = value.(abs.NumberLike)
+			if number.GetImaginary() == 0 {
+				v.appendFloat(number.GetReal())
+				return
+			}
+			v.appendHead(majorTag, uint64(tag))
+			v.appendHead(majorArray, 2)
+			v.appendFloat(number.GetReal())
+			v.appendFloat(number.GetImaginary())
+		default:
+			v.appendHead(majorTag, uint64(tag))
+			v.appendText(bal.FormatEntity(value))
+		}
+	}
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the tag that identifies the type of the specified
+// entity.
+func tagOf(entity abs.Entity) Tag {
+	return tags[bal.GetType(entity)]
+}
+
+// PRIVATE GLOBALS
+
+// This map defines the tag for each type of entity.
+var tags = map[string]Tag{
+	"Angle":       TagAngle,
+	"Binary":      TagBinary,
+	"Boolean":     TagBoolean,
+	"Bytecode":    TagBytecode,
+	"Catalog":     TagCatalog,
+	"Continuum":   TagContinuum,
+	"Duration":    TagDuration,
+	"Interval":    TagInterval,
+	"List":        TagList,
+	"Moment":      TagMoment,
+	"Name":        TagName,
+	"Narrative":   TagNarrative,
+	"Number":      TagNumber,
+	"Pattern":     TagPattern,
+	"Percentage":  TagPercentage,
+	"Probability": TagProbability,
+	"Procedure":   TagProcedure,
+	"Queue":       TagQueue,
+	"Quote":       TagQuote,
+	"Resource":    TagResource,
+	"Set":         TagSet,
+	"Spectrum":    TagSpectrum,
+	"Stack":       TagStack,
+	"Symbol":      TagSymbol,
+	"Tag":         TagTag,
+	"Version":     TagVersion,
+}
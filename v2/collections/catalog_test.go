@@ -24,9 +24,9 @@ func TestCatalogs(t *tes.T) {
 	var foo = str.SymbolFromString("foo")
 	var bar = str.SymbolFromString("bar")
 	var baz = str.SymbolFromString("baz")
-	var one = com.Component(ele.NumberFromComplex(1))
-	var two = com.Component(ele.NumberFromComplex(2))
-	var three = com.Component(ele.NumberFromComplex(3))
+	var one = com.Component(ele.Number().FromComplex(1))
+	var two = com.Component(ele.Number().FromComplex(2))
+	var three = com.Component(ele.Number().FromComplex(3))
 	var catalog = col.Catalog()
 	ass.True(t, catalog.IsEmpty())
 	ass.Equal(t, 0, catalog.GetSize())
@@ -21,9 +21,9 @@ import (
 )
 
 func TestQueueWithConcurrency(t *tes.T) {
-	var one = com.Component(ele.NumberFromComplex(1))
-	var two = com.Component(ele.NumberFromComplex(2))
-	var three = com.Component(ele.NumberFromComplex(3))
+	var one = com.Component(ele.Number().FromComplex(1))
+	var two = com.Component(ele.Number().FromComplex(2))
+	var three = com.Component(ele.Number().FromComplex(3))
 
 	// Create a wait group for synchronization.
 	var wg = new(syn.WaitGroup)
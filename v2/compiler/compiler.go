@@ -0,0 +1,538 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package compiler
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	pro "github.com/bali-nebula/go-component-framework/v2/procedures"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	sts "strings"
+)
+
+// COMPILER INTERFACE
+
+// This function compiles the specified procedure into a program consisting of
+// bytecode instructions and the tables of literal values and symbols that the
+// instructions refer to. See the INSTRUCTION SET description for the meaning
+// of each instruction. This function panics if the procedure is too large to
+// be addressed by the instructions or contains a break or continue clause that
+// is not inside a loop.
+func Compile(procedure abs.ProcedureLike) abs.ProgramLike {
+	var v = &compiler{
+		literals: col.List(),
+		indices:  map[string]int{},
+		symbols:  col.List(),
+		names:    map[string]int{},
+	}
+	v.compileProcedure(procedure)
+	v.emit(RETURN, 0)
+	var bytecode = make([]abs.Instruction, len(v.instructions))
+	for index, instruction := range v.instructions {
+		bytecode[index] = Instruction(instruction.opcode, instruction.operand)
+	}
+	return Program(str.BytecodeFromArray(bytecode), v.literals, v.symbols)
+}
+
+// COMPILER IMPLEMENTATION
+
+// This type defines the structure of an instruction that is being compiled.
+// The operand of a jump instruction may not be known until later in the
+// compilation.
+type instruction struct {
+	opcode  Opcode
+	operand int
+}
+
+// This type defines the structure of a loop that is being compiled.
+type loop struct {
+	start    int   // The address that a continue clause jumps to.
+	breaks   []int // The addresses of the jumps that break out of the loop.
+	handlers int   // The number of exception handlers pushed before the loop.
+	scopes   int   // The number of variable scopes pushed before the loop.
+}
+
+// This type defines the structure and methods associated with a procedure
+// compiler.
+type compiler struct {
+	instructions []instruction
+	literals     abs.ListLike
+	indices      map[string]int // The literal index for each canonical literal.
+	symbols      abs.ListLike
+	names        map[string]int // The symbol index for each symbol name.
+	loops        []*loop
+	handlers     int // The number of exception handlers currently pushed.
+	scopes       int // The number of variable scopes currently pushed.
+}
+
+// This method returns the address of the next instruction to be emitted.
+func (v *compiler) getAddress() int {
+	return len(v.instructions) + 1
+}
+
+// This method appends a new instruction to the bytecode and returns its address.
+func (v *compiler) emit(opcode Opcode, operand int) int {
+	v.instructions = append(v.instructions, instruction{opcode, operand})
+	var address = len(v.instructions)
+	if address > MaximumOperand {
+		panic("The procedure is too large to be compiled.")
+	}
+	return address
+}
+
+// This method sets the operand of the jump instruction at the specified
+// address to the address of the next instruction to be emitted.
+func (v *compiler) resolve(address int) {
+	v.instructions[address-1].operand = v.getAddress()
+}
+
+// This method returns the index of the specified component in the literal
+// table, adding it to the table if necessary.
+func (v *compiler) literalIndex(literal abs.ComponentLike) int {
+	var key = bal.FormatComponent(literal)
+	var index, ok = v.indices[key]
+	if !ok {
+		v.literals.AddValue(literal)
+		index = v.literals.GetSize()
+		if index > MaximumOperand {
+			panic("The procedure contains too many literals to be compiled.")
+		}
+		v.indices[key] = index
+	}
+	return index
+}
+
+// This method returns the index of the specified name in the symbol table,
+// adding it to the table if necessary.
+func (v *compiler) symbolIndex(name string) int {
+	name = sts.TrimPrefix(name, "$")
+	var index, ok = v.names[name]
+	if !ok {
+		v.symbols.AddValue(com.Component(str.SymbolFromString(name)))
+		index = v.symbols.GetSize()
+		if index > MaximumOperand {
+			panic("The procedure contains too many symbols to be compiled.")
+		}
+		v.names[name] = index
+	}
+	return index
+}
+
+// This method emits the instructions that pull the exception handlers and pop
+// the variable scopes pushed inside the current loop and then either break out
+// of the loop or continue with its next iteration.
+func (v *compiler) exitLoop(clause string, isBreak bool) {
+	var loop = v.currentLoop(clause)
+	for count := v.handlers; count > loop.handlers; count-- {
+		v.emit(PULL_HANDLER, 0)
+	}
+	for count := v.scopes; count > loop.scopes; count-- {
+		v.emit(POP_SCOPE, 0)
+	}
+	if isBreak {
+		loop.breaks = append(loop.breaks, v.emit(JUMP, 0))
+	} else {
+		v.emit(JUMP, loop.start)
+	}
+}
+
+// This method emits the instructions for the statements in the specified block.
+// The statements are executed in a new variable scope.
+func (v *compiler) compileBlock(block abs.BlockLike) {
+	v.emit(PUSH_SCOPE, 0)
+	v.compileScope(block.GetProcedure())
+}
+
+// This method emits the instructions for the statements in the specified
+// procedure followed by the instruction that pops the variable scope that was
+// pushed for them.
+func (v *compiler) compileScope(procedure abs.ProcedureLike) {
+	v.scopes++
+	v.compileProcedure(procedure)
+	v.scopes--
+	v.emit(POP_SCOPE, 0)
+}
+
+// This method emits the instructions for the specified expression. The
+// instructions push the value of the expression onto the component stack.
+func (v *compiler) compileExpression(expression abs.Expression) {
+	switch actual := expression.(type) {
+	case abs.ValueLike:
+		v.emit(PUSH_LITERAL, v.literalIndex(actual.GetComponent()))
+	case abs.VariableLike:
+		v.emit(LOAD_VARIABLE, v.symbolIndex(actual.GetIdentifier()))
+	case abs.IntrinsicLike:
+		v.compileArguments(actual.GetArguments())
+		v.emit(INVOKE_INTRINSIC, v.symbolIndex(actual.GetFunction()))
+	case abs.InvocationLike:
+		v.compileExpression(actual.GetTarget())
+		v.compileArguments(actual.GetArguments())
+		var method = v.symbolIndex(actual.GetMethod())
+		if actual.IsSynchronous() {
+			v.emit(INVOKE_METHOD, method)
+		} else {
+			v.emit(SEND_MESSAGE, method)
+		}
+	case abs.SubcomponentLike:
+		v.compileExpression(actual.GetComposite())
+		for _, index := range actual.GetIndices().AsArray() {
+			v.compileExpression(index)
+			v.emit(LOAD_SUBCOMPONENT, 0)
+		}
+	case abs.UnaryOperationLike:
+		v.compileExpression(actual.GetExpression())
+		var operator = actual.GetOperator()
+		if operator != abs.PRECEDENCE {
+			v.emit(UNARY, int(operator))
+		}
+	case abs.BinaryOperationLike:
+		v.compileExpression(actual.GetFirst())
+		v.compileExpression(actual.GetSecond())
+		v.emit(BINARY, int(actual.GetOperator()))
+	default:
+		var message = fmt.Sprintf("An invalid expression type was found: %T", actual)
+		panic(message)
+	}
+}
+
+// This method emits the instructions that push a list of the values of the
+// specified argument expressions.
+func (v *compiler) compileArguments(arguments abs.Sequential[abs.Expression]) {
+	for _, argument := range arguments.AsArray() {
+		v.compileExpression(argument)
+	}
+	v.emit(LIST, arguments.GetSize())
+}
+
+// This method emits the instructions that pop a value off the component stack
+// and assign it to the specified recipient using the specified assignment
+// operator. A compound assignment operator (e.g. "+=") is only supported for
+// an attribute recipient.
+func (v *compiler) compileRecipient(recipient abs.Recipient, operator abs.Operator) {
+	switch actual := recipient.(type) {
+	case abs.SymbolLike:
+		var variable = v.symbolIndex(string(actual.AsArray()))
+		switch operator {
+		case abs.ASSIGN:
+			v.emit(STORE_VARIABLE, variable)
+		case abs.DEFAULT:
+			v.emit(STORE_DEFAULT, variable)
+		default:
+			var message = fmt.Sprintf("An invalid assignment operator was found: %v", operator)
+			panic(message)
+		}
+	case abs.AttributeLike:
+		v.emit(LOAD_VARIABLE, v.symbolIndex(actual.GetVariable()))
+		var indices = actual.GetIndices().AsArray()
+		var last = len(indices) - 1
+		for _, index := range indices[:last] {
+			v.compileExpression(index)
+			v.emit(LOAD_SUBCOMPONENT, 0)
+		}
+		v.compileExpression(indices[last])
+		v.emit(STORE_SUBCOMPONENT, int(operator))
+	default:
+		var message = fmt.Sprintf("An invalid recipient type was found: %T", actual)
+		panic(message)
+	}
+}
+
+// This method emits the instructions for the statements in the specified
+// procedure.
+func (v *compiler) compileProcedure(procedure abs.ProcedureLike) {
+	for _, statement := range procedure.AsArray() {
+		v.compileStatement(statement)
+	}
+}
+
+// This method emits the instructions for the specified statement. If the
+// statement has an "on" clause the instructions for its main clause are
+// protected by an exception handler of the form:
+//
+//	    PUSH HANDLER handler
+//	    <main clause>
+//	    PULL HANDLER
+//	    JUMP end
+//	handler:
+//	    PUSH SCOPE
+//	    STORE VARIABLE failure
+//	    LOAD VARIABLE failure
+//	    <template>
+//	    HANDLES
+//	    JUMP ON FALSE next
+//	    <block>
+//	    POP SCOPE
+//	    JUMP end
+//	next:
+//	    ...
+//	    LOAD VARIABLE failure
+//	    POP SCOPE
+//	    THROW
+//	end:
+func (v *compiler) compileStatement(statement abs.StatementLike) {
+	var onClause = statement.GetOnClause()
+	if onClause == nil {
+		v.compileClause(statement.GetMainClause())
+		return
+	}
+	var handler = v.emit(PUSH_HANDLER, 0)
+	v.handlers++
+	v.compileClause(statement.GetMainClause())
+	v.handlers--
+	v.emit(PULL_HANDLER, 0)
+	var ends = []int{v.emit(JUMP, 0)}
+	v.resolve(handler)
+	var failure = v.symbolIndex(string(onClause.GetFailure().AsArray()))
+	v.emit(PUSH_SCOPE, 0)
+	v.emit(STORE_VARIABLE, failure)
+	for _, block := range onClause.GetBlocks().AsArray() {
+		v.emit(LOAD_VARIABLE, failure)
+		v.compileExpression(block.GetExpression())
+		v.emit(HANDLES, 0)
+		var next = v.emit(JUMP_ON_FALSE, 0)
+		v.compileScope(block.GetProcedure())
+		ends = append(ends, v.emit(JUMP, 0))
+		v.resolve(next)
+	}
+	v.emit(LOAD_VARIABLE, failure)
+	v.emit(POP_SCOPE, 0)
+	v.emit(THROW, 0)
+	for _, end := range ends {
+		v.resolve(end)
+	}
+}
+
+// This method emits the instructions for the specified main clause. It panics
+// if the type of the clause is not supported.
+func (v *compiler) compileClause(clause abs.Clause) {
+	switch pro.GetType(clause) {
+	case "AcceptClause":
+		v.compileExpression(clause.(abs.AcceptClauseLike).GetMessage())
+		v.emit(ACCEPT, 0)
+	case "BreakClause":
+		v.exitLoop("break loop", true)
+	case "CheckoutClause":
+		v.compileCheckoutClause(clause.(abs.CheckoutClauseLike))
+	case "ContinueClause":
+		v.exitLoop("continue loop", false)
+	case "DiscardClause":
+		v.compileExpression(clause.(abs.DiscardClauseLike).GetDocument())
+		v.emit(DISCARD, 0)
+	case "IfClause":
+		v.compileIfClause(clause.(abs.IfClauseLike))
+	case "LetClause":
+		v.compileLetClause(clause.(abs.LetClauseLike))
+	case "NotarizeClause":
+		var notarize = clause.(abs.NotarizeClauseLike)
+		v.compileExpression(notarize.GetDocument())
+		v.compileExpression(notarize.GetName())
+		v.emit(NOTARIZE, 0)
+	case "PostClause":
+		var post = clause.(abs.PostClauseLike)
+		v.compileExpression(post.GetMessage())
+		v.compileExpression(post.GetBag())
+		v.emit(POST, 0)
+	case "PublishClause":
+		v.compileExpression(clause.(abs.PublishClauseLike).GetEvent())
+		v.emit(PUBLISH, 0)
+	case "RejectClause":
+		v.compileExpression(clause.(abs.RejectClauseLike).GetMessage())
+		v.emit(REJECT, 0)
+	case "RetrieveClause":
+		var retrieve = clause.(abs.RetrieveClauseLike)
+		v.compileExpression(retrieve.GetBag())
+		v.emit(RETRIEVE, 0)
+		v.compileRecipient(retrieve.GetRecipient(), abs.ASSIGN)
+	case "ReturnClause":
+		v.compileExpression(clause.(abs.ReturnClauseLike).GetResult())
+		v.emit(RETURN, 1)
+	case "SaveClause":
+		var save = clause.(abs.SaveClauseLike)
+		v.compileExpression(save.GetDocument())
+		v.emit(SAVE, 0)
+		v.compileRecipient(save.GetRecipient(), abs.ASSIGN)
+	case "SelectClause":
+		v.compileSelectClause(clause.(abs.SelectClauseLike))
+	case "ThrowClause":
+		v.compileExpression(clause.(abs.ThrowClauseLike).GetException())
+		v.emit(THROW, 0)
+	case "WhileClause":
+		v.compileWhileClause(clause.(abs.WhileClauseLike))
+	case "WithClause":
+		v.compileWithClause(clause.(abs.WithClauseLike))
+	default:
+		var message = fmt.Sprintf("The %v is not supported by this compiler.", pro.GetType(clause))
+		panic(message)
+	}
+}
+
+// This method emits the instructions for the specified checkout clause.
+func (v *compiler) compileCheckoutClause(clause abs.CheckoutClauseLike) {
+	v.compileExpression(clause.GetName())
+	var level = clause.GetLevel()
+	if level == nil {
+		v.emit(CHECKOUT, 0)
+	} else {
+		v.compileExpression(level)
+		v.emit(CHECKOUT, 1)
+	}
+	v.compileRecipient(clause.GetRecipient(), abs.ASSIGN)
+}
+
+// This method emits the instructions for the specified if clause.
+func (v *compiler) compileIfClause(clause abs.IfClauseLike) {
+	var block = clause.GetBlock()
+	v.compileExpression(block.GetExpression())
+	var end = v.emit(JUMP_ON_FALSE, 0)
+	v.compileBlock(block)
+	v.resolve(end)
+}
+
+// This method emits the instructions for the specified let clause. A compound
+// assignment to a variable is compiled as:
+//
+//	LOAD VARIABLE variable
+//	<expression>
+//	BINARY operator
+//	STORE VARIABLE variable
+func (v *compiler) compileLetClause(clause abs.LetClauseLike) {
+	if !clause.HasRecipient() {
+		// The expression is evaluated for its side effects only.
+		v.compileExpression(clause.GetExpression())
+		v.emit(POP, 0)
+		return
+	}
+	var recipient, operator = clause.GetRecipient()
+	var symbol, isSymbol = recipient.(abs.SymbolLike)
+	var binary, isCompound = arithmetic[operator]
+	if isSymbol && isCompound {
+		var variable = v.symbolIndex(string(symbol.AsArray()))
+		v.emit(LOAD_VARIABLE, variable)
+		v.compileExpression(clause.GetExpression())
+		v.emit(BINARY, int(binary))
+		v.emit(STORE_VARIABLE, variable)
+		return
+	}
+	v.compileExpression(clause.GetExpression())
+	v.compileRecipient(recipient, operator)
+}
+
+// This method emits the instructions for the specified select clause. The
+// value of the target expression remains on the component stack until a
+// block is selected or no block matches.
+func (v *compiler) compileSelectClause(clause abs.SelectClauseLike) {
+	v.compileExpression(clause.GetTarget())
+	var ends []int
+	for _, block := range clause.GetBlocks().AsArray() {
+		v.emit(DUPLICATE, 0)
+		v.compileExpression(block.GetExpression())
+		v.emit(MATCH, 0)
+		var next = v.emit(JUMP_ON_FALSE, 0)
+		v.emit(POP, 0)
+		v.compileBlock(block)
+		ends = append(ends, v.emit(JUMP, 0))
+		v.resolve(next)
+	}
+	v.emit(POP, 0)
+	for _, end := range ends {
+		v.resolve(end)
+	}
+}
+
+// This method emits the instructions for the specified while clause:
+//
+//	start:
+//	    <condition>
+//	    JUMP ON FALSE end
+//	    <block>
+//	    JUMP start
+//	end:
+func (v *compiler) compileWhileClause(clause abs.WhileClauseLike) {
+	var block = clause.GetBlock()
+	var loop = v.beginLoop()
+	v.compileExpression(block.GetExpression())
+	var end = v.emit(JUMP_ON_FALSE, 0)
+	v.compileBlock(block)
+	v.emit(JUMP, loop.start)
+	v.resolve(end)
+	v.endLoop()
+}
+
+// This method emits the instructions for the specified with clause:
+//
+//	    <sequence>
+//	    ITERATE
+//	start:
+//	    NEXT ITEM end
+//	    PUSH SCOPE
+//	    STORE VARIABLE item
+//	    <block>
+//	    POP SCOPE
+//	    JUMP start
+//	break:
+//	    POP
+//	end:
+func (v *compiler) compileWithClause(clause abs.WithClauseLike) {
+	var block = clause.GetBlock()
+	v.compileExpression(block.GetExpression())
+	v.emit(ITERATE, 0)
+	var loop = v.beginLoop()
+	var end = v.emit(NEXT_ITEM, 0)
+	v.emit(PUSH_SCOPE, 0)
+	v.emit(STORE_VARIABLE, v.symbolIndex(string(clause.GetItem().AsArray())))
+	v.compileScope(block.GetProcedure())
+	v.emit(JUMP, loop.start)
+	v.endLoop()
+	v.emit(POP, 0) // Pop the iterator when breaking out of the loop.
+	v.resolve(end)
+}
+
+// This method begins the compilation of a new loop whose start is the address
+// of the next instruction to be emitted.
+func (v *compiler) beginLoop() *loop {
+	var loop = &loop{start: v.getAddress(), handlers: v.handlers, scopes: v.scopes}
+	v.loops = append(v.loops, loop)
+	return loop
+}
+
+// This method returns the loop that is currently being compiled.
+func (v *compiler) currentLoop(clause string) *loop {
+	if len(v.loops) == 0 {
+		var message = fmt.Sprintf("A %v clause must be inside a loop.", clause)
+		panic(message)
+	}
+	return v.loops[len(v.loops)-1]
+}
+
+// This method ends the compilation of the current loop. Any jumps that break
+// out of the loop are resolved to the address of the next instruction to be
+// emitted.
+func (v *compiler) endLoop() {
+	var loop = v.loops[len(v.loops)-1]
+	v.loops = v.loops[:len(v.loops)-1]
+	for _, address := range loop.breaks {
+		v.resolve(address)
+	}
+}
+
+// PRIVATE GLOBALS
+
+// This map defines the arithmetic operator for each compound assignment
+// operator.
+var arithmetic = map[abs.Operator]abs.Operator{
+	abs.SUM:        abs.PLUS,
+	abs.DIFFERENCE: abs.MINUS,
+	abs.PRODUCT:    abs.STAR,
+	abs.QUOTIENT:   abs.SLASH,
+}
@@ -0,0 +1,148 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package compiler_test
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	cmp "github.com/bali-nebula/go-component-framework/v2/compiler"
+	ass "github.com/stretchr/testify/assert"
+	tes "testing"
+)
+
+func TestInstructions(t *tes.T) {
+	var instruction = cmp.Instruction(cmp.JUMP_ON_FALSE, 0x2A5)
+	ass.Equal(t, cmp.JUMP_ON_FALSE, cmp.GetOpcode(instruction))
+	ass.Equal(t, 0x2A5, cmp.GetOperand(instruction))
+	ass.Equal(t, byte(cmp.JUMP_ON_FALSE)<<2|0x2, instruction.GetLeftByte())
+	ass.Equal(t, byte(0xA5), instruction.GetRightByte())
+	ass.Equal(t, "JUMP ON FALSE", cmp.JUMP_ON_FALSE.String())
+	ass.Panics(t, func() { cmp.Instruction(0, 0) })
+	ass.Panics(t, func() { cmp.Instruction(cmp.JUMP, cmp.MaximumOperand+1) })
+}
+
+func TestCompileAssignments(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    let $x := 5
+    let $list := [1, 2]
+    let list[1] += x
+    return x
+}`).ExtractProcedure()
+	var program = cmp.Compile(procedure)
+	ass.Equal(t, `0001: PUSH LITERAL #1
+0002: STORE VARIABLE $x
+0003: PUSH LITERAL #2
+0004: STORE VARIABLE $list
+0005: LOAD VARIABLE $x
+0006: LOAD VARIABLE $list
+0007: PUSH LITERAL #3
+0008: STORE SUBCOMPONENT 3
+0009: LOAD VARIABLE $x
+0010: RETURN 1
+0011: RETURN 0
+`, cmp.Disassemble(program))
+	ass.Equal(t, 3, program.GetLiterals().GetSize())
+	ass.Equal(t, 2, program.GetSymbols().GetSize())
+}
+
+func TestCompileControlFlow(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    let $count := 0
+    while count < 3 do {
+        let $count += 1
+        if count = 2 do {
+            break loop
+        }
+    }
+    return count
+}`).ExtractProcedure()
+	var program = cmp.Compile(procedure)
+	ass.Equal(t, `0001: PUSH LITERAL #1
+0002: STORE VARIABLE $count
+0003: LOAD VARIABLE $count
+0004: PUSH LITERAL #2
+0005: BINARY 19
+0006: JUMP ON FALSE 23
+0007: PUSH SCOPE
+0008: LOAD VARIABLE $count
+0009: PUSH LITERAL #3
+0010: BINARY 13
+0011: STORE VARIABLE $count
+0012: LOAD VARIABLE $count
+0013: PUSH LITERAL #4
+0014: BINARY 20
+0015: JUMP ON FALSE 21
+0016: PUSH SCOPE
+0017: POP SCOPE
+0018: POP SCOPE
+0019: JUMP 23
+0020: POP SCOPE
+0021: POP SCOPE
+0022: JUMP 3
+0023: LOAD VARIABLE $count
+0024: RETURN 1
+0025: RETURN 0
+`, cmp.Disassemble(program))
+}
+
+func TestCompileHandlers(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    throw $bad on $failure matching $bad do {
+        return failure
+    }
+}`).ExtractProcedure()
+	var program = cmp.Compile(procedure)
+	ass.Equal(t, `0001: PUSH HANDLER 6
+0002: PUSH LITERAL #1
+0003: THROW
+0004: PULL HANDLER
+0005: JUMP 19
+0006: PUSH SCOPE
+0007: STORE VARIABLE $failure
+0008: LOAD VARIABLE $failure
+0009: PUSH LITERAL #1
+0010: HANDLES
+0011: JUMP ON FALSE 16
+0012: LOAD VARIABLE $failure
+0013: RETURN 1
+0014: POP SCOPE
+0015: JUMP 19
+0016: LOAD VARIABLE $failure
+0017: POP SCOPE
+0018: THROW
+0019: RETURN 0
+`, cmp.Disassemble(program))
+}
+
+func TestProgramCatalog(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    let $greeting := "Hello"
+    return greeting & " World!"
+}`).ExtractProcedure()
+	var program = cmp.Compile(procedure)
+	var source = bal.FormatEntity(program.AsCatalog())
+	var catalog = bal.ParseComponent(source).GetEntity().(abs.CatalogLike)
+	var copy_ = cmp.ProgramFromCatalog(catalog)
+	ass.Equal(t, cmp.Disassemble(program), cmp.Disassemble(copy_))
+	ass.Equal(t, source, bal.FormatEntity(copy_.AsCatalog()))
+	ass.Panics(t, func() {
+		cmp.ProgramFromCatalog(bal.ParseComponent(`[$bytecode: 5]`).GetEntity().(abs.CatalogLike))
+	})
+}
+
+func TestCompileErrors(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    break loop
+}`).ExtractProcedure()
+	ass.Panics(t, func() {
+		cmp.Compile(procedure)
+	})
+}
@@ -0,0 +1,241 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package compiler
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	sts "strings"
+)
+
+// INSTRUCTION SET
+//
+// Each 16-bit instruction consists of a 6-bit operation code followed by a
+// 10-bit operand:
+//
+//	 15          10 9                  0
+//	┌──────────────┬────────────────────┐
+//	│  opcode (6)  │    operand (10)    │
+//	└──────────────┴────────────────────┘
+//
+// So the left byte of an instruction contains the operation code and the two
+// most significant bits of the operand, and the right byte contains the rest
+// of the operand.
+//
+// Depending on the operation code the operand is one of the following:
+//   - an address: the (one based) index of an instruction in the bytecode,
+//   - a literal: the (one based) index of a component in the literal table,
+//   - a symbol: the (one based) index of a symbol in the symbol table,
+//   - a count: the number of components to be popped off the component stack,
+//   - an operator: an abs.Operator value,
+//   - a flag: either zero or one,
+//   - nothing: the operand must be zero.
+//
+// The instructions operate on a stack of components. The comment for each
+// operation code below describes its operand and its effect on the stack.
+// The variables are stored in nested scopes. Each block of statements, each
+// iteration of a with clause and each block of an "on" clause is executed in
+// a new scope, just like it is by the interpreter.
+
+// This type defines the operation code of an instruction.
+type Opcode byte
+
+const (
+	_ Opcode = iota // An operation code of zero is invalid.
+
+	// Jumps (operand: address)
+	JUMP          // Continue execution at the address.
+	JUMP_ON_FALSE // Pop a boolean and continue execution at the address if it is false.
+	PUSH_HANDLER  // Push an exception handler that begins at the address.
+	PULL_HANDLER  // Pull the most recently pushed exception handler (operand: nothing).
+
+	// Variable Scopes (operand: nothing)
+	PUSH_SCOPE // Push a new variable scope that is nested inside the current one.
+	POP_SCOPE  // Pop the current variable scope, discarding the variables defined in it.
+
+	// Loads and Stores
+	PUSH_LITERAL       // Push the literal (operand: literal).
+	POP                // Pop and discard a component (operand: nothing).
+	DUPLICATE          // Push the component that is on top of the stack again (operand: nothing).
+	LIST               // Pop the components and push a list containing them in order (operand: count).
+	LOAD_VARIABLE      // Push the value of the variable (operand: symbol).
+	STORE_VARIABLE     // Pop a value and assign it to the variable (operand: symbol).
+	STORE_DEFAULT      // Pop a value and assign it to the variable if it is not defined (operand: symbol).
+	LOAD_SUBCOMPONENT  // Pop an index and a composite and push the selected value (operand: nothing).
+	STORE_SUBCOMPONENT // Pop an index, a composite and a value and assign the value to the selected subcomponent (operand: assignment operator).
+
+	// Operators and Invocations
+	UNARY            // Pop a component and push the result of applying the operator to it (operand: operator).
+	BINARY           // Pop two components and push the result of applying the operator to them (operand: operator).
+	MATCH            // Pop a template and a value and push whether or not the value matches the template (operand: nothing).
+	HANDLES          // Pop a template and an exception and push whether or not the exception is handled by the template (operand: nothing).
+	INVOKE_INTRINSIC // Pop a list of arguments and push the result of calling the intrinsic function (operand: symbol).
+	INVOKE_METHOD    // Pop a list of arguments and a target and push the result of invoking the method synchronously (operand: symbol).
+	SEND_MESSAGE     // Pop a list of arguments and a target and push the result of sending the method asynchronously (operand: symbol).
+	ITERATE          // Pop a sequence and push an iterator over its items (operand: nothing).
+	NEXT_ITEM        // Push the next item of the iterator on top of the stack, or pop the iterator and jump to the address if there are no more items (operand: address).
+	RETURN           // Return from the procedure, popping its result if the flag is one (operand: flag).
+	THROW            // Pop an exception and throw it (operand: nothing).
+
+	// Message Clauses (operand: nothing)
+	ACCEPT   // Pop a message and accept it.
+	REJECT   // Pop a message and reject it.
+	PUBLISH  // Pop an event and publish it.
+	POST     // Pop a bag and a message and post the message to the bag.
+	RETRIEVE // Pop a bag and push a message retrieved from it.
+
+	// Repository Clauses
+	CHECKOUT // Pop a level if the flag is one, pop a name, and push a draft of the named document (operand: flag).
+	SAVE     // Pop a draft, save it and push the citation to the saved draft (operand: nothing).
+	DISCARD  // Pop a draft and discard it (operand: nothing).
+	NOTARIZE // Pop a name and a draft and notarize the draft as the named document (operand: nothing).
+)
+
+// This constant defines the maximum value of an instruction operand.
+const MaximumOperand = 1<<10 - 1
+
+// This constructor creates a new instruction from the specified operation code
+// and operand.
+func Instruction(opcode Opcode, operand int) abs.Instruction {
+	if opcode < JUMP || opcode > NOTARIZE {
+		var message = fmt.Sprintf("An invalid operation code was specified: %v", opcode)
+		panic(message)
+	}
+	if operand < 0 || operand > MaximumOperand {
+		var message = fmt.Sprintf("The operand %v is out of range for the %v instruction.", operand, opcode)
+		panic(message)
+	}
+	return abs.Instruction(uint16(opcode)<<10 | uint16(operand))
+}
+
+// This function returns the operation code of the specified instruction.
+func GetOpcode(instruction abs.Instruction) Opcode {
+	return Opcode(instruction >> 10)
+}
+
+// This function returns the operand of the specified instruction.
+func GetOperand(instruction abs.Instruction) int {
+	return int(instruction & MaximumOperand)
+}
+
+// This function returns a human readable listing of the bytecode instructions
+// in the specified program. Each line of the listing contains the address of
+// an instruction followed by its operation code and operand. Symbol operands
+// are listed as symbols and literal operands are listed by their index.
+func Disassemble(program abs.ProgramLike) string {
+	var builder sts.Builder
+	var symbols = program.GetSymbols()
+	for index, instruction := range program.GetBytecode().AsArray() {
+		var opcode = GetOpcode(instruction)
+		var operand = GetOperand(instruction)
+		builder.WriteString(fmt.Sprintf("%04d: %v", index+1, opcode))
+		switch kinds[opcode] {
+		case "address", "count", "flag", "operator":
+			builder.WriteString(fmt.Sprintf(" %v", operand))
+		case "literal":
+			builder.WriteString(fmt.Sprintf(" #%v", operand))
+		case "symbol":
+			var symbol = symbols.GetValue(operand).GetEntity().(abs.SymbolLike)
+			builder.WriteString(" $" + string(symbol.AsArray()))
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// This method returns the name of this operation code.
+func (v Opcode) String() string {
+	var name, ok = names[v]
+	if !ok {
+		return fmt.Sprintf("INVALID(%d)", byte(v))
+	}
+	return name
+}
+
+// INSTRUCTION SET IMPLEMENTATION
+
+// This map defines the kind of operand for each operation code.
+var kinds = map[Opcode]string{
+	JUMP:               "address",
+	JUMP_ON_FALSE:      "address",
+	PUSH_HANDLER:       "address",
+	PULL_HANDLER:       "nothing",
+	PUSH_SCOPE:         "nothing",
+	POP_SCOPE:          "nothing",
+	PUSH_LITERAL:       "literal",
+	POP:                "nothing",
+	DUPLICATE:          "nothing",
+	LIST:               "count",
+	LOAD_VARIABLE:      "symbol",
+	STORE_VARIABLE:     "symbol",
+	STORE_DEFAULT:      "symbol",
+	LOAD_SUBCOMPONENT:  "nothing",
+	STORE_SUBCOMPONENT: "operator",
+	UNARY:              "operator",
+	BINARY:             "operator",
+	MATCH:              "nothing",
+	HANDLES:            "nothing",
+	INVOKE_INTRINSIC:   "symbol",
+	INVOKE_METHOD:      "symbol",
+	SEND_MESSAGE:       "symbol",
+	ITERATE:            "nothing",
+	NEXT_ITEM:          "address",
+	RETURN:             "flag",
+	THROW:              "nothing",
+	ACCEPT:             "nothing",
+	REJECT:             "nothing",
+	PUBLISH:            "nothing",
+	POST:               "nothing",
+	RETRIEVE:           "nothing",
+	CHECKOUT:           "flag",
+	SAVE:               "nothing",
+	DISCARD:            "nothing",
+	NOTARIZE:           "nothing",
+}
+
+// This map defines the name of each operation code.
+var names = map[Opcode]string{
+	JUMP:               "JUMP",
+	JUMP_ON_FALSE:      "JUMP ON FALSE",
+	PUSH_HANDLER:       "PUSH HANDLER",
+	PULL_HANDLER:       "PULL HANDLER",
+	PUSH_SCOPE:         "PUSH SCOPE",
+	POP_SCOPE:          "POP SCOPE",
+	PUSH_LITERAL:       "PUSH LITERAL",
+	POP:                "POP",
+	DUPLICATE:          "DUPLICATE",
+	LIST:               "LIST",
+	LOAD_VARIABLE:      "LOAD VARIABLE",
+	STORE_VARIABLE:     "STORE VARIABLE",
+	STORE_DEFAULT:      "STORE DEFAULT",
+	LOAD_SUBCOMPONENT:  "LOAD SUBCOMPONENT",
+	STORE_SUBCOMPONENT: "STORE SUBCOMPONENT",
+	UNARY:              "UNARY",
+	BINARY:             "BINARY",
+	MATCH:              "MATCH",
+	HANDLES:            "HANDLES",
+	INVOKE_INTRINSIC:   "INVOKE INTRINSIC",
+	INVOKE_METHOD:      "INVOKE METHOD",
+	SEND_MESSAGE:       "SEND MESSAGE",
+	ITERATE:            "ITERATE",
+	NEXT_ITEM:          "NEXT ITEM",
+	RETURN:             "RETURN",
+	THROW:              "THROW",
+	ACCEPT:             "ACCEPT",
+	REJECT:             "REJECT",
+	PUBLISH:            "PUBLISH",
+	POST:               "POST",
+	RETRIEVE:           "RETRIEVE",
+	CHECKOUT:           "CHECKOUT",
+	SAVE:               "SAVE",
+	DISCARD:            "DISCARD",
+	NOTARIZE:           "NOTARIZE",
+}
@@ -0,0 +1,102 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package compiler
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+)
+
+// PROGRAM IMPLEMENTATION
+
+// This constructor creates a new program from the specified bytecode and the
+// tables of literal values and symbols that its instructions refer to.
+func Program(bytecode abs.BytecodeLike, literals abs.ListLike, symbols abs.ListLike) abs.ProgramLike {
+	if bytecode == nil || literals == nil || symbols == nil {
+		panic("A program requires bytecode, literals and symbols.")
+	}
+	var v = &program{bytecode, literals, symbols}
+	return v
+}
+
+// This constructor creates a new program from a catalog of the form:
+//
+//	[
+//	    $bytecode: '...'
+//	    $literals: [...]
+//	    $symbols: [...]
+//	]
+//
+// like the one returned by the AsCatalog() method of a program.
+func ProgramFromCatalog(catalog abs.CatalogLike) abs.ProgramLike {
+	var bytecode, ok1 = attributeOf(catalog, "bytecode").(abs.BytecodeLike)
+	var literals, ok2 = attributeOf(catalog, "literals").(abs.ListLike)
+	var symbols, ok3 = attributeOf(catalog, "symbols").(abs.ListLike)
+	if !ok1 || !ok2 || !ok3 {
+		var message = fmt.Sprintf("The catalog does not describe a program: %v",
+			bal.FormatEntity(catalog))
+		panic(message)
+	}
+	return Program(bytecode, literals, symbols)
+}
+
+// This type defines the structure and methods associated with a program.
+type program struct {
+	bytecode abs.BytecodeLike
+	literals abs.ListLike
+	symbols  abs.ListLike
+}
+
+// CATALOGED INTERFACE
+
+// This method returns a catalog containing the bytecode, literals and symbols
+// of this program. The catalog can be formatted as BDN and parsed back into an
+// equivalent program using the ProgramFromCatalog() function.
+func (v *program) AsCatalog() abs.CatalogLike {
+	var catalog = col.Catalog()
+	catalog.SetValue(str.SymbolFromString("bytecode"), com.Component(v.bytecode))
+	catalog.SetValue(str.SymbolFromString("literals"), com.Component(v.literals))
+	catalog.SetValue(str.SymbolFromString("symbols"), com.Component(v.symbols))
+	return catalog
+}
+
+// EXECUTABLE INTERFACE
+
+// This method returns the bytecode instructions for this program.
+func (v *program) GetBytecode() abs.BytecodeLike {
+	return v.bytecode
+}
+
+// This method returns the table of literal values for this program.
+func (v *program) GetLiterals() abs.ListLike {
+	return v.literals
+}
+
+// This method returns the table of symbols for this program.
+func (v *program) GetSymbols() abs.ListLike {
+	return v.symbols
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the entity of the attribute with the specified name in
+// the specified catalog, or nil if there is no such attribute.
+func attributeOf(catalog abs.CatalogLike, name string) abs.Entity {
+	var value = catalog.GetValue(str.SymbolFromString(name))
+	if value == nil {
+		return nil
+	}
+	return value.GetEntity()
+}
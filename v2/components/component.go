@@ -39,6 +39,8 @@ type component struct {
 	entity  abs.Entity
 	context abs.ContextLike
 	note    abs.NoteLike
+	span    abs.SpanLike
+	trivia  abs.TriviaLike
 }
 
 // ENCAPSULATED INTERFACE
@@ -216,6 +218,31 @@ func (v *component) SetNote(note abs.NoteLike) {
 	v.note = note
 }
 
+// SPANNED INTERFACE
+
+// This method returns the span of source code for this component.
+func (v *component) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this component.
+func (v *component) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
+
+// PRESERVED INTERFACE
+
+// This method returns the trivia that was recorded for this component when it
+// was parsed losslessly, or nil if none was recorded.
+func (v *component) GetTrivia() abs.TriviaLike {
+	return v.trivia
+}
+
+// This method sets the trivia for this component.
+func (v *component) SetTrivia(trivia abs.TriviaLike) {
+	v.trivia = trivia
+}
+
 // COMPONENT ITERATOR IMPLEMENTATION
 
 // This constructor creates a new instance of a components iterator that can be
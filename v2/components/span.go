@@ -0,0 +1,86 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package components
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+)
+
+// SPAN IMPLEMENTATION
+
+// This constructor creates a new span of source code. The lines and positions
+// are ordinal (one based) and the offsets are zero based byte indices into the
+// source. The end of the span is just past its last character.
+func Span(
+	startLine, startPosition, startOffset int,
+	endLine, endPosition, endOffset int,
+) abs.SpanLike {
+	if startOffset < 0 || endOffset < startOffset {
+		panic(fmt.Sprintf("The offsets for a span must be valid: %v..%v", startOffset, endOffset))
+	}
+	var v = span{
+		startLine:     startLine,
+		startPosition: startPosition,
+		startOffset:   startOffset,
+		endLine:       endLine,
+		endPosition:   endPosition,
+		endOffset:     endOffset,
+	}
+	return v
+}
+
+// This type defines the structure and methods associated with a span of source
+// code. A span is immutable.
+type span struct {
+	startLine     int
+	startPosition int
+	startOffset   int
+	endLine       int
+	endPosition   int
+	endOffset     int
+}
+
+// This method returns the line number of the first character in this span.
+func (v span) GetStartLine() int {
+	return v.startLine
+}
+
+// This method returns the position in its line of the first character in this
+// span.
+func (v span) GetStartPosition() int {
+	return v.startPosition
+}
+
+// This method returns the byte offset of the first character in this span.
+func (v span) GetStartOffset() int {
+	return v.startOffset
+}
+
+// This method returns the line number of the end of this span.
+func (v span) GetEndLine() int {
+	return v.endLine
+}
+
+// This method returns the position in its line of the end of this span.
+func (v span) GetEndPosition() int {
+	return v.endPosition
+}
+
+// This method returns the byte offset of the end of this span.
+func (v span) GetEndOffset() int {
+	return v.endOffset
+}
+
+// This method returns the canonical string version of this span.
+func (v span) String() string {
+	return fmt.Sprintf("%d:%d", v.startLine, v.startPosition)
+}
@@ -0,0 +1,62 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package components
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+)
+
+// TRIVIA IMPLEMENTATION
+
+// This constructor creates the trivia for a component that was parsed from
+// source code. The segments are the source of the component with the source of
+// each nested component cut out, so there is one more segment than there are
+// nested components. The segments retain the whitespace, blank lines, comments
+// and original spellings of the component. The indentation is the whitespace
+// at the start of the line on which the component begins, and the digest
+// identifies the shape of the component (its canonical form without its nested
+// components) when it was parsed. Trivia is immutable.
+func Trivia(digest string, indentation string, segments []string) abs.TriviaLike {
+	if len(segments) == 0 {
+		panic("The trivia for a component requires at least one segment.")
+	}
+	var v = trivia{
+		digest:      digest,
+		indentation: indentation,
+		segments:    segments,
+	}
+	return v
+}
+
+// This type defines the structure and methods associated with the trivia for
+// a component.
+type trivia struct {
+	digest      string
+	indentation string
+	segments    []string
+}
+
+// This method returns the digest of the shape of the component when it was
+// parsed.
+func (v trivia) GetDigest() string {
+	return v.digest
+}
+
+// This method returns the whitespace at the start of the line on which the
+// component began.
+func (v trivia) GetIndentation() string {
+	return v.indentation
+}
+
+// This method returns the source segments that surround the nested components.
+func (v trivia) GetSegments() []string {
+	return v.segments
+}
@@ -0,0 +1,79 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package digest
+
+import (
+	sha "crypto/sha512"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	sts "strings"
+)
+
+// DIGEST INTERFACE
+
+// This constant names the hash algorithm that is used to generate each digest.
+// It should be recorded alongside any digest that is used in a notary seal.
+const Algorithm = "SHA512"
+
+// This function returns the canonical bytes for the specified component. The
+// canonical bytes are the bytes returned by bali.FormatDocument with the text
+// of each note trimmed of any leading and trailing whitespace. Two components
+// that differ only in their whitespace or note formatting have identical
+// canonical bytes.
+func CanonicalBytes(component abs.ComponentLike) []byte {
+	var document = bal.FormatDocument(component)
+	return normalizeNotes(document)
+}
+
+// This function returns the SHA-512 digest of the canonical bytes for the
+// specified component as a binary string.
+func DigestComponent(component abs.ComponentLike) abs.BinaryLike {
+	var digest = sha.Sum512(CanonicalBytes(component))
+	return bal.Binary(digest[:])
+}
+
+// This function returns the SHA-512 digest of the canonical bytes for the
+// specified component as a base 32 tag. The tag is suitable for use as a
+// content address for the component.
+func DigestComponentAsTag(component abs.ComponentLike) abs.TagLike {
+	var digest = sha.Sum512(CanonicalBytes(component))
+	return bal.Tag(digest[:])
+}
+
+// This function parses the specified document and returns the SHA-512 digest
+// of its canonical bytes as a binary string. It panics if the document is not
+// valid.
+func DigestDocument(document []byte) abs.BinaryLike {
+	var component = bal.ParseDocument(document)
+	return DigestComponent(component)
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns a copy of the specified canonical document with the
+// text of each note trimmed of any leading and trailing whitespace.
+func normalizeNotes(document []byte) []byte {
+	var tokens = make(chan bal.Token, 16)
+	bal.ScanTokens(document, tokens)
+	var result = make([]byte, 0, len(document))
+	var next int // The index of the next byte to be copied to the result.
+	for token := range tokens {
+		if token.Type != bal.TokenNOTE {
+			continue
+		}
+		var text = sts.TrimSpace(token.Value[1:]) // Remove the leading "!".
+		result = append(result, document[next:token.Offset]...)
+		result = append(result, "! "+text...)
+		next = token.Offset + len(token.Value)
+	}
+	result = append(result, document[next:]...)
+	return result
+}
@@ -0,0 +1,74 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package digest_test
+
+import (
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	dig "github.com/bali-nebula/go-component-framework/v2/digest"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	sts "strings"
+	tes "testing"
+)
+
+const testDirectory = "../bali/test/"
+
+func TestDigestDocuments(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ../bali/test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var source, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(source)
+			ass.Equal(t, string(source), string(dig.CanonicalBytes(component)), filename)
+			var digest = dig.DigestDocument(source)
+			ass.Equal(t, 64, digest.GetSize(), filename)
+			ass.Equal(t, digest.AsArray(), dig.DigestComponent(component).AsArray(), filename)
+		}
+	}
+}
+
+func TestDigestFormatting(t *tes.T) {
+	var first = dig.DigestDocument([]byte(`[
+    $name: "Alice"  ! The name.
+    $tags: [
+        "first"
+        "second"
+    ]($type: /bali/types/collections/Set/v1)
+]
+`))
+	var second = dig.DigestDocument([]byte(`[
+        $name:   "Alice"     !    The name.   
+  $tags:  ["first",  "second"](  $type:  /bali/types/collections/Set/v1  )
+]
+`))
+	var third = dig.DigestDocument([]byte(`[
+    $name: "Bob"  ! The name.
+    $tags: ["first", "second"]($type: /bali/types/collections/Set/v1)
+]
+`))
+	ass.Equal(t, first.AsArray(), second.AsArray())
+	ass.NotEqual(t, first.AsArray(), third.AsArray())
+}
+
+func TestDigestTags(t *tes.T) {
+	var component = bal.ParseComponent(`[$name: "Alice"]  !   A person.  `)
+	ass.Equal(t, "[$name: \"Alice\"]  ! A person.\n", string(dig.CanonicalBytes(component)))
+	var tag = dig.DigestComponentAsTag(component)
+	var binary = dig.DigestComponent(component)
+	ass.Equal(t, bal.FormatEntity(bal.Tag(binary.AsArray())), bal.FormatEntity(tag))
+	component = bal.ParseComponent(`[$name: "Alice"]  ! A person.`)
+	ass.Equal(t, bal.FormatEntity(tag), bal.FormatEntity(dig.DigestComponentAsTag(component)))
+}
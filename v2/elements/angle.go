@@ -84,6 +84,10 @@ func (c *angleClass_) FromString(string_ string) AngleLike {
 		panic(message)
 	}
 	var float = floatFromString(matches[1]) // Strip off the leading '~' character.
+	if float == Tau {
+		// An explicit tau is kept as the upper endpoint of the allowed range.
+		return c.Tau()
+	}
 	var angle = c.FromFloat(float)
 	return angle
 }
@@ -282,6 +282,46 @@ type ResourceLike interface {
 
 // PACKAGE FUNCTIONS
 
+// Public Functions
+
+// This public function returns a string describing the type of the specified
+// element. This approach is used because the type switch CANNOT distinguish
+// between abstract "Like" types if they support exactly the same method sets.
+// The type switch CAN distinguish between the private element types. An empty
+// string is returned if the specified value is not an element.
+func GetType(element Element) string {
+	switch element.(type) {
+	case angle_:
+		return "Angle"
+	case boolean_:
+		return "Boolean"
+	case character_:
+		return "Character"
+	case citation_:
+		return "Citation"
+	case duration_:
+		return "Duration"
+	case float_:
+		return "Float"
+	case integer_:
+		return "Integer"
+	case moment_:
+		return "Moment"
+	case number_:
+		return "Number"
+	case pattern_:
+		return "Pattern"
+	case percentage_:
+		return "Percentage"
+	case probability_:
+		return "Probability"
+	case resource_:
+		return "Resource"
+	default:
+		return ""
+	}
+}
+
 // Private Functions
 
 // This private function returns the complex number associated with the
@@ -292,7 +332,15 @@ func complexFromMatches(matches []string) complex128 {
 	case len(matches[2]) > 0:
 		// This is a complex number in rectangular form.
 		var realPart = floatFromString(matches[1])
-		var imaginaryPart = floatFromString(matches[3][:len(matches[3])-1])
+		var imaginaryPart float64
+		switch imaginary := matches[3][:len(matches[3])-1]; imaginary {
+		case "", "+":
+			imaginaryPart = 1
+		case "-":
+			imaginaryPart = -1
+		default:
+			imaginaryPart = floatFromString(imaginary)
+		}
 		complex_ = complex(realPart, imaginaryPart)
 	case len(matches[5]) > 0:
 		// This is a complex number in polar form.
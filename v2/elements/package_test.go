@@ -0,0 +1,31 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package elements_test
+
+import (
+	ele "github.com/bali-nebula/go-component-framework/v2/elements"
+	ass "github.com/stretchr/testify/assert"
+	tes "testing"
+)
+
+func TestGetType(t *tes.T) {
+	// An angle and a float support exactly the same methods.
+	ass.Equal(t, "Angle", ele.GetType(ele.Angle().Pi()))
+	ass.Equal(t, "Float", ele.GetType(ele.Float().FromFloat(3.14)))
+	ass.Equal(t, "Boolean", ele.GetType(ele.Boolean().True()))
+	ass.Equal(t, "Duration", ele.GetType(ele.Duration().FromMilliseconds(5)))
+	ass.Equal(t, "Moment", ele.GetType(ele.Moment().Epoch()))
+	ass.Equal(t, "Number", ele.GetType(ele.Number().One()))
+	ass.Equal(t, "Pattern", ele.GetType(ele.Pattern().Any()))
+	ass.Equal(t, "Percentage", ele.GetType(ele.Percentage().FromFloat(50)))
+	ass.Equal(t, "Probability", ele.GetType(ele.Probability().FromFloat(0.5)))
+	ass.Equal(t, "", ele.GetType("not an element"))
+}
@@ -78,7 +78,7 @@ func (c *probabilityClass_) FromString(string_ string) ProbabilityLike {
 		var message = fmt.Sprintf("Attempted to construct a probability from an invalid string: %v", string_)
 		panic(message)
 	}
-	var float, _ = stc.ParseFloat(matches[1], 64) // Strip off the '%' suffix.
+	var float, _ = stc.ParseFloat(matches[0], 64)
 	var probability = probability_(float)
 	return probability
 }
@@ -33,6 +33,7 @@ type arithmeticExpression struct {
 	first    abs.Expression
 	operator abs.Operator
 	second   abs.Expression
+	span     abs.SpanLike
 }
 
 // This method returns the first expression in this arithmetic expression.
@@ -76,3 +77,13 @@ func (v *arithmeticExpression) SetSecond(second abs.Expression) {
 	}
 	v.second = second
 }
+
+// This method returns the span of source code for this arithmetic expression.
+func (v *arithmeticExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this arithmetic expression.
+func (v *arithmeticExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
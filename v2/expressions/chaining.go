@@ -32,6 +32,7 @@ type chainingExpression struct {
 	first    abs.Expression
 	operator abs.Operator
 	second   abs.Expression
+	span     abs.SpanLike
 }
 
 // This method returns the first expression in this chaining expression.
@@ -75,3 +76,13 @@ func (v *chainingExpression) SetSecond(second abs.Expression) {
 	}
 	v.second = second
 }
+
+// This method returns the span of source code for this chaining expression.
+func (v *chainingExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this chaining expression.
+func (v *chainingExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -32,6 +32,7 @@ type comparisonExpression struct {
 	first    abs.Expression
 	operator abs.Operator
 	second   abs.Expression
+	span     abs.SpanLike
 }
 
 // This method returns the first expression in this comparison expression.
@@ -75,3 +76,13 @@ func (v *comparisonExpression) SetSecond(second abs.Expression) {
 	}
 	v.second = second
 }
+
+// This method returns the span of source code for this comparison expression.
+func (v *comparisonExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this comparison expression.
+func (v *comparisonExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
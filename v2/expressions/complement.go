@@ -30,6 +30,7 @@ func Complement(operator abs.Operator, expression abs.Expression) abs.UnaryOpera
 type complementExpression struct {
 	operator   abs.Operator
 	expression abs.Expression
+	span       abs.SpanLike
 }
 
 // This method returns the complement operator in this complement expression.
@@ -60,3 +61,13 @@ func (v *complementExpression) SetExpression(expression abs.Expression) {
 	}
 	v.expression = expression
 }
+
+// This method returns the span of source code for this complement expression.
+func (v *complementExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this complement expression.
+func (v *complementExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -30,6 +30,7 @@ func Dereference(operator abs.Operator, expression abs.Expression) abs.UnaryOper
 type dereferenceExpression struct {
 	operator   abs.Operator
 	expression abs.Expression
+	span       abs.SpanLike
 }
 
 // This method returns the dereference operator in this dereference expression.
@@ -60,3 +61,13 @@ func (v *dereferenceExpression) SetExpression(expression abs.Expression) {
 	}
 	v.expression = expression
 }
+
+// This method returns the span of source code for this dereference expression.
+func (v *dereferenceExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this dereference expression.
+func (v *dereferenceExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -32,6 +32,7 @@ type exponentialExpression struct {
 	base     abs.Expression
 	operator abs.Operator
 	exponent abs.Expression
+	span     abs.SpanLike
 }
 
 // This method returns the first expression in this exponential expression.
@@ -75,3 +76,13 @@ func (v *exponentialExpression) SetSecond(exponent abs.Expression) {
 	}
 	v.exponent = exponent
 }
+
+// This method returns the span of source code for this exponential expression.
+func (v *exponentialExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this exponential expression.
+func (v *exponentialExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -30,6 +30,7 @@ func Intrinsic(function string, arguments abs.Sequential[abs.Expression]) abs.In
 type intrinsicExpression struct {
 	function  string
 	arguments abs.Sequential[abs.Expression]
+	span      abs.SpanLike
 }
 
 // This method returns the function name for this intrinsic expression.
@@ -57,3 +58,13 @@ func (v *intrinsicExpression) SetArguments(arguments abs.Sequential[abs.Expressi
 	}
 	v.arguments = arguments
 }
+
+// This method returns the span of source code for this intrinsic expression.
+func (v *intrinsicExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this intrinsic expression.
+func (v *intrinsicExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
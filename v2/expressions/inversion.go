@@ -30,6 +30,7 @@ func Inversion(operator abs.Operator, expression abs.Expression) abs.UnaryOperat
 type inversionExpression struct {
 	operator   abs.Operator
 	expression abs.Expression
+	span       abs.SpanLike
 }
 
 // This method returns the inversion operator in this inversion expression.
@@ -60,3 +61,13 @@ func (v *inversionExpression) SetExpression(expression abs.Expression) {
 	}
 	v.expression = expression
 }
+
+// This method returns the span of source code for this inversion expression.
+func (v *inversionExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this inversion expression.
+func (v *inversionExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
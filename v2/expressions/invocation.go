@@ -39,6 +39,7 @@ type invocationExpression struct {
 	operator  abs.Operator
 	method    string
 	arguments abs.Sequential[abs.Expression]
+	span      abs.SpanLike
 }
 
 // This method determines whether or not this invocation expression is
@@ -98,3 +99,13 @@ func (v *invocationExpression) SetArguments(arguments abs.Sequential[abs.Express
 	}
 	v.arguments = arguments
 }
+
+// This method returns the span of source code for this invocation expression.
+func (v *invocationExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this invocation expression.
+func (v *invocationExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
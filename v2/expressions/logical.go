@@ -33,6 +33,7 @@ type logicalExpression struct {
 	first    abs.Expression
 	operator abs.Operator
 	second   abs.Expression
+	span     abs.SpanLike
 }
 
 // This method returns the first expression in this logical expression.
@@ -76,3 +77,13 @@ func (v *logicalExpression) SetSecond(second abs.Expression) {
 	}
 	v.second = second
 }
+
+// This method returns the span of source code for this logical expression.
+func (v *logicalExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this logical expression.
+func (v *logicalExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
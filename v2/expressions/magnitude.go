@@ -28,6 +28,7 @@ func Magnitude(expression abs.Expression) abs.UnaryOperationLike {
 // expression.
 type magnitudeExpression struct {
 	expression abs.Expression
+	span       abs.SpanLike
 }
 
 // This method returns the magnitude operator in this magnitude expression.
@@ -54,3 +55,13 @@ func (v *magnitudeExpression) SetExpression(expression abs.Expression) {
 	}
 	v.expression = expression
 }
+
+// This method returns the span of source code for this magnitude expression.
+func (v *magnitudeExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this magnitude expression.
+func (v *magnitudeExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
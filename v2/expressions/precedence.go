@@ -28,6 +28,7 @@ func Precedence(expression abs.Expression) abs.UnaryOperationLike {
 // expression.
 type precedenceExpression struct {
 	expression abs.Expression
+	span       abs.SpanLike
 }
 
 // This method returns the precedence operator in this precedence expression.
@@ -53,3 +54,13 @@ func (v *precedenceExpression) SetExpression(expression abs.Expression) {
 	}
 	v.expression = expression
 }
+
+// This method returns the span of source code for this precedence expression.
+func (v *precedenceExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this precedence expression.
+func (v *precedenceExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
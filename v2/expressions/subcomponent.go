@@ -30,6 +30,7 @@ func Subcomponent(composite abs.Expression, indices abs.Sequential[abs.Expressio
 type subcomponentExpression struct {
 	composite abs.Expression
 	indices   abs.Sequential[abs.Expression]
+	span      abs.SpanLike
 }
 
 // This method returns the composite for this subcomponent expression.
@@ -57,3 +58,13 @@ func (v *subcomponentExpression) SetIndices(indices abs.Sequential[abs.Expressio
 	}
 	v.indices = indices
 }
+
+// This method returns the span of source code for this subcomponent expression.
+func (v *subcomponentExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this subcomponent expression.
+func (v *subcomponentExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
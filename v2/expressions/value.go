@@ -28,6 +28,7 @@ func Value(component abs.ComponentLike) abs.ValueLike {
 // expression.
 type valueExpression struct {
 	component abs.ComponentLike
+	span      abs.SpanLike
 }
 
 // This method returns the component for this value expression.
@@ -42,3 +43,13 @@ func (v *valueExpression) SetComponent(component abs.ComponentLike) {
 	}
 	v.component = component
 }
+
+// This method returns the span of source code for this value expression.
+func (v *valueExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this value expression.
+func (v *valueExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -28,6 +28,7 @@ func Variable(identifier string) abs.VariableLike {
 // expression.
 type variableExpression struct {
 	identifier string
+	span       abs.SpanLike
 }
 
 // This method returns the identifier for this variable expression.
@@ -42,3 +43,13 @@ func (v *variableExpression) SetIdentifier(identifier string) {
 	}
 	v.identifier = identifier
 }
+
+// This method returns the span of source code for this variable expression.
+func (v *variableExpression) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this variable expression.
+func (v *variableExpression) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -0,0 +1,352 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package html
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	htm "html"
+	url "net/url"
+	stc "strconv"
+	sts "strings"
+)
+
+// FORMATTER INTERFACE
+
+// This function returns an HTML fragment that renders the specified component
+// for human readers. The component is rendered as follows:
+//
+//   - A catalog is rendered as a definition list of its associations.
+//   - A list, set, queue or stack is rendered as a table of its values.
+//   - A narrative is rendered as paragraphs.
+//   - A resource is rendered as a link if its scheme is safe to follow.
+//   - A moment is rendered as a time element.
+//   - A procedure is rendered as a syntax highlighted code block.
+//   - Any other entity is rendered as its syntax highlighted BDN string.
+//   - The parameters of a context are rendered as a definition list and a
+//     note is rendered as a paragraph following the entity.
+//
+// The syntax highlighting wraps each token in a span element whose class is
+// the lowercase name of the token type (e.g. "keyword" or "symbol").
+func FormatComponent(component abs.ComponentLike) string {
+	var v = &formatter{}
+	v.formatComponent(component)
+	return v.result.String()
+}
+
+// This function returns a complete HTML page that renders the specified
+// component using a default style sheet. The page ends with the POSIX standard
+// trailing EOL.
+func FormatDocument(component abs.ComponentLike) []byte {
+	var v = &formatter{depth: 2}
+	v.formatComponent(component)
+	var s = `<!DOCTYPE html>
+<html>
+    <head>
+        <meta charset="utf-8">
+        <title>Bali Document</title>
+        <style>` + styles + `        </style>
+    </head>
+    <body>
+        ` + v.result.String() + `
+    </body>
+</html>
+`
+	return []byte(s)
+}
+
+// FORMATTER IMPLEMENTATION
+
+// This constant defines the default style sheet for a rendered document.
+const styles = `
+            body { font-family: sans-serif; }
+            table, dl.context { border-left: 2px solid #ccc; padding-left: 0.5em; }
+            th { color: #888; font-weight: normal; text-align: right; vertical-align: top; }
+            dt { font-weight: bold; }
+            p.note { color: #888; font-style: italic; }
+            .keyword { color: #a626a4; font-weight: bold; }
+            .comment, .note { color: #a0a1a7; font-style: italic; }
+            .symbol, .identifier { color: #e45649; }
+            .quote, .narrative, .binary { color: #50a14f; }
+            .number, .percentage, .probability, .angle, .boolean { color: #986801; }
+            .moment, .duration, .resource, .name, .tag, .version { color: #4078f2; }
+            .intrinsic { color: #0184bc; }
+`
+
+// This map defines the URL schemes of the resources that are rendered
+// as links.
+var schemes = map[string]bool{
+	"ftp":    true,
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+}
+
+// This type defines the structure and methods for an HTML formatting agent.
+type formatter struct {
+	depth  int
+	result sts.Builder
+}
+
+// This method appends the specified string to the result.
+func (v *formatter) appendString(string_ string) {
+	v.result.WriteString(string_)
+}
+
+// This method appends a properly indented newline to the result.
+func (v *formatter) appendNewline() {
+	var separator = bal.EOL
+	for level := 0; level < v.depth; level++ {
+		separator += "    "
+	}
+	v.result.WriteString(separator)
+}
+
+// This method appends the specified component to the result as the content of
+// an element with the specified tag.
+func (v *formatter) appendElement(tag string, component abs.ComponentLike) {
+	v.appendString("<" + tag + ">")
+	if isBlock(component) {
+		v.depth++
+		v.appendNewline()
+		v.formatComponent(component)
+		v.depth--
+		v.appendNewline()
+	} else {
+		v.formatComponent(component)
+	}
+	v.appendString("</" + tag + ">")
+}
+
+// This method adds the HTML for the specified component to the state of the
+// formatter.
+func (v *formatter) formatComponent(component abs.ComponentLike) {
+	var entity = component.GetEntity()
+	if !component.IsParameterized() && !component.IsAnnotated() {
+		v.formatEntity(entity)
+		return
+	}
+	v.appendString(`<div class="component">`)
+	v.depth++
+	v.appendNewline()
+	v.formatEntity(entity)
+	if component.IsParameterized() {
+		v.appendNewline()
+		v.formatContext(component.GetContext())
+	}
+	if component.IsAnnotated() {
+		v.appendNewline()
+		var note = string(component.GetNote().AsArray())
+		v.appendString(`<p class="note">` + htm.EscapeString(note) + `</p>`)
+	}
+	v.depth--
+	v.appendNewline()
+	v.appendString(`</div>`)
+}
+
+// This method adds the HTML for the specified context to the state of the
+// formatter.
+func (v *formatter) formatContext(context abs.ContextLike) {
+	v.appendString(`<dl class="context">`)
+	v.depth++
+	var iterator = com.ParameterIterator(context)
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		v.appendNewline()
+		v.appendString("<dt>")
+		v.formatSource(parameter.GetKey())
+		v.appendString("</dt>")
+		v.appendNewline()
+		v.appendElement("dd", parameter.GetValue())
+	}
+	v.depth--
+	v.appendNewline()
+	v.appendString(`</dl>`)
+}
+
+// This method adds the HTML for the specified entity to the state of the
+// formatter.
+func (v *formatter) formatEntity(entity abs.Entity) {
+	switch bal.GetType(entity) {
+	case "Narrative":
+		v.formatNarrative(entity.(abs.NarrativeLike))
+	case "Moment":
+		v.formatMoment(entity.(abs.MomentLike))
+	case "Resource":
+		v.formatResource(entity.(abs.ResourceLike))
+	case "List", "Queue", "Set", "Stack":
+		v.formatValues(entity.(abs.ValuesLike))
+	case "Catalog":
+		v.formatAssociations(entity.(abs.AssociationsLike))
+	case "Procedure":
+		v.formatProcedure(entity.(abs.ProcedureLike))
+	default:
+		v.formatSource(entity)
+	}
+}
+
+// This method adds the HTML for the specified catalog associations to the
+// state of the formatter.
+func (v *formatter) formatAssociations(associations abs.AssociationsLike) {
+	v.appendString(`<dl class="catalog">`)
+	v.depth++
+	var iterator = col.AssociationIterator(associations)
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		v.appendNewline()
+		v.appendString("<dt>")
+		v.formatSource(association.GetKey())
+		v.appendString("</dt>")
+		v.appendNewline()
+		v.appendElement("dd", association.GetValue())
+	}
+	v.depth--
+	v.appendNewline()
+	v.appendString(`</dl>`)
+}
+
+// This method adds the HTML for the specified moment to the state of the
+// formatter.
+func (v *formatter) formatMoment(moment abs.MomentLike) {
+	var source = bal.FormatEntity(moment)
+	var datetime = sts.Trim(source, "<>")
+	v.appendString(`<time class="moment" datetime="` + htm.EscapeString(datetime) + `">`)
+	v.appendString(htm.EscapeString(source))
+	v.appendString(`</time>`)
+}
+
+// This method adds the HTML for the specified narrative to the state of the
+// formatter. Each block of lines separated by an empty line is rendered as a
+// paragraph.
+func (v *formatter) formatNarrative(narrative abs.NarrativeLike) {
+	var paragraphs []string
+	var lines []string
+	for _, line := range sts.Split(narrative.AsString(), bal.EOL) {
+		if len(sts.TrimSpace(line)) > 0 {
+			lines = append(lines, htm.EscapeString(line))
+		} else if len(lines) > 0 {
+			paragraphs = append(paragraphs, sts.Join(lines, "<br>"))
+			lines = nil
+		}
+	}
+	if len(lines) > 0 {
+		paragraphs = append(paragraphs, sts.Join(lines, "<br>"))
+	}
+	v.appendString(`<div class="narrative">`)
+	v.depth++
+	for _, paragraph := range paragraphs {
+		v.appendNewline()
+		v.appendString("<p>" + paragraph + "</p>")
+	}
+	v.depth--
+	v.appendNewline()
+	v.appendString(`</div>`)
+}
+
+// This method adds the HTML for the specified procedure to the state of the
+// formatter. Since the whitespace in a code block is significant, the
+// procedure is not indented.
+func (v *formatter) formatProcedure(procedure abs.ProcedureLike) {
+	v.appendString(`<pre class="procedure"><code>`)
+	v.appendString(highlight(bal.FormatEntity(procedure)))
+	v.appendString(`</code></pre>`)
+}
+
+// This method adds the HTML for the specified resource to the state of the
+// formatter. Only resources whose schemes are safe to follow are rendered as
+// links.
+func (v *formatter) formatResource(resource abs.ResourceLike) {
+	var source = bal.FormatEntity(resource)
+	var reference = sts.Trim(source, "<>")
+	var parsed, err = url.Parse(reference)
+	if err != nil || !schemes[sts.ToLower(parsed.Scheme)] {
+		v.formatSource(resource)
+		return
+	}
+	v.appendString(`<a class="resource" href="` + htm.EscapeString(reference) + `">`)
+	v.appendString(htm.EscapeString(source))
+	v.appendString(`</a>`)
+}
+
+// This method adds the syntax highlighted BDN string for the specified entity
+// to the state of the formatter.
+func (v *formatter) formatSource(entity abs.Entity) {
+	v.appendString("<code>" + highlight(bal.FormatEntity(entity)) + "</code>")
+}
+
+// This method adds the HTML for the specified collection values to the state
+// of the formatter.
+func (v *formatter) formatValues(values abs.ValuesLike) {
+	var class = "list"
+	switch values.(type) {
+	case abs.QueueLike:
+		class = "queue"
+	case abs.SetLike:
+		class = "set"
+	case abs.StackLike:
+		class = "stack"
+	}
+	v.appendString(`<table class="` + class + `">`)
+	v.depth++
+	var index = 0
+	var iterator = com.ComponentIterator(values)
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		index++
+		v.appendNewline()
+		v.appendString("<tr>")
+		v.depth++
+		v.appendNewline()
+		v.appendString("<th>" + stc.Itoa(index) + "</th>")
+		v.appendNewline()
+		v.appendElement("td", value)
+		v.depth--
+		v.appendNewline()
+		v.appendString("</tr>")
+	}
+	v.depth--
+	v.appendNewline()
+	v.appendString(`</table>`)
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the HTML for the specified BDN source string with each
+// of its tokens wrapped in a span element whose class names the token type.
+func highlight(source string) string {
+	var builder sts.Builder
+	bal.WalkTokens(source, func(text string, token *bal.Token) {
+		text = htm.EscapeString(text)
+		if token == nil || token.Type == bal.TokenDELIMITER || token.Type == bal.TokenEOL {
+			builder.WriteString(text)
+			return
+		}
+		var class = sts.ToLower(string(token.Type))
+		builder.WriteString(`<span class="` + class + `">` + text + `</span>`)
+	})
+	return builder.String()
+}
+
+// This function determines whether or not the HTML for the specified component
+// is a block rather than an inline element.
+func isBlock(component abs.ComponentLike) bool {
+	if component.IsParameterized() || component.IsAnnotated() {
+		return true
+	}
+	switch bal.GetType(component.GetEntity()) {
+	case "Narrative", "List", "Queue", "Set", "Stack", "Catalog", "Procedure":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,79 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package html_test
+
+import (
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	htm "github.com/bali-nebula/go-component-framework/v2/html"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	sts "strings"
+	tes "testing"
+)
+
+const testDirectory = "../bali/test/"
+
+func TestHTMLDocuments(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ../bali/test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var source, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(source)
+			var document = string(htm.FormatDocument(component))
+			ass.True(t, sts.HasPrefix(document, "<!DOCTYPE html>"), filename)
+			ass.True(t, sts.HasSuffix(document, "</html>\n"), filename)
+		}
+	}
+}
+
+func TestHTMLRendering(t *tes.T) {
+	var component = bal.ParseComponent(`[
+    $title: "Release <Notes>"
+    $published: <2024-03-31T12:30:15>
+    $link: <https://github.com/bali-nebula>
+    $script: <javascript:alert(1)>
+    $summary: ">
+        First paragraph
+        continues here.
+
+        Second paragraph.
+    <"
+    $tags: [
+        #BCH1SWKY4ZT8DC3JKSC3BH9CHTWR8PAV
+        v1.2
+    ]($type: /bali/types/collections/Set/v1)  ! A set of tags.
+    $check: {
+        if $ready do {
+            return true
+        }
+    }
+]`)
+	var html = htm.FormatComponent(component)
+	ass.True(t, sts.HasPrefix(html, `<dl class="catalog">`))
+	ass.Contains(t, html, `<dt><code><span class="symbol">$title</span></code></dt>`)
+	ass.Contains(t, html, `<span class="quote">&#34;Release &lt;Notes&gt;&#34;</span>`)
+	ass.Contains(t, html, `<time class="moment" datetime="2024-03-31T12:30:15">&lt;2024-03-31T12:30:15&gt;</time>`)
+	ass.Contains(t, html, `<a class="resource" href="https://github.com/bali-nebula">&lt;https://github.com/bali-nebula&gt;</a>`)
+	ass.NotContains(t, html, `href="javascript`)
+	ass.Contains(t, html, `<p>First paragraph<br>continues here.</p>`)
+	ass.Contains(t, html, `<p>Second paragraph.</p>`)
+	ass.Contains(t, html, `<table class="set">`)
+	ass.Contains(t, html, `<th>2</th>`)
+	ass.Contains(t, html, `<dl class="context">`)
+	ass.Contains(t, html, `<p class="note">A set of tags.</p>`)
+	ass.Contains(t, html, `<pre class="procedure"><code>{`)
+	ass.Contains(t, html, `<span class="keyword">if</span> <span class="symbol">$ready</span>`)
+}
@@ -0,0 +1,81 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package interpreter
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+)
+
+// ENVIRONMENT IMPLEMENTATION
+
+// This constructor creates a new environment with no variables defined. The
+// new environment does not support dereferencing or method invocation. An
+// environment that does may be created by embedding this environment in a
+// structure that defines the Dereference() and Invoke() methods.
+func Environment() abs.EnvironmentLike {
+	var v = &environment{variables: map[string]abs.ComponentLike{}}
+	return v
+}
+
+// This type defines the structure and methods associated with an environment.
+type environment struct {
+	variables map[string]abs.ComponentLike
+}
+
+// DELEGATING INTERFACE
+
+// This method returns the component that the specified reference refers to.
+func (v *environment) Dereference(reference abs.ComponentLike) abs.ComponentLike {
+	var message = fmt.Sprintf("This environment cannot dereference: %v", bal.FormatComponent(reference))
+	panic(message)
+}
+
+// This method invokes the specified method on the specified target component
+// and returns the result.
+func (v *environment) Invoke(
+	target abs.ComponentLike,
+	method string,
+	arguments abs.Sequential[abs.ComponentLike],
+	isSynchronous bool,
+) abs.ComponentLike {
+	var message = fmt.Sprintf("This environment cannot invoke the %v method on: %v", method, bal.FormatComponent(target))
+	panic(message)
+}
+
+// SCOPED INTERFACE
+
+// This method determines whether or not the specified variable is defined in
+// this environment.
+func (v *environment) IsDefined(variable string) bool {
+	var _, ok = v.variables[variable]
+	return ok
+}
+
+// This method returns the value of the specified variable. It panics if the
+// variable is not defined.
+func (v *environment) GetVariable(variable string) abs.ComponentLike {
+	var value, ok = v.variables[variable]
+	if !ok {
+		var message = fmt.Sprintf("The variable %v is not defined.", variable)
+		panic(message)
+	}
+	return value
+}
+
+// This method sets the value of the specified variable.
+func (v *environment) SetVariable(variable string, value abs.ComponentLike) {
+	if value == nil {
+		panic("The value of a variable cannot be nil.")
+	}
+	v.variables[variable] = value
+}
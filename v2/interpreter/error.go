@@ -0,0 +1,123 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package interpreter
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	run "runtime"
+)
+
+// EXECUTION ERROR INTERFACE
+
+// This type defines the structure of an error that occurred while executing a
+// procedure and was not handled by any "on" clause. The error carries the
+// location of the statement that failed and the exception that was either
+// thrown by the statement or describes why the statement failed.
+type ExecutionError struct {
+	Line      int               // The line number of the failing statement.
+	Position  int               // The position in the line of the failing statement.
+	Exception abs.ComponentLike // The exception that was not handled.
+	Message   string            // A plain text description of the error.
+}
+
+// This method returns the plain text rendering of this execution error.
+func (v *ExecutionError) Error() string {
+	return fmt.Sprintf("%v (line %v, position %v)", v.Message, v.Line, v.Position)
+}
+
+// EXECUTION ERROR IMPLEMENTATION
+
+// This type defines the structure of a failure that is unwinding the statements
+// of a procedure. A failure is passed along using a panic so that it can be
+// handled by the "on" clause of any statement that encloses the failing one. A
+// structural failure (e.g. a break clause that is not inside a loop) is caused
+// by the structure of the procedure itself, so it cannot be handled.
+type failure struct {
+	exception  abs.ComponentLike
+	message    string
+	span       abs.SpanLike
+	structural bool
+}
+
+// This method returns the execution error that describes this failure.
+func (v *failure) asError() *ExecutionError {
+	var err = &ExecutionError{
+		Exception: v.exception,
+		Message:   v.message,
+	}
+	if v.span != nil {
+		err.Line = v.span.GetStartLine()
+		err.Position = v.span.GetStartPosition()
+	}
+	return err
+}
+
+// This function returns a failure for the specified thrown exception.
+func exceptionFailure(exception abs.ComponentLike) *failure {
+	var message = "An unhandled exception was thrown: " + bal.FormatComponent(exception)
+	return &failure{exception: exception, message: message}
+}
+
+// This function returns a structural failure with the specified message.
+func structuralFailure(message string) *failure {
+	var result = messageFailure(message)
+	result.structural = true
+	return result
+}
+
+// This function returns the failure described by the specified recovered panic
+// value. Any panic other than a failure (e.g. an invalid operation in an
+// expression) is turned into a failure whose exception is a quote containing
+// the panic message. A runtime error is a bug in the interpreter rather than a
+// failure of the procedure so it is passed along. The location of a failure
+// that does not yet have one is set to the specified span.
+func failureFrom(value any, span abs.SpanLike) *failure {
+	var result *failure
+	switch actual := value.(type) {
+	case *failure:
+		result = actual
+	case run.Error:
+		panic(actual)
+	case error:
+		result = messageFailure(actual.Error())
+	default:
+		result = messageFailure(fmt.Sprintf("%v", actual))
+	}
+	if result.span == nil {
+		result.span = span
+	}
+	return result
+}
+
+// This function returns a failure with the specified message as its exception.
+func messageFailure(message string) *failure {
+	var exception = com.Component(str.QuoteFromArray([]rune(message)))
+	return &failure{exception: exception, message: message}
+}
+
+// This function recovers from a panic caused by a failure and stores the
+// corresponding execution error in the specified error variable. Any other
+// panic is passed along.
+func catchExecutionError(err *error) {
+	var e = recover()
+	if e == nil {
+		return
+	}
+	var executionError, ok = e.(*ExecutionError)
+	if !ok {
+		panic(e)
+	}
+	*err = executionError
+}
@@ -0,0 +1,571 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package interpreter
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	ele "github.com/bali-nebula/go-component-framework/v2/elements"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	mat "math"
+	ref "reflect"
+	sts "strings"
+)
+
+// EVALUATOR INTERFACE
+
+// This function returns the component that results from evaluating the
+// specified expression. Variables are looked up in the specified environment
+// and any dereferences and method invocations are delegated to it. Each
+// operator is applied using the library functions for the types of its
+// operands (e.g. Number().Sum() for "+" on two numbers, Angle().Scaled() for
+// "*" on an angle and a number, or Boolean().Xor() for "XOR" on two booleans).
+// This function panics if an operator is not supported for the types of its
+// operands.
+func Evaluate(expression abs.Expression, environment abs.EnvironmentLike) abs.ComponentLike {
+	var v = &evaluator{environment}
+	return v.evaluateExpression(expression)
+}
+
+// This function returns the result of applying the specified binary operator to
+// the specified components. It panics if the operator is not supported for the
+// types of the components.
+func Operate(first abs.ComponentLike, operator abs.Operator, second abs.ComponentLike) abs.ComponentLike {
+	var a = first.GetEntity()
+	var b = second.GetEntity()
+	var entity abs.Entity
+	switch operator {
+	case abs.AMPERSAND:
+		entity = chain(a, b)
+	case abs.PLUS, abs.MINUS, abs.STAR, abs.SLASH, abs.MODULO:
+		entity = calculate(a, operator, b)
+	case abs.CARET:
+		entity = exponentiate(a, b)
+	case abs.LESS, abs.EQUAL, abs.UNEQUAL, abs.MORE, abs.IS, abs.MATCHES:
+		entity = compare(first, operator, second)
+	case abs.AND, abs.SANS, abs.OR, abs.XOR:
+		entity = combine(a, operator, b)
+	}
+	if entity == nil {
+		var message = fmt.Sprintf("The %v operator cannot be applied to %v and %v.",
+			operators[operator], bal.FormatEntity(a), bal.FormatEntity(b))
+		panic(message)
+	}
+	return com.Component(entity)
+}
+
+// This function returns the result of applying the specified unary operator
+// (e.g. "-" or "NOT") to the specified component. It panics if the operator is
+// not supported for the type of the component.
+func Apply(operator abs.Operator, component abs.ComponentLike) abs.ComponentLike {
+	var a = component.GetEntity()
+	var entity abs.Entity
+	switch operator {
+	case abs.NOT:
+		entity = complement(a)
+	case abs.MINUS, abs.SLASH, abs.STAR:
+		entity = invert(operator, a)
+	case abs.MAGNITUDE:
+		entity = magnitude(a)
+	}
+	if entity == nil {
+		var message = fmt.Sprintf("The %v operator cannot be applied to %v.",
+			operators[operator], bal.FormatEntity(a))
+		panic(message)
+	}
+	return com.Component(entity)
+}
+
+// This function returns the value in the specified composite component that is
+// selected by the specified index. A list is indexed by the ordinal of a value
+// (e.g. 1 for its first value and -1 for its last) and a catalog is indexed by
+// the key of a value.
+func GetSubcomponent(composite abs.ComponentLike, index abs.ComponentLike) abs.ComponentLike {
+	var value abs.ComponentLike
+	switch collection := composite.GetEntity().(type) {
+	case abs.ListLike:
+		var ordinal, ok = index.GetEntity().(ele.Continuous)
+		if ok && typeOf(ordinal) == "Number" {
+			value = collection.GetValue(int(ordinal.AsFloat()))
+		}
+	case abs.CatalogLike:
+		value = collection.GetValue(index.GetEntity())
+	}
+	if value == nil {
+		var message = fmt.Sprintf("The index %v does not select a value from: %v",
+			bal.FormatComponent(index), bal.FormatComponent(composite))
+		panic(message)
+	}
+	return value
+}
+
+// EVALUATOR IMPLEMENTATION
+
+// This map defines the source symbol for each operator. It is used in error
+// messages.
+var operators = map[abs.Operator]string{
+	abs.AMPERSAND: "&",
+	abs.AT:        "@",
+	abs.PLUS:      "+",
+	abs.MINUS:     "-",
+	abs.STAR:      "*",
+	abs.SLASH:     "/",
+	abs.MODULO:    "//",
+	abs.CARET:     "^",
+	abs.LESS:      "<",
+	abs.EQUAL:     "=",
+	abs.UNEQUAL:   "≠",
+	abs.MORE:      ">",
+	abs.IS:        "IS",
+	abs.MATCHES:   "MATCHES",
+	abs.NOT:       "NOT",
+	abs.AND:       "AND",
+	abs.SANS:      "SANS",
+	abs.OR:        "OR",
+	abs.XOR:       "XOR",
+	abs.MAGNITUDE: "| |",
+}
+
+// This type defines the structure and methods associated with an expression
+// evaluator.
+type evaluator struct {
+	environment abs.EnvironmentLike
+}
+
+// This method returns the result of applying the specified binary operation.
+func (v *evaluator) evaluateBinaryOperation(operation abs.BinaryOperationLike) abs.ComponentLike {
+	var first = v.evaluateExpression(operation.GetFirst())
+	var operator = operation.GetOperator()
+	var second = v.evaluateExpression(operation.GetSecond())
+	return Operate(first, operator, second)
+}
+
+// This method returns the values of the specified argument expressions.
+func (v *evaluator) evaluateArguments(arguments abs.Sequential[abs.Expression]) abs.ListLike {
+	var values = col.List()
+	for _, argument := range arguments.AsArray() {
+		values.AddValue(v.evaluateExpression(argument))
+	}
+	return values
+}
+
+// This method returns the value of the specified expression.
+func (v *evaluator) evaluateExpression(expression abs.Expression) abs.ComponentLike {
+	switch actual := expression.(type) {
+	case abs.ValueLike:
+		return actual.GetComponent()
+	case abs.VariableLike:
+		return v.environment.GetVariable(actual.GetIdentifier())
+	case abs.IntrinsicLike:
+		return v.evaluateIntrinsic(actual)
+	case abs.InvocationLike:
+		return v.evaluateInvocation(actual)
+	case abs.SubcomponentLike:
+		return v.evaluateSubcomponent(actual)
+	case abs.UnaryOperationLike:
+		return v.evaluateUnaryOperation(actual)
+	case abs.BinaryOperationLike:
+		return v.evaluateBinaryOperation(actual)
+	default:
+		var message = fmt.Sprintf("An invalid expression type was found: %T", actual)
+		panic(message)
+	}
+}
+
+// This method returns the result of calling the specified intrinsic function.
+func (v *evaluator) evaluateIntrinsic(intrinsic abs.IntrinsicLike) abs.ComponentLike {
+	var arguments = v.evaluateArguments(intrinsic.GetArguments())
+	return CallIntrinsic(intrinsic.GetFunction(), arguments.AsArray())
+}
+
+// This method returns the result of invoking the specified method on its
+// target component. The invocation is delegated to the environment.
+func (v *evaluator) evaluateInvocation(invocation abs.InvocationLike) abs.ComponentLike {
+	var target = v.evaluateExpression(invocation.GetTarget())
+	var arguments = v.evaluateArguments(invocation.GetArguments())
+	var method = invocation.GetMethod()
+	return v.environment.Invoke(target, method, arguments, invocation.IsSynchronous())
+}
+
+// This method returns the subcomponent of a composite component that is
+// selected by the specified indices. Each index selects a value from the
+// component selected by the previous index.
+func (v *evaluator) evaluateSubcomponent(subcomponent abs.SubcomponentLike) abs.ComponentLike {
+	var component = v.evaluateExpression(subcomponent.GetComposite())
+	for _, expression := range subcomponent.GetIndices().AsArray() {
+		var index = v.evaluateExpression(expression)
+		component = GetSubcomponent(component, index)
+	}
+	return component
+}
+
+// This method returns the result of applying the specified unary operation.
+func (v *evaluator) evaluateUnaryOperation(operation abs.UnaryOperationLike) abs.ComponentLike {
+	var component = v.evaluateExpression(operation.GetExpression())
+	switch operator := operation.GetOperator(); operator {
+	case abs.PRECEDENCE:
+		return component
+	case abs.AT:
+		return v.environment.Dereference(component)
+	default:
+		return Apply(operator, component)
+	}
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the result of applying an arithmetic operator to the
+// specified entities, or nil if the operator is not supported for them.
+func calculate(a abs.Entity, operator abs.Operator, b abs.Entity) abs.Entity {
+	var number = ele.Number()
+	switch typeOf(a) + "," + typeOf(b) {
+	case "Number,Number":
+		var first, second = a.(ele.NumberLike), b.(ele.NumberLike)
+		switch operator {
+		case abs.PLUS:
+			return number.Sum(first, second)
+		case abs.MINUS:
+			return number.Difference(first, second)
+		case abs.STAR:
+			return number.Product(first, second)
+		case abs.SLASH:
+			return number.Quotient(first, second)
+		case abs.MODULO:
+			return number.Remainder(first, second)
+		}
+	case "Angle,Angle":
+		var first, second = a.(ele.AngleLike), b.(ele.AngleLike)
+		switch operator {
+		case abs.PLUS:
+			return ele.Angle().Sum(first, second)
+		case abs.MINUS:
+			return ele.Angle().Difference(first, second)
+		}
+	case "Angle,Number":
+		var angle, factor = a.(ele.AngleLike), b.(ele.NumberLike).AsFloat()
+		switch operator {
+		case abs.STAR:
+			return ele.Angle().Scaled(angle, factor)
+		case abs.SLASH:
+			return ele.Angle().Scaled(angle, 1.0/factor)
+		}
+	case "Number,Angle":
+		if operator == abs.STAR {
+			return ele.Angle().Scaled(b.(ele.AngleLike), a.(ele.NumberLike).AsFloat())
+		}
+	case "Duration,Duration":
+		var first, second = a.(ele.DurationLike).AsInteger(), b.(ele.DurationLike).AsInteger()
+		switch operator {
+		case abs.PLUS:
+			return ele.Duration().FromMilliseconds(first + second)
+		case abs.MINUS:
+			return ele.Duration().FromMilliseconds(first - second)
+		}
+	case "Duration,Number":
+		var duration, factor = a.(ele.DurationLike).AsMilliseconds(), b.(ele.NumberLike).AsFloat()
+		switch operator {
+		case abs.STAR:
+			return ele.Duration().FromMilliseconds(int(mat.Round(duration * factor)))
+		case abs.SLASH:
+			return ele.Duration().FromMilliseconds(int(mat.Round(duration / factor)))
+		}
+	case "Moment,Duration":
+		var moment, duration = a.(ele.MomentLike), b.(ele.DurationLike)
+		switch operator {
+		case abs.PLUS:
+			return ele.Moment().Later(moment, duration)
+		case abs.MINUS:
+			return ele.Moment().Earlier(moment, duration)
+		}
+	case "Moment,Moment":
+		if operator == abs.MINUS {
+			// The duration from the second moment to the first moment.
+			return ele.Moment().Duration(b.(ele.MomentLike), a.(ele.MomentLike))
+		}
+	}
+	return nil
+}
+
+// This function returns the concatenation of the specified strings, or nil if
+// they are not strings of the same type.
+func chain(a abs.Entity, b abs.Entity) abs.Entity {
+	switch typeOf(a) + "," + typeOf(b) {
+	case "Binary,Binary":
+		return str.Binary.Concatenate(a.(abs.BinaryLike), b.(abs.BinaryLike))
+	case "Name,Name":
+		return str.Names.Concatenate(a.(abs.NameLike), b.(abs.NameLike))
+	case "Narrative,Narrative":
+		return str.Narratives.Concatenate(a.(abs.NarrativeLike), b.(abs.NarrativeLike))
+	case "Quote,Quote":
+		var runes = append(a.(abs.QuoteLike).AsArray(), b.(abs.QuoteLike).AsArray()...)
+		return str.QuoteFromArray(runes)
+	case "Version,Version":
+		var ordinals = append(a.(abs.VersionLike).AsArray(), b.(abs.VersionLike).AsArray()...)
+		return str.VersionFromArray(ordinals)
+	}
+	return nil
+}
+
+// This function returns the result of applying a logical operator to the
+// specified entities, or nil if the operator is not supported for them.
+func combine(a abs.Entity, operator abs.Operator, b abs.Entity) abs.Entity {
+	switch typeOf(a) + "," + typeOf(b) {
+	case "Boolean,Boolean":
+		var first, second = a.(ele.BooleanLike), b.(ele.BooleanLike)
+		switch operator {
+		case abs.AND:
+			return ele.Boolean().And(first, second)
+		case abs.SANS:
+			return ele.Boolean().Sans(first, second)
+		case abs.OR:
+			return ele.Boolean().Or(first, second)
+		case abs.XOR:
+			return ele.Boolean().Xor(first, second)
+		}
+	case "Probability,Probability":
+		var first, second = a.(ele.ProbabilityLike), b.(ele.ProbabilityLike)
+		switch operator {
+		case abs.AND:
+			return ele.Probability().And(first, second)
+		case abs.SANS:
+			return ele.Probability().Sans(first, second)
+		case abs.OR:
+			return ele.Probability().Or(first, second)
+		case abs.XOR:
+			return ele.Probability().Xor(first, second)
+		}
+	case "Binary,Binary":
+		var first, second = a.(abs.BinaryLike), b.(abs.BinaryLike)
+		switch operator {
+		case abs.AND:
+			return str.Binary.And(first, second)
+		case abs.SANS:
+			return str.Binary.Sans(first, second)
+		case abs.OR:
+			return str.Binary.Or(first, second)
+		case abs.XOR:
+			return str.Binary.Xor(first, second)
+		}
+	}
+	return nil
+}
+
+// This function returns the result of applying a comparison operator to the
+// specified components, or nil if the operator is not supported for them.
+func compare(first abs.ComponentLike, operator abs.Operator, second abs.ComponentLike) abs.Entity {
+	var result bool
+	switch operator {
+	case abs.EQUAL:
+		result = isEqual(first, second)
+	case abs.UNEQUAL:
+		result = !isEqual(first, second)
+	case abs.IS:
+		result = isSame(first, second)
+	case abs.MATCHES:
+		var ok bool
+		result, ok = matches(first, second)
+		if !ok {
+			return nil
+		}
+	case abs.LESS, abs.MORE:
+		var ranking, ok = rank(first.GetEntity(), second.GetEntity())
+		if !ok {
+			return nil
+		}
+		result = (operator == abs.LESS && ranking < 0) || (operator == abs.MORE && ranking > 0)
+	}
+	return ele.Boolean().FromBoolean(result)
+}
+
+// This function returns the logical complement of the specified entity, or nil
+// if the entity has no complement.
+func complement(a abs.Entity) abs.Entity {
+	switch typeOf(a) {
+	case "Boolean":
+		return ele.Boolean().Not(a.(ele.BooleanLike))
+	case "Probability":
+		return ele.Probability().Not(a.(ele.ProbabilityLike))
+	case "Binary":
+		return str.Binary.Not(a.(abs.BinaryLike))
+	}
+	return nil
+}
+
+// This function returns the specified base raised to the specified exponent, or
+// nil if they are not both numbers.
+func exponentiate(base abs.Entity, exponent abs.Entity) abs.Entity {
+	if typeOf(base) != "Number" || typeOf(exponent) != "Number" {
+		return nil
+	}
+	return ele.Number().Power(base.(ele.NumberLike), exponent.(ele.NumberLike))
+}
+
+// This function returns the result of applying an inversion operator to the
+// specified entity: the additive inverse for "-", the multiplicative inverse
+// for "/" and the complex conjugate for "*". It returns nil if the inversion is
+// not supported for the entity.
+func invert(operator abs.Operator, a abs.Entity) abs.Entity {
+	switch typeOf(a) + "," + operators[operator] {
+	case "Number,-":
+		return ele.Number().Inverse(a.(ele.NumberLike))
+	case "Number,/":
+		return ele.Number().Reciprocal(a.(ele.NumberLike))
+	case "Number,*":
+		return ele.Number().Conjugate(a.(ele.NumberLike))
+	case "Angle,-":
+		return ele.Angle().Inverse(a.(ele.AngleLike))
+	case "Angle,*":
+		return ele.Angle().Conjugate(a.(ele.AngleLike))
+	case "Duration,-":
+		return ele.Duration().FromMilliseconds(-a.(ele.DurationLike).AsInteger())
+	case "Percentage,-":
+		return ele.Percentage().FromFloat(-100.0 * a.(ele.PercentageLike).AsFloat())
+	}
+	return nil
+}
+
+// This function returns the magnitude of the specified entity, or nil if the
+// entity has no magnitude.
+func magnitude(a abs.Entity) abs.Entity {
+	switch typeOf(a) {
+	case "Number":
+		var magnitude = a.(ele.NumberLike).GetMagnitude()
+		return ele.Number().FromComplex(complex(magnitude, 0))
+	case "Duration":
+		var milliseconds = a.(ele.DurationLike).AsInteger()
+		if milliseconds < 0 {
+			milliseconds = -milliseconds
+		}
+		return ele.Duration().FromMilliseconds(milliseconds)
+	case "Percentage":
+		return ele.Percentage().FromFloat(100.0 * mat.Abs(a.(ele.PercentageLike).AsFloat()))
+	}
+	return nil
+}
+
+// This function determines whether or not the two specified components are
+// equal. Two components are equal if their canonical BDN strings are the same.
+func isEqual(first abs.ComponentLike, second abs.ComponentLike) bool {
+	return bal.FormatComponent(first) == bal.FormatComponent(second)
+}
+
+// This function determines whether or not the two specified components are the
+// same component. Two elements with the same value are also considered to be
+// the same.
+func isSame(first abs.ComponentLike, second abs.ComponentLike) bool {
+	if first == second {
+		return true
+	}
+	var a = first.GetEntity()
+	var b = second.GetEntity()
+	var type_ = ref.TypeOf(a)
+	return type_ == ref.TypeOf(b) && type_.Comparable() && a == b
+}
+
+// This function determines whether or not the first component matches the
+// pattern in the second component. The contents of a quote are matched
+// directly while any other entity is matched using its canonical BDN string.
+// It returns false for its second result if the second component is not a
+// pattern.
+func matches(first abs.ComponentLike, second abs.ComponentLike) (bool, bool) {
+	var pattern, ok = second.GetEntity().(ele.PatternLike)
+	if !ok || typeOf(pattern) != "Pattern" {
+		return false, false
+	}
+	var entity = first.GetEntity()
+	var text string
+	if typeOf(entity) == "Quote" {
+		text = string(entity.(abs.QuoteLike).AsArray())
+	} else {
+		text = bal.FormatEntity(entity)
+	}
+	return pattern.MatchesText(text), true
+}
+
+// This function compares the specified entities and returns -1, 0 or 1 if the
+// first is less than, equal to or more than the second. It returns false for
+// its second result if the entities are not of the same ordered type.
+func rank(a abs.Entity, b abs.Entity) (int, bool) {
+	var type_ = typeOf(a)
+	if type_ != typeOf(b) {
+		return 0, false
+	}
+	switch type_ {
+	case "Number":
+		var first, second = a.(ele.NumberLike), b.(ele.NumberLike)
+		if first.GetImaginary() != 0 || second.GetImaginary() != 0 {
+			// Complex numbers are not ordered.
+			return 0, false
+		}
+		return rankFloats(first.GetReal(), second.GetReal()), true
+	case "Angle", "Float", "Percentage", "Probability":
+		var first, second = a.(ele.Continuous), b.(ele.Continuous)
+		return rankFloats(first.AsFloat(), second.AsFloat()), true
+	case "Character", "Duration", "Integer", "Moment":
+		var first, second = a.(ele.Discrete), b.(ele.Discrete)
+		return rankFloats(float64(first.AsInteger()), float64(second.AsInteger())), true
+	case "Quote":
+		var first = string(a.(abs.QuoteLike).AsArray())
+		var second = string(b.(abs.QuoteLike).AsArray())
+		return sts.Compare(first, second), true
+	}
+	return 0, false
+}
+
+// This function compares the specified floating point numbers and returns -1,
+// 0 or 1 if the first is less than, equal to or more than the second.
+func rankFloats(first float64, second float64) int {
+	switch {
+	case first < second:
+		return -1
+	case first > second:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// This function returns a string describing the type of the specified entity.
+// Elements, strings and the list and catalog collections are described by their
+// type names (e.g. "Number", "Quote" or "List") and any other entity by its Go
+// type. The order of the cases matters since a binary string also supports the
+// tag interface and a quote also supports the symbol interface.
+func typeOf(entity abs.Entity) string {
+	var type_ = ele.GetType(entity)
+	if len(type_) > 0 {
+		return type_
+	}
+	switch entity.(type) {
+	case abs.BinaryLike:
+		return "Binary"
+	case abs.TagLike:
+		return "Tag"
+	case abs.NameLike:
+		return "Name"
+	case abs.NarrativeLike:
+		return "Narrative"
+	case abs.QuoteLike:
+		return "Quote"
+	case abs.SymbolLike:
+		return "Symbol"
+	case abs.VersionLike:
+		return "Version"
+	case abs.CatalogLike:
+		return "Catalog"
+	case abs.ListLike:
+		return "List"
+	default:
+		return fmt.Sprintf("%T", entity)
+	}
+}
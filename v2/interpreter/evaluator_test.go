@@ -0,0 +1,91 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package interpreter_test
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	int_ "github.com/bali-nebula/go-component-framework/v2/interpreter"
+	ass "github.com/stretchr/testify/assert"
+	tes "testing"
+)
+
+// This function returns the expression in a procedure of the form:
+//
+//	{return <expression>}
+func parseExpression(source string) abs.Expression {
+	var procedure = bal.ParseComponent("{return " + source + "}").ExtractProcedure()
+	var statement = procedure.AsArray()[0]
+	return statement.GetMainClause().(abs.ReturnClauseLike).GetResult()
+}
+
+func TestEvaluateExpressions(t *tes.T) {
+	var environment = int_.Environment()
+	environment.SetVariable("x", bal.ParseComponent("5"))
+	environment.SetVariable("list", bal.ParseComponent("[1, 2, 3]"))
+	environment.SetVariable("catalog", bal.ParseComponent(`[$first: "alpha", $second: "beta"]`))
+
+	var expressions = map[string]string{
+		`x`:                         `5`,
+		`x + 2`:                     `7`,
+		`x - 7`:                     `-2`,
+		`x * 3`:                     `15`,
+		`x // 4`:                    `1`,
+		`2 ^ 3`:                     `8`,
+		`-x`:                        `-5`,
+		`|-x|`:                      `5`,
+		`(x + 1) * 2`:               `12`,
+		`(~π / 2) + (~π / 2)`:       `~π`,
+		`~PT1M + ~PT30S`:            `~PT1M30S`,
+		`<2024-03-31> + ~P2D`:       `<2024-04-02>`,
+		`x < 7`:                     `true`,
+		`x > 7`:                     `false`,
+		`x = 5`:                     `true`,
+		`x ≠ 5`:                     `false`,
+		`true XOR false`:            `true`,
+		`NOT true`:                  `false`,
+		`true AND NOT false`:        `true`,
+		`"Hello " & "World"`:        `"Hello World"`,
+		`v1.2 & v3`:                 `v1.2.3`,
+		`"abc" MATCHES "a.c"?`:      `true`,
+		`list[2]`:                   `2`,
+		`list[-1]`:                  `3`,
+		`catalog[$second]`:          `"beta"`,
+		`catalog[$first] = "alpha"`: `true`,
+		`list IS list`:              `true`,
+		`list IS [1, 2, 3]`:         `false`,
+	}
+	for source, expected := range expressions {
+		var expression = parseExpression(source)
+		var result = int_.Evaluate(expression, environment)
+		ass.Equal(t, expected, bal.FormatComponent(result), source)
+	}
+}
+
+func TestEvaluateErrors(t *tes.T) {
+	var environment = int_.Environment()
+	var expressions = []string{
+		`y + 1`,
+		`"text" + 1`,
+		`~π < 5`,
+		`NOT 5`,
+		`@x`,
+		`x.method()`,
+		`[1, 2][5]`,
+	}
+	environment.SetVariable("x", bal.ParseComponent("5"))
+	for _, source := range expressions {
+		var expression = parseExpression(source)
+		ass.Panics(t, func() {
+			int_.Evaluate(expression, environment)
+		}, source)
+	}
+}
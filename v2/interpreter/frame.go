@@ -0,0 +1,89 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package interpreter
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+)
+
+// FRAME IMPLEMENTATION
+
+// This constructor creates a new variable frame that is nested inside the
+// specified parent environment. The variables defined in the parent remain
+// visible from the new frame and assigning a value to one of them updates it
+// in the parent. Any other variable that is assigned a value is defined in the
+// new frame only and is discarded along with it. Dereferencing and method
+// invocation are delegated to the parent.
+func Frame(parent abs.EnvironmentLike) abs.EnvironmentLike {
+	if parent == nil {
+		panic("A frame requires a parent environment.")
+	}
+	var v = &frame{parent: parent, variables: map[string]abs.ComponentLike{}}
+	return v
+}
+
+// This type defines the structure and methods associated with a variable frame.
+type frame struct {
+	parent    abs.EnvironmentLike
+	variables map[string]abs.ComponentLike
+}
+
+// DELEGATING INTERFACE
+
+// This method returns the component that the specified reference refers to.
+func (v *frame) Dereference(reference abs.ComponentLike) abs.ComponentLike {
+	return v.parent.Dereference(reference)
+}
+
+// This method invokes the specified method on the specified target component
+// and returns the result.
+func (v *frame) Invoke(
+	target abs.ComponentLike,
+	method string,
+	arguments abs.Sequential[abs.ComponentLike],
+	isSynchronous bool,
+) abs.ComponentLike {
+	return v.parent.Invoke(target, method, arguments, isSynchronous)
+}
+
+// SCOPED INTERFACE
+
+// This method determines whether or not the specified variable is defined in
+// this frame or any of its parents.
+func (v *frame) IsDefined(variable string) bool {
+	var _, ok = v.variables[variable]
+	return ok || v.parent.IsDefined(variable)
+}
+
+// This method returns the value of the specified variable. It panics if the
+// variable is not defined in this frame or any of its parents.
+func (v *frame) GetVariable(variable string) abs.ComponentLike {
+	var value, ok = v.variables[variable]
+	if !ok {
+		value = v.parent.GetVariable(variable)
+	}
+	return value
+}
+
+// This method sets the value of the specified variable. If the variable is
+// defined in a parent its value is updated there, otherwise the variable is
+// defined in this frame.
+func (v *frame) SetVariable(variable string, value abs.ComponentLike) {
+	if value == nil {
+		panic("The value of a variable cannot be nil.")
+	}
+	var _, ok = v.variables[variable]
+	if !ok && v.parent.IsDefined(variable) {
+		v.parent.SetVariable(variable, value)
+		return
+	}
+	v.variables[variable] = value
+}
@@ -0,0 +1,400 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package interpreter
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	ele "github.com/bali-nebula/go-component-framework/v2/elements"
+	pro "github.com/bali-nebula/go-component-framework/v2/procedures"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	sts "strings"
+)
+
+// INTERPRETER INTERFACE
+
+// This function executes the statements in the specified procedure using the
+// specified environment and returns the result of its return clause, or nil if
+// the procedure completes without returning a result. Each block of statements
+// (e.g. the body of a while loop) is executed in its own variable frame that is
+// nested inside the frame of its enclosing procedure. This function panics with
+// an *ExecutionError if the procedure fails with an exception that is not
+// handled by any "on" clause.
+func Execute(procedure abs.ProcedureLike, environment abs.EnvironmentLike) abs.ComponentLike {
+	var v = &interpreter{}
+	defer v.reportFailure()
+	var signal_, result = v.executeProcedure(procedure, environment)
+	if signal_ == returnResult {
+		return result
+	}
+	return nil
+}
+
+// This function executes a procedure like Execute but returns any unhandled
+// failure as an *ExecutionError rather than panicking.
+func TryExecute(procedure abs.ProcedureLike, environment abs.EnvironmentLike) (result abs.ComponentLike, err error) {
+	defer catchExecutionError(&err)
+	result = Execute(procedure, environment)
+	return result, err
+}
+
+// This function assigns the specified value to the specified variable in the
+// specified environment using the specified assignment operator (e.g. ":=" or
+// "+="). A compound assignment operator requires the variable to be defined.
+func AssignVariable(environment abs.Scoped, variable string, operator abs.Operator, value abs.ComponentLike) {
+	if environment.IsDefined(variable) {
+		value = assign(environment.GetVariable(variable), operator, value)
+	} else if operator != abs.ASSIGN && operator != abs.DEFAULT {
+		var message = fmt.Sprintf("The variable %v is not defined.", variable)
+		panic(message)
+	}
+	if value != nil {
+		environment.SetVariable(variable, value)
+	}
+}
+
+// This function assigns the specified value to the subcomponent of the
+// specified composite component that is selected by the specified index using
+// the specified assignment operator. A compound assignment operator requires
+// the subcomponent to exist.
+func AssignSubcomponent(composite abs.ComponentLike, index abs.ComponentLike, operator abs.Operator, value abs.ComponentLike) {
+	var current = lookupSubcomponent(composite, index)
+	if current != nil {
+		value = assign(current, operator, value)
+	} else if operator != abs.ASSIGN && operator != abs.DEFAULT {
+		// This panics with an explanation of the missing value.
+		GetSubcomponent(composite, index)
+	}
+	if value != nil {
+		setSubcomponent(composite, index, value)
+	}
+}
+
+// This function determines whether or not the specified exception is handled
+// by a block whose expression has the specified value. The exception is handled
+// if it matches the value, or if the exception is a catalog whose $type
+// attribute matches the value.
+func IsHandledBy(exception abs.ComponentLike, template abs.ComponentLike) bool {
+	if IsMatch(exception, template) {
+		return true
+	}
+	var catalog, ok = exception.GetEntity().(abs.CatalogLike)
+	if ok {
+		var type_ = catalog.GetValue(str.SymbolFromString("type"))
+		return type_ != nil && IsMatch(type_, template)
+	}
+	return false
+}
+
+// This function determines whether or not the specified value matches the
+// specified template. If the template is a pattern the value must match the
+// pattern, otherwise the value must equal the template.
+func IsMatch(value abs.ComponentLike, template abs.ComponentLike) bool {
+	var result, ok = matches(value, template)
+	if ok {
+		return result
+	}
+	return isEqual(value, template)
+}
+
+// INTERPRETER IMPLEMENTATION
+
+// This type is used to signal how the statements in a procedure should proceed
+// after a statement has been executed.
+type signal int
+
+const (
+	proceed signal = iota
+	continueLoop
+	breakLoop
+	returnResult
+)
+
+// This type defines the structure and methods associated with a procedure
+// interpreter.
+type interpreter struct {
+	loops int // The number of loops enclosing the current statement.
+}
+
+// This method recovers from a panic caused by an unhandled failure and panics
+// with the corresponding execution error instead. Any other panic is passed
+// along.
+func (v *interpreter) reportFailure() {
+	var e = recover()
+	if e == nil {
+		return
+	}
+	var failure_, ok = e.(*failure)
+	if !ok {
+		panic(e)
+	}
+	panic(failure_.asError())
+}
+
+// This method executes the statements in the specified block using a new
+// variable frame.
+func (v *interpreter) executeBlock(block abs.BlockLike, environment abs.EnvironmentLike) (signal, abs.ComponentLike) {
+	return v.executeProcedure(block.GetProcedure(), Frame(environment))
+}
+
+// This method executes the specified break clause.
+func (v *interpreter) executeBreakClause(clause abs.BreakClauseLike) signal {
+	if v.loops == 0 {
+		panic(structuralFailure("A break loop clause must be inside a loop."))
+	}
+	return breakLoop
+}
+
+// This method executes the specified continue clause.
+func (v *interpreter) executeContinueClause(clause abs.ContinueClauseLike) signal {
+	if v.loops == 0 {
+		panic(structuralFailure("A continue loop clause must be inside a loop."))
+	}
+	return continueLoop
+}
+
+// This method executes the specified if clause.
+func (v *interpreter) executeIfClause(clause abs.IfClauseLike, environment abs.EnvironmentLike) (signal, abs.ComponentLike) {
+	var block = clause.GetBlock()
+	if v.evaluateCondition(block.GetExpression(), environment) {
+		return v.executeBlock(block, environment)
+	}
+	return proceed, nil
+}
+
+// This method executes the specified let clause.
+func (v *interpreter) executeLetClause(clause abs.LetClauseLike, environment abs.EnvironmentLike) {
+	var value = Evaluate(clause.GetExpression(), environment)
+	if !clause.HasRecipient() {
+		// The expression is evaluated for its side effects only.
+		return
+	}
+	var recipient, operator = clause.GetRecipient()
+	switch actual := recipient.(type) {
+	case abs.SymbolLike:
+		AssignVariable(environment, nameOf(actual), operator, value)
+	case abs.AttributeLike:
+		var composite = environment.GetVariable(actual.GetVariable())
+		var indices = actual.GetIndices().AsArray()
+		var last = len(indices) - 1
+		for _, expression := range indices[:last] {
+			composite = GetSubcomponent(composite, Evaluate(expression, environment))
+		}
+		var index = Evaluate(indices[last], environment)
+		AssignSubcomponent(composite, index, operator, value)
+	}
+}
+
+// This method executes the statements in the specified procedure.
+func (v *interpreter) executeProcedure(procedure abs.ProcedureLike, environment abs.EnvironmentLike) (signal, abs.ComponentLike) {
+	for _, statement := range procedure.AsArray() {
+		var signal_, result = v.executeStatement(statement, environment)
+		if signal_ != proceed {
+			return signal_, result
+		}
+	}
+	return proceed, nil
+}
+
+// This method executes the specified return clause.
+func (v *interpreter) executeReturnClause(clause abs.ReturnClauseLike, environment abs.EnvironmentLike) (signal, abs.ComponentLike) {
+	return returnResult, Evaluate(clause.GetResult(), environment)
+}
+
+// This method executes the first block in the specified select clause whose
+// expression matches the value of the target expression.
+func (v *interpreter) executeSelectClause(clause abs.SelectClauseLike, environment abs.EnvironmentLike) (signal, abs.ComponentLike) {
+	var target = Evaluate(clause.GetTarget(), environment)
+	for _, block := range clause.GetBlocks().AsArray() {
+		var template = Evaluate(block.GetExpression(), environment)
+		if IsMatch(target, template) {
+			return v.executeBlock(block, environment)
+		}
+	}
+	return proceed, nil
+}
+
+// This method executes the specified statement. If the main clause of the
+// statement fails, the failure is handled by the first block in the "on" clause
+// of the statement (if any) whose expression matches the exception. The
+// exception is assigned to the failure variable of the "on" clause before the
+// block is executed. A failure that is not handled, or is structural, is passed
+// along to the enclosing statement.
+func (v *interpreter) executeStatement(statement abs.StatementLike, environment abs.EnvironmentLike) (signal_ signal, result abs.ComponentLike) {
+	defer func() {
+		var e = recover()
+		if e == nil {
+			return
+		}
+		var failure_ = failureFrom(e, statement.GetSpan())
+		var onClause = statement.GetOnClause()
+		if onClause != nil && !failure_.structural {
+			var variable = nameOf(onClause.GetFailure())
+			for _, block := range onClause.GetBlocks().AsArray() {
+				var template = Evaluate(block.GetExpression(), environment)
+				if IsHandledBy(failure_.exception, template) {
+					var frame = Frame(environment)
+					frame.SetVariable(variable, failure_.exception)
+					signal_, result = v.executeProcedure(block.GetProcedure(), frame)
+					return
+				}
+			}
+		}
+		panic(failure_)
+	}()
+	var clause = statement.GetMainClause()
+	switch pro.GetType(clause) {
+	case "BreakClause":
+		return v.executeBreakClause(clause.(abs.BreakClauseLike)), nil
+	case "ContinueClause":
+		return v.executeContinueClause(clause.(abs.ContinueClauseLike)), nil
+	case "IfClause":
+		return v.executeIfClause(clause.(abs.IfClauseLike), environment)
+	case "LetClause":
+		v.executeLetClause(clause.(abs.LetClauseLike), environment)
+		return proceed, nil
+	case "ReturnClause":
+		return v.executeReturnClause(clause.(abs.ReturnClauseLike), environment)
+	case "SelectClause":
+		return v.executeSelectClause(clause.(abs.SelectClauseLike), environment)
+	case "ThrowClause":
+		v.executeThrowClause(clause.(abs.ThrowClauseLike), environment)
+		return proceed, nil
+	case "WhileClause":
+		return v.executeWhileClause(clause.(abs.WhileClauseLike), environment)
+	case "WithClause":
+		return v.executeWithClause(clause.(abs.WithClauseLike), environment)
+	default:
+		var message = fmt.Sprintf("The %v is not supported by this interpreter.", pro.GetType(clause))
+		panic(message)
+	}
+}
+
+// This method executes the specified throw clause.
+func (v *interpreter) executeThrowClause(clause abs.ThrowClauseLike, environment abs.EnvironmentLike) {
+	var exception = Evaluate(clause.GetException(), environment)
+	panic(exceptionFailure(exception))
+}
+
+// This method executes the specified while clause.
+func (v *interpreter) executeWhileClause(clause abs.WhileClauseLike, environment abs.EnvironmentLike) (signal, abs.ComponentLike) {
+	v.loops++
+	defer func() { v.loops-- }()
+	var block = clause.GetBlock()
+	for v.evaluateCondition(block.GetExpression(), environment) {
+		var signal_, result = v.executeBlock(block, environment)
+		switch signal_ {
+		case breakLoop:
+			return proceed, nil
+		case returnResult:
+			return signal_, result
+		}
+	}
+	return proceed, nil
+}
+
+// This method executes the specified with clause.
+func (v *interpreter) executeWithClause(clause abs.WithClauseLike, environment abs.EnvironmentLike) (signal, abs.ComponentLike) {
+	v.loops++
+	defer func() { v.loops-- }()
+	var variable = nameOf(clause.GetItem())
+	var block = clause.GetBlock()
+	var sequence = Evaluate(block.GetExpression(), environment)
+	var items, ok = sequence.GetEntity().(abs.Sequential[abs.ComponentLike])
+	if !ok {
+		var message = fmt.Sprintf("A with clause requires a sequence of items: %v", bal.FormatComponent(sequence))
+		panic(message)
+	}
+	for _, item := range items.AsArray() {
+		var frame = Frame(environment)
+		frame.SetVariable(variable, item)
+		var signal_, result = v.executeProcedure(block.GetProcedure(), frame)
+		switch signal_ {
+		case breakLoop:
+			return proceed, nil
+		case returnResult:
+			return signal_, result
+		}
+	}
+	return proceed, nil
+}
+
+// This method returns the value of the specified condition expression. It
+// panics if the value is not a boolean.
+func (v *interpreter) evaluateCondition(expression abs.Expression, environment abs.EnvironmentLike) bool {
+	var condition = Evaluate(expression, environment)
+	var boolean, ok = condition.GetEntity().(ele.BooleanLike)
+	if !ok || typeOf(boolean) != "Boolean" {
+		var message = fmt.Sprintf("A condition must be a boolean: %v", bal.FormatComponent(condition))
+		panic(message)
+	}
+	return boolean.AsBoolean()
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the value that results from applying the specified
+// assignment operator to the current value of a recipient and the specified
+// value, or nil if the current value should remain unchanged.
+func assign(current abs.ComponentLike, operator abs.Operator, value abs.ComponentLike) abs.ComponentLike {
+	switch operator {
+	case abs.ASSIGN:
+		return value
+	case abs.DEFAULT:
+		return nil
+	case abs.SUM:
+		return Operate(current, abs.PLUS, value)
+	case abs.DIFFERENCE:
+		return Operate(current, abs.MINUS, value)
+	case abs.PRODUCT:
+		return Operate(current, abs.STAR, value)
+	case abs.QUOTIENT:
+		return Operate(current, abs.SLASH, value)
+	default:
+		var message = fmt.Sprintf("An invalid assignment operator was found: %v", operator)
+		panic(message)
+	}
+}
+
+// This function returns the value in the specified composite component that is
+// selected by the specified index, or nil if there is no such value.
+func lookupSubcomponent(composite abs.ComponentLike, index abs.ComponentLike) abs.ComponentLike {
+	var catalog, ok = composite.GetEntity().(abs.CatalogLike)
+	if ok {
+		return catalog.GetValue(index.GetEntity())
+	}
+	return GetSubcomponent(composite, index)
+}
+
+// This function returns the name of the variable for the specified symbol.
+func nameOf(symbol abs.SymbolLike) string {
+	return sts.TrimPrefix(string(symbol.AsArray()), "$")
+}
+
+// This function sets the value in the specified composite component that is
+// selected by the specified index to the specified value.
+func setSubcomponent(composite abs.ComponentLike, index abs.ComponentLike, value abs.ComponentLike) {
+	switch collection := composite.GetEntity().(type) {
+	case abs.ListLike:
+		var ordinal, ok = index.GetEntity().(ele.Continuous)
+		if ok && typeOf(ordinal) == "Number" {
+			collection.SetValue(int(ordinal.AsFloat()), value)
+			return
+		}
+	case abs.CatalogLike:
+		collection.SetValue(index.GetEntity(), value)
+		return
+	}
+	var message = fmt.Sprintf("The index %v does not select a value from: %v",
+		bal.FormatComponent(index), bal.FormatComponent(composite))
+	panic(message)
+}
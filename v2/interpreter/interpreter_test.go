@@ -0,0 +1,163 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package interpreter_test
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	int_ "github.com/bali-nebula/go-component-framework/v2/interpreter"
+	ass "github.com/stretchr/testify/assert"
+	tes "testing"
+)
+
+func TestExecuteAssignments(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    let $count := 10
+    let $count += 5
+    let $count -= 3
+    let $count *= 2
+    let $count /= 4
+    let $count ?= 100
+    let $default ?= 100
+    let $list := [1, 2, 3]
+    let list[2] += 5
+    let $catalog := [$first: 1]
+    let catalog[$second] := 2
+    return count
+}`).ExtractProcedure()
+	var environment = int_.Environment()
+	var result = int_.Execute(procedure, environment)
+	ass.Equal(t, "6", bal.FormatComponent(result))
+	ass.Equal(t, "100", bal.FormatComponent(environment.GetVariable("default")))
+	ass.Equal(t, "[1, 7, 3]", bal.FormatCompactComponent(environment.GetVariable("list")))
+	ass.Equal(t, "[$first: 1, $second: 2]", bal.FormatCompactComponent(environment.GetVariable("catalog")))
+}
+
+func TestExecuteControlFlow(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    let $sum := 0
+    let $index := 0
+    while index < 10 do {
+        let $index += 1
+        if index = 3 do {
+            continue loop
+        }
+        if index > 5 do {
+            break loop
+        }
+        let $sum += index
+    }
+    with each $item in [10, 20, 30] do {
+        let $sum += item
+        let $local := item
+    }
+    select sum matching 0 do {
+        return "zero"
+    } matching "[0-9]+"? do {
+        return sum
+    } matching any do {
+        return "unexpected"
+    }
+    return "unreachable"
+}`).ExtractProcedure()
+	var environment = int_.Environment()
+	var result, err = int_.TryExecute(procedure, environment)
+	ass.NoError(t, err)
+	ass.Equal(t, "72", bal.FormatComponent(result))
+	ass.False(t, environment.IsDefined("item"))
+	ass.False(t, environment.IsDefined("local"))
+}
+
+func TestExecuteHandlers(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    let $log := ""
+    if true do {
+        throw [
+            $type: $bad
+            $kind: "worse"
+        ]
+    } on $failure matching $worse do {
+        let $log := "worse"
+    } matching $bad do {
+        let $log := failure[$kind]
+    }
+    let $result := 1 + "one" on $problem matching any do {
+        let $log := log & " and invalid"
+    }
+    return log
+}`).ExtractProcedure()
+	var result = int_.Execute(procedure, int_.Environment())
+	ass.Equal(t, `"worse and invalid"`, bal.FormatComponent(result))
+}
+
+func TestExecuteErrors(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    let $count := 1
+    if count > 0 do {
+        throw $unexpected
+    } on $failure matching $expected do {
+        return count
+    }
+}`).ExtractProcedure()
+	var _, err = int_.TryExecute(procedure, int_.Environment())
+	var executionError, ok = err.(*int_.ExecutionError)
+	ass.True(t, ok)
+	ass.Equal(t, 4, executionError.Line)
+	ass.Equal(t, "$unexpected", bal.FormatComponent(executionError.Exception))
+
+	procedure = bal.ParseComponent(`{
+    let $count := 1
+    let $count += missing
+}`).ExtractProcedure()
+	_, err = int_.TryExecute(procedure, int_.Environment())
+	executionError, ok = err.(*int_.ExecutionError)
+	ass.True(t, ok)
+	ass.Equal(t, 3, executionError.Line)
+	ass.Equal(t, "The variable missing is not defined.", executionError.Message)
+
+	procedure = bal.ParseComponent(`{
+    break loop
+}`).ExtractProcedure()
+	_, err = int_.TryExecute(procedure, int_.Environment())
+	ass.Error(t, err)
+
+	// A structural error cannot be handled by an "on" clause.
+	procedure = bal.ParseComponent(`{
+    continue loop on $failure matching any do {
+        return "handled"
+    }
+}`).ExtractProcedure()
+	_, err = int_.TryExecute(procedure, int_.Environment())
+	executionError, ok = err.(*int_.ExecutionError)
+	ass.True(t, ok)
+	ass.Equal(t, "A continue loop clause must be inside a loop.", executionError.Message)
+
+	// A runtime error is a bug rather than a failure so it is passed along.
+	procedure = bal.ParseComponent(`{
+    let $count := 1 on $failure matching any do {
+        return "handled"
+    }
+}`).ExtractProcedure()
+	ass.Panics(t, func() {
+		int_.TryExecute(procedure, &brokenEnvironment{int_.Environment()})
+	})
+}
+
+// This type defines an environment whose variables cannot be set because of a
+// bug that causes a runtime error.
+type brokenEnvironment struct {
+	abs.EnvironmentLike
+}
+
+func (v *brokenEnvironment) SetVariable(variable string, value abs.ComponentLike) {
+	var variables map[string]abs.ComponentLike
+	variables[variable] = value
+}
@@ -0,0 +1,227 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package interpreter
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	ele "github.com/bali-nebula/go-component-framework/v2/elements"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	sts "strings"
+	syn "sync"
+)
+
+// INTRINSICS INTERFACE
+
+// This type defines the signature of a Go function that implements an intrinsic
+// function. Each argument is the entity of a component that has already been
+// checked against the corresponding parameter type of the intrinsic function.
+type Function func(arguments []abs.Entity) abs.Entity
+
+// This function registers the specified Go function as the intrinsic function
+// with the specified name, replacing any intrinsic function already registered
+// with that name. Each parameter type names the type of entity that the
+// corresponding argument must have (e.g. "Angle", "Number" or "Quote"). A type
+// of "Any" accepts any entity and alternative types may be separated by a
+// vertical bar (e.g. "Boolean|Probability").
+func RegisterIntrinsic(name string, parameters []string, function Function) {
+	if len(name) == 0 || function == nil {
+		panic("An intrinsic function requires a name and a Go function.")
+	}
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	intrinsics[name] = intrinsic{parameters, function}
+}
+
+// This function determines whether or not an intrinsic function with the
+// specified name has been registered.
+func IsIntrinsic(name string) bool {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	var _, ok = intrinsics[name]
+	return ok
+}
+
+// This function calls the intrinsic function with the specified name, passing
+// it the entities of the specified argument components, and returns the result
+// as a new component. It panics if no intrinsic function with that name has
+// been registered or if the arguments do not match its parameter types.
+func CallIntrinsic(name string, arguments []abs.ComponentLike) abs.ComponentLike {
+	registryMutex.RLock()
+	var intrinsic, ok = intrinsics[name]
+	registryMutex.RUnlock()
+	if !ok {
+		var message = fmt.Sprintf("The intrinsic function %v is not defined.", name)
+		panic(message)
+	}
+	var parameters = intrinsic.parameters
+	if len(arguments) != len(parameters) {
+		var message = fmt.Sprintf("The intrinsic function %v requires %v arguments but was passed %v.",
+			name, len(parameters), len(arguments))
+		panic(message)
+	}
+	var entities = make([]abs.Entity, len(arguments))
+	for index, argument := range arguments {
+		var entity = argument.GetEntity()
+		if !isOfType(entity, parameters[index]) {
+			var message = fmt.Sprintf("Argument %v of the intrinsic function %v must be of type %v: %v",
+				index+1, name, parameters[index], bal.FormatEntity(entity))
+			panic(message)
+		}
+		entities[index] = entity
+	}
+	return com.Component(intrinsic.function(entities))
+}
+
+// INTRINSICS IMPLEMENTATION
+
+// This type defines the structure of a registered intrinsic function.
+type intrinsic struct {
+	parameters []string
+	function   Function
+}
+
+// This mutex guards the registry of intrinsic functions.
+var registryMutex syn.RWMutex
+
+// This map contains the registered intrinsic functions. It is initialized with
+// intrinsic functions that expose the existing element and string operations.
+var intrinsics = map[string]intrinsic{
+	// Angles
+	"sine": {[]string{"Angle"}, func(arguments []abs.Entity) abs.Entity {
+		return numberFromFloat(ele.Angle().Sine(arguments[0].(ele.AngleLike)))
+	}},
+	"cosine": {[]string{"Angle"}, func(arguments []abs.Entity) abs.Entity {
+		return numberFromFloat(ele.Angle().Cosine(arguments[0].(ele.AngleLike)))
+	}},
+	"tangent": {[]string{"Angle"}, func(arguments []abs.Entity) abs.Entity {
+		return numberFromFloat(ele.Angle().Tangent(arguments[0].(ele.AngleLike)))
+	}},
+	"arcSine": {[]string{"Number"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Angle().ArcSine(floatFrom(arguments[0]))
+	}},
+	"arcCosine": {[]string{"Number"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Angle().ArcCosine(floatFrom(arguments[0]))
+	}},
+	"arcTangent": {[]string{"Number", "Number"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Angle().ArcTangent(floatFrom(arguments[0]), floatFrom(arguments[1]))
+	}},
+	"complement": {[]string{"Angle"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Angle().Complement(arguments[0].(ele.AngleLike))
+	}},
+	"supplement": {[]string{"Angle"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Angle().Supplement(arguments[0].(ele.AngleLike))
+	}},
+
+	// Numbers
+	"logarithm": {[]string{"Number", "Number"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Number().Logarithm(arguments[0].(ele.NumberLike), arguments[1].(ele.NumberLike))
+	}},
+	"real": {[]string{"Number"}, func(arguments []abs.Entity) abs.Entity {
+		return numberFromFloat(arguments[0].(ele.NumberLike).GetReal())
+	}},
+	"imaginary": {[]string{"Number"}, func(arguments []abs.Entity) abs.Entity {
+		return numberFromFloat(arguments[0].(ele.NumberLike).GetImaginary())
+	}},
+	"phase": {[]string{"Number"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Angle().FromFloat(arguments[0].(ele.NumberLike).GetPhase())
+	}},
+
+	// Moments
+	"now": {[]string{}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Moment().Now()
+	}},
+	"earlier": {[]string{"Moment", "Duration"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Moment().Earlier(arguments[0].(ele.MomentLike), arguments[1].(ele.DurationLike))
+	}},
+	"later": {[]string{"Moment", "Duration"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Moment().Later(arguments[0].(ele.MomentLike), arguments[1].(ele.DurationLike))
+	}},
+	"duration": {[]string{"Moment", "Moment"}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Moment().Duration(arguments[0].(ele.MomentLike), arguments[1].(ele.MomentLike))
+	}},
+
+	// Versions
+	"nextVersion": {[]string{"Version", "Number"}, func(arguments []abs.Entity) abs.Entity {
+		var level = abs.Ordinal(floatFrom(arguments[1]))
+		return str.Versions.GetNextVersion(arguments[0].(abs.VersionLike), level)
+	}},
+	"isValidNextVersion": {[]string{"Version", "Version"}, func(arguments []abs.Entity) abs.Entity {
+		var current, next = arguments[0].(abs.VersionLike), arguments[1].(abs.VersionLike)
+		return ele.Boolean().FromBoolean(str.Versions.IsValidNextVersion(current, next))
+	}},
+
+	// Binary Strings
+	"not": {[]string{"Binary"}, func(arguments []abs.Entity) abs.Entity {
+		return str.Binary.Not(arguments[0].(abs.BinaryLike))
+	}},
+	"and": {[]string{"Binary", "Binary"}, func(arguments []abs.Entity) abs.Entity {
+		return str.Binary.And(arguments[0].(abs.BinaryLike), arguments[1].(abs.BinaryLike))
+	}},
+	"sans": {[]string{"Binary", "Binary"}, func(arguments []abs.Entity) abs.Entity {
+		return str.Binary.Sans(arguments[0].(abs.BinaryLike), arguments[1].(abs.BinaryLike))
+	}},
+	"or": {[]string{"Binary", "Binary"}, func(arguments []abs.Entity) abs.Entity {
+		return str.Binary.Or(arguments[0].(abs.BinaryLike), arguments[1].(abs.BinaryLike))
+	}},
+	"xor": {[]string{"Binary", "Binary"}, func(arguments []abs.Entity) abs.Entity {
+		return str.Binary.Xor(arguments[0].(abs.BinaryLike), arguments[1].(abs.BinaryLike))
+	}},
+
+	// Patterns
+	"matches": {[]string{"Quote", "Pattern"}, func(arguments []abs.Entity) abs.Entity {
+		var text = string(arguments[0].(abs.QuoteLike).AsArray())
+		return ele.Boolean().FromBoolean(arguments[1].(ele.PatternLike).MatchesText(text))
+	}},
+	"getMatches": {[]string{"Quote", "Pattern"}, func(arguments []abs.Entity) abs.Entity {
+		var text = string(arguments[0].(abs.QuoteLike).AsArray())
+		var list = col.List()
+		for _, match := range arguments[1].(ele.PatternLike).GetMatches(text) {
+			list.AddValue(com.Component(str.QuoteFromArray([]rune(match))))
+		}
+		return list
+	}},
+
+	// Random Values
+	"randomProbability": {[]string{}, func(arguments []abs.Entity) abs.Entity {
+		return ele.Probability().Random()
+	}},
+	"randomTag": {[]string{"Number"}, func(arguments []abs.Entity) abs.Entity {
+		return str.TagOfSize(int(floatFrom(arguments[0])))
+	}},
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the real part of the specified number entity.
+func floatFrom(number abs.Entity) float64 {
+	return number.(ele.NumberLike).GetReal()
+}
+
+// This function determines whether or not the specified entity is of the
+// specified parameter type.
+func isOfType(entity abs.Entity, parameter string) bool {
+	var type_ = typeOf(entity)
+	for _, alternative := range sts.Split(parameter, "|") {
+		if alternative == "Any" || alternative == type_ {
+			return true
+		}
+	}
+	return false
+}
+
+// This function returns a real number with the specified value.
+func numberFromFloat(float float64) abs.Entity {
+	return ele.Number().FromComplex(complex(float, 0))
+}
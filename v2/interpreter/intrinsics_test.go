@@ -0,0 +1,76 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package interpreter_test
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	ele "github.com/bali-nebula/go-component-framework/v2/elements"
+	int_ "github.com/bali-nebula/go-component-framework/v2/interpreter"
+	ass "github.com/stretchr/testify/assert"
+	tes "testing"
+)
+
+func TestIntrinsicFunctions(t *tes.T) {
+	var environment = int_.Environment()
+	environment.SetVariable("bits", bal.ParseComponent(`'>
+    AQID
+<'`))
+	var expressions = map[string]string{
+		`sine(~π)`:                      `0`,
+		`cosine(~π)`:                    `-1`,
+		`logarithm(2, 8)`:               `3`,
+		`later(<2024-03-31>, ~P2D)`:     `<2024-04-02>`,
+		`earlier(<2024-03-31>, ~P1D)`:   `<2024-03-30>`,
+		`nextVersion(v1.2, 2)`:          `v1.3`,
+		`nextVersion(v1.2, 3)`:          `v1.2.1`,
+		`isValidNextVersion(v1.2, v2)`:  `true`,
+		`and(bits, bits) = bits`:        `true`,
+		`matches("abc", "a.c"?)`:        `true`,
+		`matches("abd", "a.c"?)`:        `false`,
+		`randomTag(20) ≠ randomTag(20)`: `true`,
+	}
+	for source, expected := range expressions {
+		var expression = parseExpression(source)
+		var result = int_.Evaluate(expression, environment)
+		ass.Equal(t, expected, bal.FormatComponent(result), source)
+	}
+}
+
+func TestRegisterIntrinsic(t *tes.T) {
+	int_.RegisterIntrinsic("double", []string{"Number|Angle"}, func(arguments []abs.Entity) abs.Entity {
+		switch argument := arguments[0].(type) {
+		case ele.NumberLike:
+			return ele.Number().Scaled(argument, 2)
+		default:
+			return ele.Angle().Scaled(argument.(ele.AngleLike), 2)
+		}
+	})
+	ass.True(t, int_.IsIntrinsic("double"))
+	var result = int_.Evaluate(parseExpression(`double(21)`), int_.Environment())
+	ass.Equal(t, "42", bal.FormatComponent(result))
+}
+
+func TestIntrinsicErrors(t *tes.T) {
+	var environment = int_.Environment()
+	var expressions = []string{
+		`unknown(5)`,
+		`sine()`,
+		`sine(5)`,
+		`later(~P1D, <2024-03-31>)`,
+	}
+	for _, source := range expressions {
+		var expression = parseExpression(source)
+		ass.Panics(t, func() {
+			int_.Evaluate(expression, environment)
+		}, source)
+	}
+}
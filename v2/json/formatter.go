@@ -0,0 +1,249 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package json
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	sts "strings"
+)
+
+// FORMATTER INTERFACE
+
+// This function returns the JSON string for the specified component. The
+// component is mapped to JSON as follows:
+//
+//   - A catalog is mapped to an object whose member names are the canonical
+//     BDN strings for its keys.
+//   - A list, set, queue or stack is mapped to an array.
+//   - Any other entity (e.g. an element, string, range or procedure) is mapped
+//     to a tagged string containing its canonical BDN string. The leading
+//     characters of the tagged string (e.g. "<", "~" or "$") identify the type
+//     of the entity.
+//   - A component with a context or note is mapped to a metadata object whose
+//     "@value" member is the mapping of its entity, whose "@context" member is
+//     an object containing its parameters, and whose "@note" member is its
+//     note.
+//
+// Since no BDN key begins with a "@" character, a metadata object is never
+// mistaken for a catalog and the mapping is lossless.
+func FormatComponent(component abs.ComponentLike) string {
+	var v = &formatter{}
+	v.formatComponent(component)
+	return v.result.String()
+}
+
+// This function returns the JSON bytes for the specified component including
+// the POSIX standard trailing EOL.
+func FormatDocument(component abs.ComponentLike) []byte {
+	var s = FormatComponent(component) + bal.EOL
+	return []byte(s)
+}
+
+// FORMATTER IMPLEMENTATION
+
+// These constants define the names of the members of a metadata object.
+const (
+	contextMember = "@context"
+	noteMember    = "@note"
+	valueMember   = "@value"
+)
+
+// This type defines the structure and methods for a JSON formatting agent.
+type formatter struct {
+	depth  int
+	result sts.Builder
+}
+
+// This method appends a properly indented newline to the result.
+func (v *formatter) appendNewline() {
+	var separator = bal.EOL
+	for level := 0; level < v.depth; level++ {
+		separator += "    "
+	}
+	v.result.WriteString(separator)
+}
+
+// This method appends the specified string to the result as a JSON string.
+// Only the characters that JSON requires to be escaped are escaped so that the
+// tagged strings remain readable.
+func (v *formatter) appendString(string_ string) {
+	v.result.WriteString(`"`)
+	for _, character := range string_ {
+		switch character {
+		case '"':
+			v.result.WriteString(`\"`)
+		case '\\':
+			v.result.WriteString(`\\`)
+		case '\n':
+			v.result.WriteString(`\n`)
+		case '\r':
+			v.result.WriteString(`\r`)
+		case '\t':
+			v.result.WriteString(`\t`)
+		default:
+			if character < 0x20 {
+				v.result.WriteString(fmt.Sprintf(`\u%04x`, character))
+			} else {
+				v.result.WriteRune(character)
+			}
+		}
+	}
+	v.result.WriteString(`"`)
+}
+
+// This method appends the name of an object member to the result.
+func (v *formatter) appendMember(name string) {
+	v.appendNewline()
+	v.appendString(name)
+	v.result.WriteString(": ")
+}
+
+// This method adds the JSON format for the specified component to the state of
+// the formatter.
+func (v *formatter) formatComponent(component abs.ComponentLike) {
+	var entity = component.GetEntity()
+	var context = collectionContext(component)
+	if context == nil && !component.IsAnnotated() {
+		v.formatEntity(entity)
+		return
+	}
+	v.result.WriteString("{")
+	v.depth++
+	v.appendMember(valueMember)
+	v.formatEntity(entity)
+	if context != nil {
+		v.result.WriteString(",")
+		v.appendMember(contextMember)
+		v.formatContext(context)
+	}
+	if component.IsAnnotated() {
+		v.result.WriteString(",")
+		v.appendMember(noteMember)
+		v.appendString(string(component.GetNote().AsArray()))
+	}
+	v.depth--
+	v.appendNewline()
+	v.result.WriteString("}")
+}
+
+// This method adds the JSON format for the specified context to the state of
+// the formatter.
+func (v *formatter) formatContext(context abs.ContextLike) {
+	if context.IsEmpty() {
+		v.result.WriteString("{}")
+		return
+	}
+	v.result.WriteString("{")
+	v.depth++
+	var iterator = com.ParameterIterator(context)
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		v.appendMember(bal.FormatEntity(parameter.GetKey()))
+		v.formatComponent(parameter.GetValue())
+		if iterator.HasNext() {
+			v.result.WriteString(",")
+		}
+	}
+	v.depth--
+	v.appendNewline()
+	v.result.WriteString("}")
+}
+
+// This method adds the JSON format for the specified entity to the state of
+// the formatter.
+func (v *formatter) formatEntity(entity abs.Entity) {
+	switch value := entity.(type) {
+	case abs.ValuesLike:
+		v.formatValues(value)
+	case abs.AssociationsLike:
+		v.formatAssociations(value)
+	default:
+		v.appendString(bal.FormatEntity(value))
+	}
+}
+
+// This method adds the JSON format for the specified catalog associations to
+// the state of the formatter.
+func (v *formatter) formatAssociations(associations abs.AssociationsLike) {
+	if associations.IsEmpty() {
+		v.result.WriteString("{}")
+		return
+	}
+	v.result.WriteString("{")
+	v.depth++
+	var iterator = col.AssociationIterator(associations)
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		v.appendMember(bal.FormatEntity(association.GetKey()))
+		v.formatComponent(association.GetValue())
+		if iterator.HasNext() {
+			v.result.WriteString(",")
+		}
+	}
+	v.depth--
+	v.appendNewline()
+	v.result.WriteString("}")
+}
+
+// This method adds the JSON format for the specified collection values to the
+// state of the formatter.
+func (v *formatter) formatValues(values abs.ValuesLike) {
+	if values.IsEmpty() {
+		v.result.WriteString("[]")
+		return
+	}
+	v.result.WriteString("[")
+	v.depth++
+	var iterator = com.ComponentIterator(values)
+	for iterator.HasNext() {
+		var value = iterator.GetNext()
+		v.appendNewline()
+		v.formatComponent(value)
+		if iterator.HasNext() {
+			v.result.WriteString(",")
+		}
+	}
+	v.depth--
+	v.appendNewline()
+	v.result.WriteString("]")
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the context of the specified component with a "$type"
+// parameter set for any queue, set or stack. Since JSON has only one array
+// type, the parameter is needed to restore the right collection type. The
+// component itself is left unchanged.
+func collectionContext(component abs.ComponentLike) abs.ContextLike {
+	var type_ string
+	var context = component.GetContext()
+	switch component.GetEntity().(type) {
+	case abs.QueueLike:
+		type_ = queueType
+	case abs.SetLike:
+		type_ = setType
+	case abs.StackLike:
+		type_ = stackType
+	}
+	if type_ != "" {
+		if context == nil {
+			context = com.Context()
+		} else {
+			context = com.ContextFromSequence(context)
+		}
+		context.SetValue(bal.Symbol("$type"), bal.Component(type_))
+	}
+	return context
+}
@@ -0,0 +1,331 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package json
+
+import (
+	byt "bytes"
+	enc "encoding/json"
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	io "io"
+	sts "strings"
+)
+
+// PARSER INTERFACE
+
+// This function parses the specified JSON document and returns the
+// corresponding component. The document must use the mapping described by
+// FormatComponent. Plain JSON numbers and booleans are also accepted and are
+// mapped to Bali numbers and booleans. This function panics if the document is
+// not a valid mapping of a component.
+func ParseDocument(document []byte) abs.ComponentLike {
+	var component, err = TryParseDocument(document)
+	if err != nil {
+		panic(err)
+	}
+	return component
+}
+
+// This function parses a JSON source string rather than the bytes from a JSON
+// document file.
+func ParseComponent(source string) abs.ComponentLike {
+	return ParseDocument([]byte(source))
+}
+
+// This function parses the specified JSON document like ParseDocument but
+// returns any error rather than panicking. It is useful when parsing documents
+// from untrusted sources.
+func TryParseDocument(document []byte) (abs.ComponentLike, error) {
+	var decoder = enc.NewDecoder(byt.NewReader(document))
+	decoder.UseNumber() // Keep the exact digits of each number.
+	var v = &parser{decoder: decoder}
+	var component, err = v.parseComponent()
+	if err != nil {
+		return nil, err
+	}
+	_, err = decoder.Token()
+	if err != io.EOF {
+		return nil, fmt.Errorf("The JSON document contains more than one value.")
+	}
+	return component, nil
+}
+
+// PARSER IMPLEMENTATION
+
+// These constants define the types of the collections that are mapped to JSON
+// arrays.
+const (
+	queueType = "/bali/types/collections/Queue/v1"
+	setType   = "/bali/types/collections/Set/v1"
+	stackType = "/bali/types/collections/Stack/v1"
+)
+
+// This type defines the structure and methods for the JSON parser agent.
+type parser struct {
+	decoder *enc.Decoder
+}
+
+// This method parses the next JSON value and returns the corresponding
+// component.
+func (v *parser) parseComponent() (abs.ComponentLike, error) {
+	var token, err = v.decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	var entity abs.Entity
+	switch actual := token.(type) {
+	case enc.Delim:
+		switch actual {
+		case '[':
+			entity, err = v.parseValues()
+		case '{':
+			return v.parseObject()
+		default:
+			err = fmt.Errorf("An unexpected JSON delimiter was found: %v", actual)
+		}
+	case string:
+		entity, err = bal.TryParseEntity(actual)
+	case enc.Number:
+		entity, err = v.parseNumber(actual)
+	case bool:
+		entity = bal.Boolean(actual)
+	default:
+		err = fmt.Errorf("An unexpected JSON value was found: %v", actual)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return com.Component(entity), nil
+}
+
+// This method parses the remaining members of a JSON object for a catalog
+// whose first member has the specified name.
+func (v *parser) parseCatalog(name string) (abs.CatalogLike, error) {
+	var catalog = col.Catalog()
+	for {
+		var key, err = bal.TryParseEntity(name)
+		if err != nil {
+			return nil, err
+		}
+		var value abs.ComponentLike
+		value, err = v.parseComponent()
+		if err != nil {
+			return nil, err
+		}
+		catalog.SetValue(key, value)
+		if !v.decoder.More() {
+			break
+		}
+		name, err = v.parseName()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return catalog, v.parseDelimiter('}')
+}
+
+// This method parses the members of a JSON object containing the parameters
+// of a context.
+func (v *parser) parseContext() (abs.ContextLike, error) {
+	var err = v.parseDelimiter('{')
+	if err != nil {
+		return nil, err
+	}
+	var context = com.Context()
+	for v.decoder.More() {
+		var name string
+		name, err = v.parseName()
+		if err != nil {
+			return nil, err
+		}
+		var symbol abs.SymbolLike
+		symbol, err = v.parseSymbol(name)
+		if err != nil {
+			return nil, err
+		}
+		var value abs.ComponentLike
+		value, err = v.parseComponent()
+		if err != nil {
+			return nil, err
+		}
+		context.SetValue(symbol, value)
+	}
+	return context, v.parseDelimiter('}')
+}
+
+// This method parses the next JSON token which must be the specified
+// delimiter.
+func (v *parser) parseDelimiter(delimiter enc.Delim) error {
+	var token, err = v.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delimiter {
+		return fmt.Errorf("Expected a JSON %v delimiter but found: %v", delimiter, token)
+	}
+	return nil
+}
+
+// This method parses the remaining members of a metadata object whose first
+// member has the specified name and returns the corresponding component.
+func (v *parser) parseMetadata(name string) (abs.ComponentLike, error) {
+	var err error
+	var entity abs.Entity
+	var context abs.ContextLike
+	var note abs.NoteLike
+	for {
+		switch name {
+		case valueMember:
+			var value abs.ComponentLike
+			value, err = v.parseComponent()
+			if err == nil && (value.IsParameterized() || value.IsAnnotated()) {
+				err = fmt.Errorf("The %q member cannot contain another metadata object.", name)
+			}
+			if err == nil {
+				entity = value.GetEntity()
+			}
+		case contextMember:
+			context, err = v.parseContext()
+		case noteMember:
+			var token enc.Token
+			token, err = v.decoder.Token()
+			var text, ok = token.(string)
+			if err == nil && !ok {
+				err = fmt.Errorf("The %q member must be a string: %v", name, token)
+			}
+			note = com.Note(text)
+		default:
+			err = fmt.Errorf("An unknown metadata member was found: %q", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !v.decoder.More() {
+			break
+		}
+		name, err = v.parseName()
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = v.parseDelimiter('}')
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, fmt.Errorf("A metadata object is missing its %q member.", valueMember)
+	}
+	entity = adjustEntity(entity, context)
+	var component = com.ComponentWithContext(entity, context)
+	component.SetNote(note)
+	return component, nil
+}
+
+// This method parses the name of the next member of a JSON object.
+func (v *parser) parseName() (string, error) {
+	var token, err = v.decoder.Token()
+	if err != nil {
+		return "", err
+	}
+	var name, ok = token.(string)
+	if !ok {
+		return "", fmt.Errorf("Expected a JSON member name but found: %v", token)
+	}
+	return name, nil
+}
+
+// This method converts the specified JSON number into a number element.
+func (v *parser) parseNumber(number enc.Number) (abs.Entity, error) {
+	var float, err = number.Float64()
+	if err != nil {
+		return nil, err
+	}
+	return bal.Number(float), nil
+}
+
+// This method parses the remaining members of a JSON object that is either a
+// catalog or a metadata object and returns the corresponding component.
+func (v *parser) parseObject() (abs.ComponentLike, error) {
+	if !v.decoder.More() {
+		var err = v.parseDelimiter('}')
+		if err != nil {
+			return nil, err
+		}
+		return com.Component(col.Catalog()), nil
+	}
+	var name, err = v.parseName()
+	if err != nil {
+		return nil, err
+	}
+	if sts.HasPrefix(name, "@") {
+		return v.parseMetadata(name)
+	}
+	var catalog abs.CatalogLike
+	catalog, err = v.parseCatalog(name)
+	if err != nil {
+		return nil, err
+	}
+	return com.Component(catalog), nil
+}
+
+// This method converts the specified member name into a symbol.
+func (v *parser) parseSymbol(name string) (abs.SymbolLike, error) {
+	var entity, err = bal.TryParseEntity(name)
+	if err != nil {
+		return nil, err
+	}
+	var symbol, ok = entity.(abs.SymbolLike)
+	if !ok {
+		return nil, fmt.Errorf("A context parameter name must be a symbol: %v", name)
+	}
+	return symbol, nil
+}
+
+// This method parses the remaining values of a JSON array and returns them as
+// a list.
+func (v *parser) parseValues() (abs.ListLike, error) {
+	var list = col.List()
+	for v.decoder.More() {
+		var value, err = v.parseComponent()
+		if err != nil {
+			return nil, err
+		}
+		list.AddValue(value)
+	}
+	return list, v.parseDelimiter(']')
+}
+
+// PRIVATE FUNCTIONS
+
+// This function converts the specified list into the type of collection named
+// by the "$type" parameter in the specified context (if any).
+func adjustEntity(entity abs.Entity, context abs.ContextLike) abs.Entity {
+	var list, ok = entity.(abs.ListLike)
+	if !ok || context == nil {
+		return entity
+	}
+	var type_ = context.GetValue(bal.Symbol("$type"))
+	if type_ == nil {
+		return entity
+	}
+	switch bal.FormatEntity(type_.GetEntity()) {
+	case queueType:
+		entity = col.QueueFromSequence(list)
+	case setType:
+		entity = col.SetFromSequence(list)
+	case stackType:
+		entity = col.StackFromSequence(list)
+	}
+	return entity
+}
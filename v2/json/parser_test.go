@@ -0,0 +1,113 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package json_test
+
+import (
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	jsn "github.com/bali-nebula/go-component-framework/v2/json"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	sts "strings"
+	tes "testing"
+)
+
+const testDirectory = "../bali/test/"
+
+func TestJSONRoundtrips(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ../bali/test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var expected, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(expected)
+			var document = jsn.FormatDocument(component)
+			component = jsn.ParseDocument(document)
+			ass.Equal(t, string(expected), string(bal.FormatDocument(component)))
+			ass.Equal(t, string(document), string(jsn.FormatDocument(component)))
+		}
+	}
+}
+
+func TestJSONMapping(t *tes.T) {
+	var source = `[
+    $moment: <2024-03-31T12:30:15>
+    $duration: ~P3DT4H
+    $angle: ~π
+    $quote: "Hello World!"  ! A famous greeting.
+    $values: [
+        1
+        $two
+    ]($type: /bali/types/collections/Set/v1)
+    $empty: [:]
+]($type: /bali/types/Example/v1)`
+	var expected = `{
+    "@value": {
+        "$moment": "<2024-03-31T12:30:15>",
+        "$duration": "~P3DT4H",
+        "$angle": "~π",
+        "$quote": {
+            "@value": "\"Hello World!\"",
+            "@note": "A famous greeting."
+        },
+        "$values": {
+            "@value": [
+                "1",
+                "$two"
+            ],
+            "@context": {
+                "$type": "/bali/types/collections/Set/v1"
+            }
+        },
+        "$empty": {}
+    },
+    "@context": {
+        "$type": "/bali/types/Example/v1"
+    }
+}`
+	var component = bal.ParseComponent(source)
+	var json = jsn.FormatComponent(component)
+	ass.Equal(t, expected, json)
+	component = jsn.ParseComponent(json)
+	ass.Equal(t, source, bal.FormatComponent(component))
+	var set = component.ExtractCatalog().GetValue(bal.Symbol("$values"))
+	ass.Equal(t, 2, set.ExtractSet().GetSize())
+}
+
+func TestJSONPlainValues(t *tes.T) {
+	var component = jsn.ParseComponent(`{"$count": 5, "$done": false, "$items": []}`)
+	ass.Equal(t, `[
+    $count: 5
+    $done: false
+    $items: [ ]
+]`, bal.FormatComponent(component))
+}
+
+func TestJSONErrors(t *tes.T) {
+	var documents = []string{
+		`null`,
+		`{"@value": "1", "@unknown": "2"}`,
+		`{"@context": {"$type": "/bali/types/Example/v1"}}`,
+		`{"@value": {"@value": "1", "@note": "nested"}}`,
+		`{"@value": "1", "@context": {"type": "1"}}`,
+		`"1" "2"`,
+		`[`,
+		`"<2024-02-30>"`,
+		`{"$date": "<2024-02-31>"}`,
+	}
+	for _, document := range documents {
+		var _, err = jsn.TryParseDocument([]byte(document))
+		ass.Error(t, err, document)
+	}
+}
@@ -0,0 +1,109 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package machine
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	run "runtime"
+)
+
+// EXECUTION ERROR INTERFACE
+
+// This type defines the structure of an error that occurred while executing a
+// program and was not handled by any exception handler. The error carries the
+// address of the instruction that failed and the exception that was either
+// thrown by the instruction or describes why the instruction failed.
+type ExecutionError struct {
+	Address   int               // The address of the failing instruction.
+	Exception abs.ComponentLike // The exception that was not handled.
+	Message   string            // A plain text description of the error.
+}
+
+// This method returns the plain text rendering of this execution error.
+func (v *ExecutionError) Error() string {
+	return fmt.Sprintf("%v (address %v)", v.Message, v.Address)
+}
+
+// EXECUTION ERROR IMPLEMENTATION
+
+// This type defines the structure of a failure that is unwinding the call
+// frames of the virtual machine. A failure is passed along using a panic until
+// it reaches the processing loop, which transfers control to the most recently
+// pushed exception handler.
+type failure struct {
+	exception abs.ComponentLike
+	message   string
+	address   int
+}
+
+// This method returns the execution error that describes this failure.
+func (v *failure) asError() *ExecutionError {
+	return &ExecutionError{
+		Address:   v.address,
+		Exception: v.exception,
+		Message:   v.message,
+	}
+}
+
+// This function returns a failure for the specified thrown exception.
+func exceptionFailure(exception abs.ComponentLike) *failure {
+	var message = "An unhandled exception was thrown: " + bal.FormatComponent(exception)
+	return &failure{exception: exception, message: message}
+}
+
+// This function returns the failure described by the specified recovered panic
+// value. Any panic other than a failure (e.g. an invalid operation on a
+// component) is turned into a failure whose exception is a quote containing the
+// panic message. A runtime error is a bug in the virtual machine rather than a
+// failure of the program so it is passed along. The address of a failure that
+// does not yet have one is set to the specified address.
+func failureFrom(value any, address int) *failure {
+	var result *failure
+	switch actual := value.(type) {
+	case *failure:
+		result = actual
+	case run.Error:
+		panic(actual)
+	case error:
+		result = messageFailure(actual.Error())
+	default:
+		result = messageFailure(fmt.Sprintf("%v", actual))
+	}
+	if result.address == 0 {
+		result.address = address
+	}
+	return result
+}
+
+// This function returns a failure with the specified message as its exception.
+func messageFailure(message string) *failure {
+	var exception = com.Component(str.QuoteFromArray([]rune(message)))
+	return &failure{exception: exception, message: message}
+}
+
+// This function recovers from a panic caused by an unhandled failure and stores
+// the corresponding execution error in the specified error variable. Any other
+// panic is passed along.
+func catchExecutionError(err *error) {
+	var e = recover()
+	if e == nil {
+		return
+	}
+	var executionError, ok = e.(*ExecutionError)
+	if !ok {
+		panic(e)
+	}
+	*err = executionError
+}
@@ -0,0 +1,204 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package machine
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	cmp "github.com/bali-nebula/go-component-framework/v2/compiler"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+)
+
+// HOST IMPLEMENTATION
+
+// This constant defines the name of the bag that the events published using an
+// in-memory host are posted to.
+const EventBag = "/nebula/events/bag"
+
+// This constructor creates a new in-memory host with no compiled methods. The
+// host keeps its bags of messages, saved drafts and notarized documents in
+// memory, which makes it suitable for running programs in tests.
+func Host() abs.HostLike {
+	return HostWithMethods(col.Catalog())
+}
+
+// This constructor creates a new in-memory host with the specified compiled
+// methods. The catalog maps the symbol for the name of each method to a catalog
+// describing its program (see compiler.ProgramFromCatalog()). The methods can
+// be invoked on any target component.
+func HostWithMethods(methods abs.CatalogLike) abs.HostLike {
+	var v = &host{
+		methods:   map[string]abs.ProgramLike{},
+		bags:      map[string]abs.QueueLike{},
+		retrieved: map[abs.ComponentLike]abs.ComponentLike{},
+		drafts:    map[string]abs.ComponentLike{},
+		documents: map[string]abs.ComponentLike{},
+	}
+	for _, association := range methods.AsArray() {
+		var name = string(association.GetKey().(abs.SymbolLike).AsArray())
+		var program = cmp.ProgramFromCatalog(association.GetValue().ExtractCatalog())
+		v.methods[name] = program
+	}
+	return v
+}
+
+// This type defines the structure and methods associated with an in-memory
+// host. Bags, drafts and documents are keyed by the canonical BDN strings of
+// their names and citations.
+type host struct {
+	methods   map[string]abs.ProgramLike
+	bags      map[string]abs.QueueLike
+	retrieved map[abs.ComponentLike]abs.ComponentLike // The bag of each retrieved message.
+	drafts    map[string]abs.ComponentLike
+	documents map[string]abs.ComponentLike
+}
+
+// DELEGATING INTERFACE
+
+// This method returns the notarized document with the specified name.
+func (v *host) Dereference(reference abs.ComponentLike) abs.ComponentLike {
+	var document, ok = v.documents[bal.FormatComponent(reference)]
+	if !ok {
+		var message = fmt.Sprintf("There is no document named: %v", bal.FormatComponent(reference))
+		panic(message)
+	}
+	return document
+}
+
+// This method panics since an in-memory host can only invoke compiled methods.
+func (v *host) Invoke(
+	target abs.ComponentLike,
+	method string,
+	arguments abs.Sequential[abs.ComponentLike],
+	isSynchronous bool,
+) abs.ComponentLike {
+	var message = fmt.Sprintf("The %v method is not supported by this host.", method)
+	panic(message)
+}
+
+// DISPATCHING INTERFACE
+
+// This method returns the compiled program for the specified method, or nil if
+// the host has no such method.
+func (v *host) LookupMethod(target abs.ComponentLike, method string) abs.ProgramLike {
+	return v.methods[method]
+}
+
+// MESSAGING INTERFACE
+
+// This method accepts the specified message, removing it from its bag for good.
+func (v *host) AcceptMessage(message abs.ComponentLike) {
+	v.bagOf(message)
+	delete(v.retrieved, message)
+}
+
+// This method rejects the specified message, posting it back to its bag.
+func (v *host) RejectMessage(message abs.ComponentLike) {
+	var bag = v.bagOf(message)
+	delete(v.retrieved, message)
+	v.PostMessage(message, bag)
+}
+
+// This method publishes the specified event by posting it to the event bag.
+func (v *host) PublishEvent(event abs.ComponentLike) {
+	v.PostMessage(event, bal.ParseComponent(EventBag))
+}
+
+// This method posts the specified message to the specified bag.
+func (v *host) PostMessage(message abs.ComponentLike, bag abs.ComponentLike) {
+	var key = bal.FormatComponent(bag)
+	var queue, ok = v.bags[key]
+	if !ok {
+		queue = col.Queue()
+		v.bags[key] = queue
+	}
+	if queue.GetSize() == queue.GetCapacity() {
+		// Adding a message to a full queue would block forever.
+		var message = fmt.Sprintf("The bag %v is full.", key)
+		panic(message)
+	}
+	queue.AddValue(message)
+}
+
+// This method retrieves the next message from the specified bag. The message
+// must be accepted or rejected once it has been processed.
+func (v *host) RetrieveMessage(bag abs.ComponentLike) abs.ComponentLike {
+	var key = bal.FormatComponent(bag)
+	var queue, ok = v.bags[key]
+	if !ok || queue.IsEmpty() {
+		// Removing a message from an empty queue would block forever.
+		var message = fmt.Sprintf("The bag %v contains no messages.", key)
+		panic(message)
+	}
+	var message, _ = queue.RemoveHead()
+	v.retrieved[message] = bag
+	return message
+}
+
+// REPOSITORY INTERFACE
+
+// This method returns a new draft of the notarized document with the specified
+// name. The level of the version is ignored by an in-memory host.
+func (v *host) CheckoutDraft(name abs.ComponentLike, level abs.ComponentLike) abs.ComponentLike {
+	return copyOf(v.Dereference(name))
+}
+
+// This method saves a copy of the specified draft and returns a citation to the
+// saved draft.
+func (v *host) SaveDraft(draft abs.ComponentLike) abs.ComponentLike {
+	var citation = com.Component(str.TagOfSize(20))
+	v.drafts[bal.FormatComponent(citation)] = copyOf(draft)
+	return citation
+}
+
+// This method discards the saved drafts that are equal to the specified draft.
+func (v *host) DiscardDraft(draft abs.ComponentLike) {
+	var source = bal.FormatComponent(draft)
+	var count = len(v.drafts)
+	for citation, saved := range v.drafts {
+		if bal.FormatComponent(saved) == source {
+			delete(v.drafts, citation)
+		}
+	}
+	if len(v.drafts) == count {
+		var message = fmt.Sprintf("The draft has not been saved: %v", source)
+		panic(message)
+	}
+}
+
+// This method notarizes a copy of the specified draft as the document with the
+// specified name. A notarized document cannot be replaced.
+func (v *host) NotarizeDraft(draft abs.ComponentLike, name abs.ComponentLike) {
+	var key = bal.FormatComponent(name)
+	var _, ok = v.documents[key]
+	if ok {
+		var message = fmt.Sprintf("A document named %v has already been notarized.", key)
+		panic(message)
+	}
+	v.documents[key] = copyOf(draft)
+}
+
+// PRIVATE METHODS
+
+// This method returns the bag from which the specified message was retrieved.
+// It panics if the message was not retrieved from a bag.
+func (v *host) bagOf(message abs.ComponentLike) abs.ComponentLike {
+	var bag, ok = v.retrieved[message]
+	if !ok {
+		var text = fmt.Sprintf("The message was not retrieved from a bag: %v",
+			bal.FormatComponent(message))
+		panic(text)
+	}
+	return bag
+}
@@ -0,0 +1,485 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package machine
+
+import (
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	cmp "github.com/bali-nebula/go-component-framework/v2/compiler"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	ele "github.com/bali-nebula/go-component-framework/v2/elements"
+	int_ "github.com/bali-nebula/go-component-framework/v2/interpreter"
+)
+
+// VIRTUAL MACHINE INTERFACE
+
+// This function executes the bytecode instructions of the specified program on
+// a stack based virtual machine and returns the result of its outermost return
+// instruction, or nil if the program returns without a result. The variables
+// of the program are kept in a new environment. The messaging and repository
+// instructions, dereferences and method invocations are delegated to the
+// specified host. A method invocation whose method has a compiled program (see
+// the LookupMethod() method of the host) is executed in a new call frame with
+// the variables "target" and "arguments" defined. This function panics with an
+// *ExecutionError if the program fails with an exception that is not handled by
+// any exception handler.
+func Execute(program abs.ProgramLike, host abs.HostLike) abs.ComponentLike {
+	var v = &processor{
+		host:       host,
+		components: col.StackWithCapacity(componentCapacity),
+		frames:     col.StackWithCapacity(frameCapacity),
+	}
+	v.pushFrame(program, int_.Environment())
+	for !v.frames.IsEmpty() {
+		v.executeInstructions()
+	}
+	return v.result
+}
+
+// This function executes a program like Execute but returns any unhandled
+// failure as an *ExecutionError rather than panicking.
+func TryExecute(program abs.ProgramLike, host abs.HostLike) (result abs.ComponentLike, err error) {
+	defer catchExecutionError(&err)
+	result = Execute(program, host)
+	return result, err
+}
+
+// VIRTUAL MACHINE IMPLEMENTATION
+
+// These constants define the maximum number of components on the component
+// stack and the maximum number of nested call frames. A program that exceeds
+// either of them fails rather than overflowing the stack.
+const (
+	componentCapacity = 1024
+	frameCapacity     = 256
+)
+
+// This type defines the structure of an exception handler. The handler begins
+// at the specified address and expects the component stack to be restored to
+// the specified depth, and the variable scopes to the specified number, before
+// the exception is pushed onto it.
+type handler struct {
+	address int
+	depth   int
+	scopes  int
+}
+
+// This type defines the structure of a call frame. Each call frame executes a
+// program using its own variables and exception handlers.
+type frame struct {
+	program   abs.ProgramLike
+	bytecode  []abs.Instruction
+	variables abs.EnvironmentLike // The current variable scope.
+	scopes    abs.StackLike       // The enclosing variable scopes, each wrapped in a component.
+	handlers  abs.StackLike       // The exception handlers, each wrapped in a component.
+	address   int                 // The address of the next instruction to be executed.
+	depth     int                 // The depth of the component stack when the frame was pushed.
+}
+
+// This type defines the structure and methods associated with a stack based
+// virtual machine.
+type processor struct {
+	host       abs.HostLike
+	components abs.StackLike
+	frames     abs.StackLike     // The call frames, each wrapped in a component.
+	failure    *failure          // The failure currently being handled (if any).
+	result     abs.ComponentLike // The result of the outermost call frame.
+}
+
+// This method pushes a new call frame that executes the specified program using
+// the specified variables.
+func (v *processor) pushFrame(program abs.ProgramLike, variables abs.EnvironmentLike) {
+	checkCapacity(v.frames, "call frame")
+	v.frames.AddValue(com.Component(&frame{
+		program:   program,
+		bytecode:  program.GetBytecode().AsArray(),
+		variables: variables,
+		scopes:    col.Stack(),
+		handlers:  col.Stack(),
+		address:   1,
+		depth:     v.components.GetSize(),
+	}))
+}
+
+// This method returns the current call frame.
+func (v *processor) currentFrame() *frame {
+	return v.frames.GetTop().GetEntity().(*frame)
+}
+
+// This method pops the current call frame and passes the specified result to
+// the calling frame, or saves it as the result of the program if there is no
+// calling frame.
+func (v *processor) popFrame(result abs.ComponentLike) {
+	var frame = v.frames.RemoveTop().GetEntity().(*frame)
+	v.restoreDepth(frame.depth)
+	if v.frames.IsEmpty() {
+		v.result = result
+		return
+	}
+	if result == nil {
+		// A method without a result returns none.
+		result = com.Component(ele.Pattern().None())
+	}
+	v.pushComponent(result)
+}
+
+// This method executes the instructions of the current call frame until all
+// call frames have been popped or an instruction fails. A failure is handled
+// by the most recently pushed exception handler of the innermost call frame
+// that has one. This method panics with an *ExecutionError if no call frame
+// has an exception handler.
+func (v *processor) executeInstructions() {
+	var address int
+	defer func() {
+		var e = recover()
+		if e == nil {
+			return
+		}
+		v.handleFailure(failureFrom(e, address))
+	}()
+	for !v.frames.IsEmpty() {
+		var frame = v.currentFrame()
+		address = frame.address
+		if address < 1 || address > len(frame.bytecode) {
+			var message = fmt.Sprintf("The address %v is outside of the bytecode.", address)
+			panic(message)
+		}
+		frame.address++
+		v.executeInstruction(frame, frame.bytecode[address-1])
+	}
+}
+
+// This method transfers control to the exception handler that handles the
+// specified failure. The call frames that have no exception handlers are
+// popped first. This method panics with an *ExecutionError if no call frame
+// has an exception handler.
+func (v *processor) handleFailure(failure_ *failure) {
+	for !v.frames.IsEmpty() {
+		var frame = v.currentFrame()
+		if !frame.handlers.IsEmpty() {
+			var handler_ = frame.handlers.RemoveTop().GetEntity().(handler)
+			v.restoreDepth(handler_.depth)
+			for frame.scopes.GetSize() > handler_.scopes {
+				frame.variables = frame.scopes.RemoveTop().GetEntity().(abs.EnvironmentLike)
+			}
+			v.pushComponent(failure_.exception)
+			v.failure = failure_
+			frame.address = handler_.address
+			return
+		}
+		v.frames.RemoveTop()
+		v.restoreDepth(frame.depth)
+	}
+	panic(failure_.asError())
+}
+
+// This method pushes the specified component onto the component stack.
+func (v *processor) pushComponent(component abs.ComponentLike) {
+	checkCapacity(v.components, "component")
+	v.components.AddValue(component)
+}
+
+// This method pops components off the component stack until it has the
+// specified depth.
+func (v *processor) restoreDepth(depth int) {
+	for v.components.GetSize() > depth {
+		v.components.RemoveTop()
+	}
+}
+
+// This method pops the specified number of components off the component stack
+// and returns them in the order in which they were pushed.
+func (v *processor) popComponents(count int) []abs.ComponentLike {
+	var components = make([]abs.ComponentLike, count)
+	for index := count - 1; index >= 0; index-- {
+		components[index] = v.components.RemoveTop()
+	}
+	return components
+}
+
+// This method pops a boolean component off the component stack and returns its
+// value. It panics if the component is not a boolean.
+func (v *processor) popCondition() bool {
+	var condition = v.components.RemoveTop()
+	var boolean, ok = condition.GetEntity().(ele.BooleanLike)
+	if !ok || ele.GetType(boolean) != "Boolean" {
+		var message = fmt.Sprintf("A condition must be a boolean: %v", bal.FormatComponent(condition))
+		panic(message)
+	}
+	return boolean.AsBoolean()
+}
+
+// This method pushes a boolean component with the specified value onto the
+// component stack.
+func (v *processor) pushBoolean(boolean bool) {
+	v.pushComponent(com.Component(ele.Boolean().FromBoolean(boolean)))
+}
+
+// This method executes the specified instruction in the specified call frame.
+func (v *processor) executeInstruction(frame *frame, instruction abs.Instruction) {
+	var opcode = cmp.GetOpcode(instruction)
+	var operand = cmp.GetOperand(instruction)
+	switch opcode {
+	// Jumps
+	case cmp.JUMP:
+		frame.address = operand
+	case cmp.JUMP_ON_FALSE:
+		if !v.popCondition() {
+			frame.address = operand
+		}
+	case cmp.PUSH_HANDLER:
+		// The component stack must have room for the exception.
+		checkCapacity(v.components, "component")
+		checkCapacity(frame.handlers, "exception handler")
+		frame.handlers.AddValue(com.Component(handler{operand, v.components.GetSize(), frame.scopes.GetSize()}))
+	case cmp.PULL_HANDLER:
+		frame.handlers.RemoveTop()
+
+	// Variable Scopes
+	case cmp.PUSH_SCOPE:
+		checkCapacity(frame.scopes, "variable scope")
+		frame.scopes.AddValue(com.Component(frame.variables))
+		frame.variables = int_.Frame(frame.variables)
+	case cmp.POP_SCOPE:
+		frame.variables = frame.scopes.RemoveTop().GetEntity().(abs.EnvironmentLike)
+
+	// Loads and Stores
+	case cmp.PUSH_LITERAL:
+		v.pushComponent(copyOf(literalOf(frame, operand)))
+	case cmp.POP:
+		v.components.RemoveTop()
+	case cmp.DUPLICATE:
+		v.pushComponent(v.components.GetTop())
+	case cmp.LIST:
+		var list = col.List()
+		for _, component := range v.popComponents(operand) {
+			list.AddValue(component)
+		}
+		v.pushComponent(com.Component(list))
+	case cmp.LOAD_VARIABLE:
+		v.pushComponent(frame.variables.GetVariable(symbolOf(frame, operand)))
+	case cmp.STORE_VARIABLE:
+		var value = v.components.RemoveTop()
+		int_.AssignVariable(frame.variables, symbolOf(frame, operand), abs.ASSIGN, value)
+	case cmp.STORE_DEFAULT:
+		var value = v.components.RemoveTop()
+		int_.AssignVariable(frame.variables, symbolOf(frame, operand), abs.DEFAULT, value)
+	case cmp.LOAD_SUBCOMPONENT:
+		var operands = v.popComponents(2)
+		v.pushComponent(int_.GetSubcomponent(operands[0], operands[1]))
+	case cmp.STORE_SUBCOMPONENT:
+		var operands = v.popComponents(3)
+		int_.AssignSubcomponent(operands[1], operands[2], abs.Operator(operand), operands[0])
+
+	// Operators and Invocations
+	case cmp.UNARY:
+		v.executeUnary(abs.Operator(operand))
+	case cmp.BINARY:
+		var operands = v.popComponents(2)
+		v.pushComponent(int_.Operate(operands[0], abs.Operator(operand), operands[1]))
+	case cmp.MATCH:
+		var operands = v.popComponents(2)
+		v.pushBoolean(int_.IsMatch(operands[0], operands[1]))
+	case cmp.HANDLES:
+		var operands = v.popComponents(2)
+		v.pushBoolean(int_.IsHandledBy(operands[0], operands[1]))
+	case cmp.INVOKE_INTRINSIC:
+		var arguments = v.components.RemoveTop().ExtractList()
+		v.pushComponent(int_.CallIntrinsic(symbolOf(frame, operand), arguments.AsArray()))
+	case cmp.INVOKE_METHOD:
+		v.executeInvocation(symbolOf(frame, operand), true)
+	case cmp.SEND_MESSAGE:
+		v.executeInvocation(symbolOf(frame, operand), false)
+	case cmp.ITERATE:
+		v.executeIterate()
+	case cmp.NEXT_ITEM:
+		var iterator = v.components.GetTop().GetEntity().(abs.ComponentIteratorLike)
+		if iterator.HasNext() {
+			v.pushComponent(iterator.GetNext())
+		} else {
+			v.components.RemoveTop()
+			frame.address = operand
+		}
+	case cmp.RETURN:
+		var result abs.ComponentLike
+		if operand == 1 {
+			result = v.components.RemoveTop()
+		}
+		v.popFrame(result)
+	case cmp.THROW:
+		v.executeThrow()
+
+	// Message Clauses
+	case cmp.ACCEPT:
+		v.host.AcceptMessage(v.components.RemoveTop())
+	case cmp.REJECT:
+		v.host.RejectMessage(v.components.RemoveTop())
+	case cmp.PUBLISH:
+		v.host.PublishEvent(v.components.RemoveTop())
+	case cmp.POST:
+		var operands = v.popComponents(2)
+		v.host.PostMessage(operands[0], operands[1])
+	case cmp.RETRIEVE:
+		var bag = v.components.RemoveTop()
+		v.pushComponent(v.host.RetrieveMessage(bag))
+
+	// Repository Clauses
+	case cmp.CHECKOUT:
+		var level abs.ComponentLike
+		if operand == 1 {
+			level = v.components.RemoveTop()
+		}
+		var name = v.components.RemoveTop()
+		v.pushComponent(v.host.CheckoutDraft(name, level))
+	case cmp.SAVE:
+		var draft = v.components.RemoveTop()
+		v.pushComponent(v.host.SaveDraft(draft))
+	case cmp.DISCARD:
+		v.host.DiscardDraft(v.components.RemoveTop())
+	case cmp.NOTARIZE:
+		var operands = v.popComponents(2)
+		v.host.NotarizeDraft(operands[0], operands[1])
+
+	default:
+		var message = fmt.Sprintf("An invalid instruction was found: %v", instruction)
+		panic(message)
+	}
+}
+
+// This method pops a list of arguments and a target component off the
+// component stack and invokes the specified method on the target. A
+// synchronous invocation of a method that has a compiled program pushes a new
+// call frame for the program, otherwise the invocation is delegated to the host
+// and its result is pushed onto the component stack.
+func (v *processor) executeInvocation(method string, isSynchronous bool) {
+	var operands = v.popComponents(2)
+	var target = operands[0]
+	var arguments = operands[1].ExtractList()
+	if isSynchronous {
+		var program = v.host.LookupMethod(target, method)
+		if program != nil {
+			var variables = int_.Environment()
+			variables.SetVariable("target", target)
+			variables.SetVariable("arguments", operands[1])
+			v.pushFrame(program, variables)
+			return
+		}
+	}
+	v.pushComponent(v.host.Invoke(target, method, arguments, isSynchronous))
+}
+
+// This method pops a sequence off the component stack and pushes an iterator
+// over its items.
+func (v *processor) executeIterate() {
+	var sequence = v.components.RemoveTop()
+	var items, ok = sequence.GetEntity().(abs.Sequential[abs.ComponentLike])
+	if !ok {
+		var message = fmt.Sprintf("A with clause requires a sequence of items: %v", bal.FormatComponent(sequence))
+		panic(message)
+	}
+	v.pushComponent(com.Component(com.ComponentIterator(items)))
+}
+
+// This method pops an exception off the component stack and throws it. An
+// exception that is rethrown by an exception handler keeps its original
+// failure.
+func (v *processor) executeThrow() {
+	var exception = v.components.RemoveTop()
+	if v.failure != nil && v.failure.exception == exception {
+		panic(v.failure)
+	}
+	panic(exceptionFailure(exception))
+}
+
+// This method pops a component off the component stack and pushes the result
+// of applying the specified unary operator to it. A dereference is delegated
+// to the host.
+func (v *processor) executeUnary(operator abs.Operator) {
+	var component = v.components.RemoveTop()
+	if operator == abs.AT {
+		v.pushComponent(v.host.Dereference(component))
+		return
+	}
+	v.pushComponent(int_.Apply(operator, component))
+}
+
+// PRIVATE FUNCTIONS
+
+// This function panics with a message if the specified stack has reached its
+// capacity. The panic fails the current instruction, unlike the panic of the
+// stack itself, which formats every component on the stack.
+func checkCapacity(stack abs.StackLike, name string) {
+	var capacity = stack.GetCapacity()
+	if stack.GetSize() == capacity {
+		var message = fmt.Sprintf("The %v stack exceeded its capacity of %v.", name, capacity)
+		panic(message)
+	}
+}
+
+// This function returns a copy of the specified literal component. Each
+// collection, including any collections nested within it, is copied so that
+// changes to it do not alter the literal table of the program. The elements and
+// strings are immutable so they are shared.
+func copyOf(literal abs.ComponentLike) abs.ComponentLike {
+	var entity abs.Entity
+	switch collection := literal.GetEntity().(type) {
+	case abs.CatalogLike:
+		var catalog = col.Catalog()
+		for _, association := range collection.AsArray() {
+			catalog.SetValue(association.GetKey(), copyOf(association.GetValue()))
+		}
+		entity = catalog
+	case abs.ListLike:
+		var list = col.List()
+		for _, value := range collection.AsArray() {
+			list.AddValue(copyOf(value))
+		}
+		entity = list
+	case abs.SetLike:
+		var set = col.Set()
+		for _, value := range collection.AsArray() {
+			set.AddValue(copyOf(value))
+		}
+		entity = set
+	case abs.QueueLike:
+		var queue = col.QueueWithCapacity(collection.GetCapacity())
+		for _, value := range collection.AsArray() {
+			queue.AddValue(copyOf(value))
+		}
+		entity = queue
+	case abs.StackLike:
+		var stack = col.StackWithCapacity(collection.GetCapacity())
+		for _, value := range collection.AsArray() {
+			stack.AddValue(copyOf(value))
+		}
+		entity = stack
+	default:
+		return literal
+	}
+	var component = com.ComponentWithContext(entity, literal.GetContext())
+	component.SetNote(literal.GetNote())
+	return component
+}
+
+// This function returns the literal at the specified index in the literal table
+// of the program for the specified call frame.
+func literalOf(frame *frame, index int) abs.ComponentLike {
+	return frame.program.GetLiterals().GetValue(index)
+}
+
+// This function returns the name of the symbol at the specified index in the
+// symbol table of the program for the specified call frame.
+func symbolOf(frame *frame, index int) string {
+	var symbol = frame.program.GetSymbols().GetValue(index).GetEntity().(abs.SymbolLike)
+	return string(symbol.AsArray())
+}
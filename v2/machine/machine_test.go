@@ -0,0 +1,298 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package machine_test
+
+import (
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	cmp "github.com/bali-nebula/go-component-framework/v2/compiler"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	int_ "github.com/bali-nebula/go-component-framework/v2/interpreter"
+	mac "github.com/bali-nebula/go-component-framework/v2/machine"
+	str "github.com/bali-nebula/go-component-framework/v2/strings"
+	ass "github.com/stretchr/testify/assert"
+	tes "testing"
+)
+
+func compile(source string) abs.ProgramLike {
+	return cmp.Compile(bal.ParseComponent(source).ExtractProcedure())
+}
+
+func TestExecuteAssignments(t *tes.T) {
+	var program = compile(`{
+    let $list := [1, 2, 3]
+    let list[2] += 5
+    let $catalog := [$first: 1]
+    let catalog[$second] := list[2]
+    let catalog[$second] *= 2
+    let catalog[$first] ?= 100
+    return catalog
+}`)
+	// The literal table must not be changed by executing the program.
+	for count := 0; count < 2; count++ {
+		var result = mac.Execute(program, mac.Host())
+		ass.Equal(t, "[$first: 1, $second: 14]", bal.FormatCompactComponent(result))
+	}
+}
+
+func TestExecuteCollectionLiterals(t *tes.T) {
+	var types = []string{"Set", "Queue", "Stack"}
+	for _, type_ := range types {
+		var source = `[[1], 2]($type: /bali/types/collections/` + type_ + `/v1)`
+		var program = compile(`{
+    return ` + source + `
+}`)
+		// The literal table must not be changed by modifying the results.
+		for count := 0; count < 2; count++ {
+			var result = mac.Execute(program, mac.Host())
+			ass.Equal(t, bal.FormatComponent(bal.ParseComponent(source)), bal.FormatComponent(result))
+			var values = result.GetEntity().(abs.Sequential[abs.ComponentLike]).AsArray()
+			for _, value := range values {
+				var list, ok = value.GetEntity().(abs.ListLike)
+				if ok {
+					list.AddValue(bal.ParseComponent("3"))
+				}
+			}
+			result.GetEntity().(interface{ AddValue(abs.ComponentLike) }).AddValue(bal.ParseComponent("4"))
+		}
+	}
+}
+
+func TestExecuteControlFlow(t *tes.T) {
+	var program = compile(`{
+    let $sum := 0
+    let $index := 0
+    while index < 10 do {
+        let $index += 1
+        if index = 3 do {
+            continue loop
+        }
+        if index > 5 do {
+            break loop
+        }
+        let $sum += index
+    }
+    with each $item in [10, 20, 30] do {
+        let $sum += item
+    }
+    select sum matching 0 do {
+        return "zero"
+    } matching "[0-9]+"? do {
+        return sum
+    } matching any do {
+        return "unexpected"
+    }
+    return "unreachable"
+}`)
+	var result, err = mac.TryExecute(program, mac.Host())
+	ass.NoError(t, err)
+	ass.Equal(t, "72", bal.FormatComponent(result))
+}
+
+func TestExecuteScopes(t *tes.T) {
+	var procedure = bal.ParseComponent(`{
+    let $outer := 0
+    if true do {
+        let $outer := 1
+        let $inner := 2
+    }
+    with each $item in [1, 2] do {
+        let $outer += item
+    }
+    while outer < 10 do {
+        let $temporary := outer
+        let $outer += 1
+        if outer = 5 do {
+            break loop
+        }
+    }
+    if true do {
+        let $local := 3
+        throw $bad
+    } on $failure matching any do {
+        let $caught := failure
+    }
+    let $inner ?= "undefined"
+    let $item ?= "undefined"
+    let $temporary ?= "undefined"
+    let $local ?= "undefined"
+    let $failure ?= "undefined"
+    let $caught ?= "undefined"
+    let $result := [:]
+    let result[$outer] := outer
+    let result[$inner] := inner
+    let result[$item] := item
+    let result[$temporary] := temporary
+    let result[$local] := local
+    let result[$failure] := failure
+    let result[$caught] := caught
+    return result
+}`).ExtractProcedure()
+	// The virtual machine must scope the variables like the interpreter does.
+	var expected = `[$outer: 5, $inner: "undefined", $item: "undefined", $temporary: "undefined", $local: "undefined", $failure: "undefined", $caught: "undefined"]`
+	var result = int_.Execute(procedure, int_.Environment())
+	ass.Equal(t, expected, bal.FormatCompactComponent(result))
+	result = mac.Execute(cmp.Compile(procedure), mac.Host())
+	ass.Equal(t, expected, bal.FormatCompactComponent(result))
+}
+
+func TestExecuteHandlers(t *tes.T) {
+	var program = compile(`{
+    let $log := ""
+    if true do {
+        throw [
+            $type: $bad
+            $kind: "worse"
+        ]
+    } on $failure matching $worse do {
+        let $log := "worse"
+    } matching $bad do {
+        let $log := failure[$kind]
+    }
+    let $result := 1 + "one" on $problem matching any do {
+        let $log := log & " and invalid"
+    }
+    return log
+}`)
+	var result = mac.Execute(program, mac.Host())
+	ass.Equal(t, `"worse and invalid"`, bal.FormatComponent(result))
+}
+
+func TestExecuteErrors(t *tes.T) {
+	var program = compile(`{
+    let $count := 1
+    if count > 0 do {
+        throw $unexpected
+    } on $failure matching $expected do {
+        return count
+    }
+}`)
+	var _, err = mac.TryExecute(program, mac.Host())
+	var executionError, ok = err.(*mac.ExecutionError)
+	ass.True(t, ok)
+	ass.Equal(t, 10, executionError.Address)
+	ass.Equal(t, "$unexpected", bal.FormatComponent(executionError.Exception))
+	ass.Equal(t, "An unhandled exception was thrown: $unexpected", executionError.Message)
+
+	program = compile(`{
+    let $count := 1
+    let $count += missing
+}`)
+	_, err = mac.TryExecute(program, mac.Host())
+	executionError, ok = err.(*mac.ExecutionError)
+	ass.True(t, ok)
+	ass.Equal(t, 4, executionError.Address)
+	ass.Equal(t, "The variable missing is not defined.", executionError.Message)
+}
+
+func TestExecuteMessaging(t *tes.T) {
+	var program = compile(`{
+    post "Hello" to /acme/bag
+    post "World" to /acme/bag
+    retrieve $first from /acme/bag
+    accept first
+    retrieve $second from /acme/bag
+    reject second
+    retrieve $third from /acme/bag
+    publish third
+    accept third
+    retrieve $fourth from /acme/bag on $failure matching any do {
+        return first
+    }
+}`)
+	var host = mac.Host()
+	var result = mac.Execute(program, host)
+	ass.Equal(t, `"Hello"`, bal.FormatComponent(result))
+	var event = host.RetrieveMessage(bal.ParseComponent(mac.EventBag))
+	ass.Equal(t, `"World"`, bal.FormatComponent(event))
+	ass.Panics(t, func() {
+		host.RetrieveMessage(bal.ParseComponent(`/acme/bag`))
+	})
+}
+
+func TestExecuteRepository(t *tes.T) {
+	var program = compile(`{
+    notarize [$greeting: "Hello"] as /acme/document/v1
+    checkout $draft at level 2 from /acme/document/v1
+    let draft[$greeting] := "Hi"
+    save draft as $citation
+    discard draft
+    return @/acme/document/v1
+}`)
+	var host = mac.Host()
+	var result = mac.Execute(program, host)
+	ass.Equal(t, `[$greeting: "Hello"]`, bal.FormatComponent(result))
+
+	program = compile(`{
+    discard [$greeting: "Hi"]
+}`)
+	var _, err = mac.TryExecute(program, host)
+	ass.Error(t, err)
+}
+
+func TestExecuteMethods(t *tes.T) {
+	var methods = col.Catalog()
+	methods.SetValue(str.SymbolFromString("double"),
+		com.Component(compile(`{return target * 2}`).AsCatalog()))
+	methods.SetValue(str.SymbolFromString("fail"),
+		com.Component(compile(`{throw $failed}`).AsCatalog()))
+	var host = mac.HostWithMethods(methods)
+
+	var program = compile(`{
+    let $x := 21
+    return x <- double()
+}`)
+	var result = mac.Execute(program, host)
+	ass.Equal(t, "42", bal.FormatComponent(result))
+
+	program = compile(`{
+    let $x := 21
+    return x <- fail() on $error matching $failed do {
+        return "caught"
+    }
+}`)
+	result = mac.Execute(program, host)
+	ass.Equal(t, `"caught"`, bal.FormatComponent(result))
+
+	program = compile(`{
+    let $x := 21
+    return x.double()
+}`)
+	var _, err = mac.TryExecute(program, host)
+	ass.Error(t, err)
+}
+
+func TestExecuteOverflow(t *tes.T) {
+	var methods = col.Catalog()
+	methods.SetValue(str.SymbolFromString("recurse"),
+		com.Component(compile(`{return target <- recurse()}`).AsCatalog()))
+	var host = mac.HostWithMethods(methods)
+
+	var program = compile(`{
+    let $x := 1
+    return x <- recurse()
+}`)
+	var _, err = mac.TryExecute(program, host)
+	var executionError, ok = err.(*mac.ExecutionError)
+	ass.True(t, ok)
+	ass.Equal(t, "The call frame stack exceeded its capacity of 256.", executionError.Message)
+
+	// An overflow can be handled like any other failure.
+	program = compile(`{
+    let $x := 1
+    return x <- recurse() on $failure matching any do {
+        return "overflowed"
+    }
+}`)
+	var result = mac.Execute(program, host)
+	ass.Equal(t, `"overflowed"`, bal.FormatComponent(result))
+}
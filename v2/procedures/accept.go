@@ -28,6 +28,7 @@ func AcceptClause(message abs.Expression) abs.AcceptClauseLike {
 // clause.
 type acceptClause struct {
 	message abs.Expression
+	span    abs.SpanLike
 }
 
 // This method returns the message expression for this accept clause.
@@ -42,3 +43,13 @@ func (v *acceptClause) SetMessage(message abs.Expression) {
 	}
 	v.message = message
 }
+
+// This method returns the span of source code for this accept clause.
+func (v *acceptClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this accept clause.
+func (v *acceptClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -24,4 +24,15 @@ func BreakClause() abs.BreakClauseLike {
 
 // This type defines the structure and methods associated with a break clause.
 type breakClause struct {
+	span abs.SpanLike
+}
+
+// This method returns the span of source code for this break clause.
+func (v *breakClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this break clause.
+func (v *breakClause) SetSpan(span abs.SpanLike) {
+	v.span = span
 }
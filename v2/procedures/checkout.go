@@ -32,6 +32,7 @@ type checkoutClause struct {
 	recipient abs.Recipient
 	level     abs.Expression // The version level to be incremented (optional).
 	name      abs.Expression // A name to the citation for the document to be checked out.
+	span      abs.SpanLike
 }
 
 // This method returns the recipient for this checkout clause.
@@ -69,3 +70,13 @@ func (v *checkoutClause) SetName(name abs.Expression) {
 	}
 	v.name = name
 }
+
+// This method returns the span of source code for this checkout clause.
+func (v *checkoutClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this checkout clause.
+func (v *checkoutClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
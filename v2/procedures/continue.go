@@ -24,4 +24,15 @@ func ContinueClause() abs.ContinueClauseLike {
 
 // This type defines the structure and methods associated with a continue clause.
 type continueClause struct {
+	span abs.SpanLike
+}
+
+// This method returns the span of source code for this continue clause.
+func (v *continueClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this continue clause.
+func (v *continueClause) SetSpan(span abs.SpanLike) {
+	v.span = span
 }
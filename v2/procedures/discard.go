@@ -28,6 +28,7 @@ func DiscardClause(document abs.Expression) abs.DiscardClauseLike {
 // clause.
 type discardClause struct {
 	document abs.Expression
+	span     abs.SpanLike
 }
 
 // This method returns the document expression for this discard clause.
@@ -42,3 +43,13 @@ func (v *discardClause) SetDocument(document abs.Expression) {
 	}
 	v.document = document
 }
+
+// This method returns the span of source code for this discard clause.
+func (v *discardClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this discard clause.
+func (v *discardClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
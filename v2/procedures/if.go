@@ -27,6 +27,7 @@ func IfClause(block abs.BlockLike) abs.IfClauseLike {
 // This type defines the structure and methods associated with an if clause.
 type ifClause struct {
 	block abs.BlockLike
+	span  abs.SpanLike
 }
 
 // This method returns the block for this if clause.
@@ -41,3 +42,13 @@ func (v *ifClause) SetBlock(block abs.BlockLike) {
 	}
 	v.block = block
 }
+
+// This method returns the span of source code for this if clause.
+func (v *ifClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this if clause.
+func (v *ifClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -38,6 +38,7 @@ type letClause struct {
 	recipient  abs.Recipient
 	operator   abs.Operator
 	expression abs.Expression
+	span       abs.SpanLike
 }
 
 // This method determines whether or not this clause has a recipient.
@@ -73,3 +74,13 @@ func (v *letClause) SetExpression(expression abs.Expression) {
 	}
 	v.expression = expression
 }
+
+// This method returns the span of source code for this let clause.
+func (v *letClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this let clause.
+func (v *letClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -30,6 +30,7 @@ func NotarizeClause(document, name abs.Expression) abs.NotarizeClauseLike {
 type notarizeClause struct {
 	document abs.Expression
 	name     abs.Expression
+	span     abs.SpanLike
 }
 
 // This method returns the document expression for this notarize clause.
@@ -57,3 +58,13 @@ func (v *notarizeClause) SetName(name abs.Expression) {
 	}
 	v.name = name
 }
+
+// This method returns the span of source code for this notarize clause.
+func (v *notarizeClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this notarize clause.
+func (v *notarizeClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -30,6 +30,7 @@ func PostClause(message, bag abs.Expression) abs.PostClauseLike {
 type postClause struct {
 	message abs.Expression
 	bag     abs.Expression
+	span    abs.SpanLike
 }
 
 // This method returns the message expression for this post clause.
@@ -57,3 +58,13 @@ func (v *postClause) SetBag(bag abs.Expression) {
 	}
 	v.bag = bag
 }
+
+// This method returns the span of source code for this post clause.
+func (v *postClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this post clause.
+func (v *postClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
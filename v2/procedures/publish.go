@@ -28,6 +28,7 @@ func PublishClause(event abs.Expression) abs.PublishClauseLike {
 // clause.
 type publishClause struct {
 	event abs.Expression
+	span  abs.SpanLike
 }
 
 // This method returns the event expression for this publish clause.
@@ -42,3 +43,13 @@ func (v *publishClause) SetEvent(event abs.Expression) {
 	}
 	v.event = event
 }
+
+// This method returns the span of source code for this publish clause.
+func (v *publishClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this publish clause.
+func (v *publishClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
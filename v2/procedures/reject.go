@@ -28,6 +28,7 @@ func RejectClause(message abs.Expression) abs.RejectClauseLike {
 // clause.
 type rejectClause struct {
 	message abs.Expression
+	span    abs.SpanLike
 }
 
 // This method returns the message expression for this reject clause.
@@ -42,3 +43,13 @@ func (v *rejectClause) SetMessage(message abs.Expression) {
 	}
 	v.message = message
 }
+
+// This method returns the span of source code for this reject clause.
+func (v *rejectClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this reject clause.
+func (v *rejectClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
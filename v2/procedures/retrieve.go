@@ -30,6 +30,7 @@ func RetrieveClause(recipient abs.Recipient, bag abs.Expression) abs.RetrieveCla
 type retrieveClause struct {
 	recipient abs.Recipient
 	bag       abs.Expression
+	span      abs.SpanLike
 }
 
 // This method returns the recipient expression for this retrieve clause.
@@ -57,3 +58,13 @@ func (v *retrieveClause) SetBag(bag abs.Expression) {
 	}
 	v.bag = bag
 }
+
+// This method returns the span of source code for this retrieve clause.
+func (v *retrieveClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this retrieve clause.
+func (v *retrieveClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
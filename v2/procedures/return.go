@@ -28,6 +28,7 @@ func ReturnClause(result abs.Expression) abs.ReturnClauseLike {
 // clause.
 type returnClause struct {
 	result abs.Expression
+	span   abs.SpanLike
 }
 
 // This method returns the result expression for this return clause.
@@ -42,3 +43,13 @@ func (v *returnClause) SetResult(result abs.Expression) {
 	}
 	v.result = result
 }
+
+// This method returns the span of source code for this return clause.
+func (v *returnClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this return clause.
+func (v *returnClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -30,6 +30,7 @@ func SaveClause(document abs.Expression, recipient abs.Recipient) abs.SaveClause
 type saveClause struct {
 	document  abs.Expression
 	recipient abs.Recipient
+	span      abs.SpanLike
 }
 
 // This method returns the document expression for this save clause.
@@ -57,3 +58,13 @@ func (v *saveClause) SetRecipient(recipient abs.Recipient) {
 	}
 	v.recipient = recipient
 }
+
+// This method returns the span of source code for this save clause.
+func (v *saveClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this save clause.
+func (v *saveClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -29,6 +29,7 @@ func SelectClause(target abs.Expression, blocks abs.Sequential[abs.BlockLike]) a
 type selectClause struct {
 	target abs.Expression
 	blocks abs.Sequential[abs.BlockLike]
+	span   abs.SpanLike
 }
 
 // This method returns the target expression for this select clause.
@@ -56,3 +57,13 @@ func (v *selectClause) SetBlocks(blocks abs.Sequential[abs.BlockLike]) {
 	}
 	v.blocks = blocks
 }
+
+// This method returns the span of source code for this select clause.
+func (v *selectClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this select clause.
+func (v *selectClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
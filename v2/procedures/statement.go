@@ -39,6 +39,7 @@ type statement struct {
 	mainClause abs.Clause
 	onClause   abs.OnClauseLike
 	note       abs.NoteLike
+	span       abs.SpanLike
 }
 
 // This method returns the annotation for this statement.
@@ -80,3 +81,13 @@ func (v *statement) GetOnClause() abs.OnClauseLike {
 func (v *statement) SetOnClause(onClause abs.OnClauseLike) {
 	v.onClause = onClause
 }
+
+// This method returns the span of source code for this statement.
+func (v *statement) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this statement.
+func (v *statement) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -28,6 +28,7 @@ func ThrowClause(exception abs.Expression) abs.ThrowClauseLike {
 // clause.
 type throwClause struct {
 	exception abs.Expression
+	span      abs.SpanLike
 }
 
 // This method returns the exception expression for this throw clause.
@@ -42,3 +43,13 @@ func (v *throwClause) SetException(exception abs.Expression) {
 	}
 	v.exception = exception
 }
+
+// This method returns the span of source code for this throw clause.
+func (v *throwClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this throw clause.
+func (v *throwClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
@@ -27,6 +27,7 @@ func WhileClause(block abs.BlockLike) abs.WhileClauseLike {
 // This type defines the structure and methods associated with a while clause.
 type whileClause struct {
 	block abs.BlockLike
+	span  abs.SpanLike
 }
 
 // This method returns the block for this while clause.
@@ -41,3 +42,13 @@ func (v *whileClause) SetBlock(block abs.BlockLike) {
 	}
 	v.block = block
 }
+
+// This method returns the span of source code for this while clause.
+func (v *whileClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this while clause.
+func (v *whileClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
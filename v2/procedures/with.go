@@ -29,6 +29,7 @@ func WithClause(item abs.SymbolLike, block abs.BlockLike) abs.WithClauseLike {
 type withClause struct {
 	item  abs.SymbolLike
 	block abs.BlockLike
+	span  abs.SpanLike
 }
 
 // This method returns the symbol for the item for this with clause.
@@ -56,3 +57,13 @@ func (v *withClause) SetBlock(block abs.BlockLike) {
 	}
 	v.block = block
 }
+
+// This method returns the span of source code for this with clause.
+func (v *withClause) GetSpan() abs.SpanLike {
+	return v.span
+}
+
+// This method sets the span of source code for this with clause.
+func (v *withClause) SetSpan(span abs.SpanLike) {
+	v.span = span
+}
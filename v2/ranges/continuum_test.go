@@ -19,22 +19,22 @@ import (
 )
 
 func TestContinuaWithAngles(t *tes.T) {
-	var s = ran.Continuum(ele.AngleFromFloat(0), abs.LEFT, ele.Pi)
-	ass.True(t, s.ContainsValue(ele.AngleFromFloat(0)))
-	ass.False(t, s.ContainsValue(ele.Pi))
-	ass.True(t, s.ContainsValue(ele.AngleFromFloat(2)))
-	ass.False(t, s.ContainsValue(ele.AngleFromFloat(4)))
-	ass.Equal(t, ele.AngleFromFloat(0), s.GetFirst())
+	var s = ran.Continuum(ele.Angle().FromFloat(0), abs.LEFT, ele.Angle().Pi())
+	ass.True(t, s.ContainsValue(ele.Angle().FromFloat(0)))
+	ass.False(t, s.ContainsValue(ele.Angle().Pi()))
+	ass.True(t, s.ContainsValue(ele.Angle().FromFloat(2)))
+	ass.False(t, s.ContainsValue(ele.Angle().FromFloat(4)))
+	ass.Equal(t, ele.Angle().FromFloat(0), s.GetFirst())
 	ass.Equal(t, abs.LEFT, s.GetExtent())
-	ass.Equal(t, ele.Pi, s.GetLast())
+	ass.Equal(t, ele.Angle().Pi(), s.GetLast())
 }
 
 func TestContinuaWithPercentages(t *tes.T) {
-	var pMinus30 = ele.PercentageFromInt(-30)
-	var pMinus25 = ele.PercentageFromInt(-25)
-	var p100 = ele.PercentageFromInt(100)
-	var p50 = ele.PercentageFromInt(50)
-	var p150 = ele.PercentageFromInt(150)
+	var pMinus30 = ele.Percentage().FromInt(-30)
+	var pMinus25 = ele.Percentage().FromInt(-25)
+	var p100 = ele.Percentage().FromInt(100)
+	var p50 = ele.Percentage().FromInt(50)
+	var p150 = ele.Percentage().FromInt(150)
 	var s = ran.Continuum(pMinus25, abs.INCLUSIVE, p100)
 	ass.True(t, s.ContainsValue(pMinus25))
 	ass.True(t, s.ContainsValue(p100))
@@ -47,9 +47,9 @@ func TestContinuaWithPercentages(t *tes.T) {
 }
 
 func TestContinuaWithProbabilities(t *tes.T) {
-	var p0 = ele.ProbabilityFromFloat(0)
-	var pHalf = ele.ProbabilityFromFloat(0.5)
-	var p1 = ele.ProbabilityFromFloat(1)
+	var p0 = ele.Probability().FromFloat(0)
+	var pHalf = ele.Probability().FromFloat(0.5)
+	var p1 = ele.Probability().FromFloat(1)
 	var s = ran.Continuum(p0, abs.RIGHT, p1)
 	ass.False(t, s.ContainsValue(p0))
 	ass.True(t, s.ContainsValue(p1))
@@ -60,9 +60,9 @@ func TestContinuaWithProbabilities(t *tes.T) {
 }
 
 func TestContinuaWithFloats(t *tes.T) {
-	var minimum = ele.MinimumFloat()
-	var zero = ele.FloatFromFloat(0)
-	var maximum = ele.MaximumFloat()
+	var minimum = ele.Float().MinimumValue()
+	var zero = ele.Float().FromFloat(0)
+	var maximum = ele.Float().MaximumValue()
 	var s = ran.Continuum(minimum, abs.EXCLUSIVE, maximum)
 	ass.True(t, s.ContainsValue(zero))
 	ass.Equal(t, minimum, s.GetFirst())
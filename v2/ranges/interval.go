@@ -249,13 +249,13 @@ func (v *interval) indexToValue(index int) abs.Discrete {
 	var discrete abs.Discrete = v.first
 	switch discrete.(type) {
 	case abs.DurationLike:
-		discrete = ele.DurationFromMilliseconds(index)
+		discrete = ele.Duration().FromMilliseconds(index)
 	case abs.MomentLike:
-		discrete = ele.MomentFromMilliseconds(index)
+		discrete = ele.Moment().FromMilliseconds(index)
 	case abs.IntegerLike:
-		discrete = ele.IntegerFromInteger(index)
+		discrete = ele.Integer().FromInteger(index)
 	case abs.CharacterLike:
-		discrete = ele.CharacterFromInteger(index)
+		discrete = ele.Character().FromInteger(index)
 	default:
 		var message = fmt.Sprintf("The discrete type was not found: %T", discrete)
 		panic(message)
@@ -20,13 +20,13 @@ import (
 )
 
 func TestIntervalsWithDurations(t *tes.T) {
-	var two = ele.DurationFromMilliseconds(2)
-	var three = ele.DurationFromMilliseconds(3)
-	var four = ele.DurationFromMilliseconds(4)
-	var five = ele.DurationFromMilliseconds(5)
-	var six = ele.DurationFromMilliseconds(6)
-	var seven = ele.DurationFromMilliseconds(7)
-	var eight = ele.DurationFromMilliseconds(8)
+	var two = ele.Duration().FromMilliseconds(2)
+	var three = ele.Duration().FromMilliseconds(3)
+	var four = ele.Duration().FromMilliseconds(4)
+	var five = ele.Duration().FromMilliseconds(5)
+	var six = ele.Duration().FromMilliseconds(6)
+	var seven = ele.Duration().FromMilliseconds(7)
+	var eight = ele.Duration().FromMilliseconds(8)
 	var s = ran.Interval(three, abs.INCLUSIVE, seven)
 	ass.False(t, s.IsEmpty())
 	ass.Equal(t, 5, s.GetSize())
@@ -58,13 +58,13 @@ func TestIntervalsWithDurations(t *tes.T) {
 }
 
 func TestIntervalsWithMoments(t *tes.T) {
-	var two = ele.MomentFromMilliseconds(2)
-	var three = ele.MomentFromMilliseconds(3)
-	var four = ele.MomentFromMilliseconds(4)
-	var five = ele.MomentFromMilliseconds(5)
-	var six = ele.MomentFromMilliseconds(6)
-	var seven = ele.MomentFromMilliseconds(7)
-	var eight = ele.MomentFromMilliseconds(8)
+	var two = ele.Moment().FromMilliseconds(2)
+	var three = ele.Moment().FromMilliseconds(3)
+	var four = ele.Moment().FromMilliseconds(4)
+	var five = ele.Moment().FromMilliseconds(5)
+	var six = ele.Moment().FromMilliseconds(6)
+	var seven = ele.Moment().FromMilliseconds(7)
+	var eight = ele.Moment().FromMilliseconds(8)
 	var s = ran.Interval(three, abs.RIGHT, seven)
 	ass.False(t, s.IsEmpty())
 	ass.Equal(t, 4, s.GetSize())
@@ -91,9 +91,9 @@ func TestIntervalsWithMoments(t *tes.T) {
 }
 
 func TestIntervalsWithEmojis(t *tes.T) {
-	var r1 = ele.CharacterFromRune('😀')
-	var r2 = ele.CharacterFromRune('😆')
-	var r3 = ele.CharacterFromRune('🤣')
+	var r1 = ele.Character().FromRune('😀')
+	var r2 = ele.Character().FromRune('😆')
+	var r3 = ele.Character().FromRune('🤣')
 	var s = ran.Interval(r1, abs.INCLUSIVE, r3)
 	ass.False(t, s.IsEmpty())
 	ass.Equal(t, 804, s.GetSize())
@@ -106,14 +106,14 @@ func TestIntervalsWithEmojis(t *tes.T) {
 }
 
 func TestIntervalsWithCharacters(t *tes.T) {
-	var rA = ele.CharacterFromRune('A')
-	var ra = ele.CharacterFromRune('a')
-	var rb = ele.CharacterFromRune('b')
-	var rc = ele.CharacterFromRune('c')
-	var rd = ele.CharacterFromRune('d')
-	var re = ele.CharacterFromRune('e')
-	var rf = ele.CharacterFromRune('f')
-	var rg = ele.CharacterFromRune('g')
+	var rA = ele.Character().FromRune('A')
+	var ra = ele.Character().FromRune('a')
+	var rb = ele.Character().FromRune('b')
+	var rc = ele.Character().FromRune('c')
+	var rd = ele.Character().FromRune('d')
+	var re = ele.Character().FromRune('e')
+	var rf = ele.Character().FromRune('f')
+	var rg = ele.Character().FromRune('g')
 	var s = ran.Interval(ra, abs.LEFT, rf)
 	ass.False(t, s.IsEmpty())
 	ass.Equal(t, 5, s.GetSize())
@@ -133,12 +133,12 @@ func TestIntervalsWithCharacters(t *tes.T) {
 }
 
 func TestIntervalsWithIntegers(t *tes.T) {
-	var i1 = ele.IntegerFromInteger(1)
-	var i2 = ele.IntegerFromInteger(2)
-	var i3 = ele.IntegerFromInteger(3)
-	var i4 = ele.IntegerFromInteger(4)
-	var i5 = ele.IntegerFromInteger(5)
-	var i6 = ele.IntegerFromInteger(6)
+	var i1 = ele.Integer().FromInteger(1)
+	var i2 = ele.Integer().FromInteger(2)
+	var i3 = ele.Integer().FromInteger(3)
+	var i4 = ele.Integer().FromInteger(4)
+	var i5 = ele.Integer().FromInteger(5)
+	var i6 = ele.Integer().FromInteger(6)
 	var s = ran.Interval(i1, abs.RIGHT, i5)
 	ass.False(t, s.IsEmpty())
 	ass.Equal(t, 4, s.GetSize())
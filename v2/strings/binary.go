@@ -56,22 +56,10 @@ type binary_ string
 
 // LEXICAL INTERFACE
 
-// This method returns a string value for this lexical string.
+// This method returns a string value for this lexical string. The string
+// contains the base 64 encoded bytes without any delimiters or whitespace.
 func (v binary_) AsString() string {
-	var indentation = "    "
-	var encoded = string(v)
-	var width = 60
-	var length = len(encoded)
-	var string_ = "'>\n"
-	if length > 0 {
-		var index int
-		for index = 0; index+width < length; index += width {
-			string_ += indentation + encoded[index:index+width]
-		}
-		string_ += indentation + encoded[index:] + "\n"
-	}
-	string_ += "<'"
-	return string_
+	return string(v)
 }
 
 // SEQUENTIAL INTERFACE
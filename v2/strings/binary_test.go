@@ -20,7 +20,7 @@ func TestEmptyBinary(t *tes.T) {
 	var binary = `'>
 <'`
 	var v = str.BinaryFromString(binary)
-	ass.Equal(t, binary, v.AsString())
+	ass.Equal(t, "", v.AsString())
 	ass.True(t, v.IsEmpty())
 	ass.Equal(t, 0, v.GetSize())
 }
@@ -33,13 +33,13 @@ func TestBinary(t *tes.T) {
     abcd
 <'`
 	var v = str.BinaryFromString(b1)
-	ass.Equal(t, b1, v.AsString())
+	ass.Equal(t, "abcd1234", v.AsString())
 	ass.False(t, v.IsEmpty())
 	ass.Equal(t, 6, v.GetSize())
 	ass.Equal(t, byte(0x69), v.GetValue(1))
 	ass.Equal(t, byte(0xf8), v.GetValue(-1))
 	ass.Equal(t, v.AsArray(), str.BinaryFromArray(v.AsArray()).AsArray())
-	ass.Equal(t, b2, str.BinaryFromSequence(v.GetValues(1, 3)).AsString())
+	ass.Equal(t, str.BinaryFromString(b2), str.BinaryFromSequence(v.GetValues(1, 3)))
 }
 
 func TestBinaryLibrary(t *tes.T) {
@@ -54,7 +54,7 @@ func TestBinaryLibrary(t *tes.T) {
 <'`
 	var v1 = str.BinaryFromString(b1)
 	var v2 = str.BinaryFromString(b2)
-	ass.Equal(t, b3, str.Binary.Concatenate(v1, v2).AsString())
+	ass.Equal(t, str.BinaryFromString(b3), str.Binary.Concatenate(v1, v2))
 
 	v1 = str.BinaryFromArray([]byte{0x00, 0x01, 0x02, 0x03, 0x04})
 	v2 = str.BinaryFromArray([]byte{0x03, 0x00, 0x01, 0x02})
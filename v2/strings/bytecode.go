@@ -27,8 +27,8 @@ func BytecodeFromString(string_ string) abs.BytecodeLike {
 		var message = fmt.Sprintf("Attempted to construct a bytecode string from an invalid string: %v", string_)
 		panic(message)
 	}
-	var encoded = matches[1]                    // Strip off the "'" delimiters.
-	encoded = sts.ReplaceAll(encoded, " ", "")  // Remove all spaces.
+	var encoded = matches[1]                   // Strip off the "'" delimiters.
+	encoded = sts.ReplaceAll(encoded, " ", "") // Remove all spaces.
 	return bytecode_(encoded)
 }
 
@@ -68,7 +68,7 @@ type bytecode_ string
 
 // This method returns a string value for this lexical string.
 func (v bytecode_) AsString() string {
-	return "'" + string(v) + "'"
+	return string(v)
 }
 
 // SEQUENTIAL INTERFACE
@@ -19,7 +19,7 @@ import (
 func TestEmptyBytecode(t *tes.T) {
 	var bytecode = `''`
 	var v = str.BytecodeFromString(bytecode)
-	ass.Equal(t, bytecode, v.AsString())
+	ass.Equal(t, "", v.AsString())
 	ass.True(t, v.IsEmpty())
 	ass.Equal(t, 0, v.GetSize())
 }
@@ -27,7 +27,7 @@ func TestEmptyBytecode(t *tes.T) {
 func TestBytecode(t *tes.T) {
 	var bytecode = `'abcd1234'`
 	var v = str.BytecodeFromString(bytecode)
-	ass.Equal(t, bytecode, v.AsString())
+	ass.Equal(t, "abcd1234", v.AsString())
 	ass.False(t, v.IsEmpty())
 	ass.Equal(t, 2, v.GetSize())
 	ass.Equal(t, v.AsArray(), str.BytecodeFromArray(v.AsArray()).AsArray())
@@ -110,7 +110,7 @@ func (v name_) GetValues(first int, last int) abs.Sequential[abs.Identifier] {
 
 // This singleton creates a unique name space for the library functions for
 // name strings.
-var Name = &names_{}
+var Names = &names_{}
 
 // This type defines an empty structure and the group of methods bound to it
 // that define the library functions for name strings.
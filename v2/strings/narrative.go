@@ -11,9 +11,7 @@
 package strings
 
 import (
-	fmt "fmt"
 	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
-	uti "github.com/bali-nebula/go-component-framework/v2/utilities"
 	col "github.com/craterdog/go-collection-framework/v2"
 	sts "strings"
 )
@@ -21,29 +19,23 @@ import (
 // NARRATIVE STRING INTERFACE
 
 // This constructor creates a new narrative string from the specified string.
+// The string contains the lines of the narrative without its delimiters and
+// without any indentation.
 func NarrativeFromString(string_ string) abs.NarrativeLike {
-	var matches = uti.NarrativeMatcher.FindStringSubmatch(string_)
-	if len(matches) == 0 {
-		var message = fmt.Sprintf("Attempted to construct a narrative string from an invalid string: %v", string_)
-		panic(message)
-	}
-	var narrative = matches[1] // Strip off the ">\n and <" delimiters.
-	// TODO: Remove indentation from all lines.
-	return narrative_(narrative)
+	return narrative_(string_)
 }
 
 // This constructor attempts to create a new narrative string from the specified
-// array of runes. It returns the corresponding narrative string.
+// array of lines. It returns the corresponding narrative string.
 func NarrativeFromArray(array []abs.Line) abs.NarrativeLike {
 	var builder sts.Builder
-	var indentation = "    "
 	var lines = col.Array[abs.Line](array)
 	var iterator = col.Iterator[abs.Line](lines)
 	for iterator.HasNext() {
-		var line = string(iterator.GetNext())
-		builder.WriteString(indentation)
-		builder.WriteString(line)
-		builder.WriteString("\n")
+		builder.WriteString(string(iterator.GetNext()))
+		if iterator.HasNext() {
+			builder.WriteString("\n")
+		}
 	}
 	var narrative = narrative_(builder.String())
 	return narrative
@@ -118,7 +110,7 @@ func (v narrative_) GetValues(first int, last int) abs.Sequential[abs.Line] {
 
 // This singleton creates a unique name space for the library functions for
 // narrative strings.
-var Narrative = &narratives_{}
+var Narratives = &narratives_{}
 
 // This type defines an empty structure and the group of methods bound to it
 // that define the library functions for narrative strings.
@@ -116,13 +116,13 @@ func (v Quote) GetValues(first int, last int) abs.Sequential[rune] {
 
 // This singleton creates a unique name space for the library functions for
 // quote strings.
-var Quote = &quotes_{}
+var Quotes = &quotes_{}
 
 // This type defines an empty structure and the group of methods bound to it
 // that define the library functions for quote strings.
 type quotes_ struct{}
 
 // This function returns the concatenation of the two specified quote strings.
-func (l *quotes) Concatenate(first, second abs.QuoteLike) abs.QuoteLike {
+func (l *quotes_) Concatenate(first, second abs.QuoteLike) abs.QuoteLike {
 	return Quote(first.AsString() + second.AsString())
 }
@@ -21,6 +21,8 @@ import (
 // These constants are used to define a regular expression for matching
 // symbols.
 const (
+	letter     = `\pL` // All unicode letters and connectors like underscores.
+	digit      = `\pN` // All unicode digits.
 	identifier = letter + `(?:` + letter + `|` + digit + `)*`
 	symbol     = `(` + identifier + `(?:-` + ordinal + `)?)`
 )
@@ -126,14 +126,14 @@ func (v Version) GetValues(first int, last int) abs.Sequential[abs.Ordinal] {
 
 // This singleton creates a unique name space for the library functions for
 // version strings.
-var Version = &versions_{}
+var Versions = &versions_{}
 
 // This type defines an empty structure and the group of methods bound to it
 // that define the library functions for version strings.
 type versions_ struct{}
 
 // This function returns the concatenation of the two specified version strings.
-func (l *versions) Concatenate(first, second abs.VersionLike) abs.VersionLike {
+func (l *versions_) Concatenate(first, second abs.VersionLike) abs.VersionLike {
 	var version = first.AsString() + "." + second.AsString()
 	return Version(version)
 }
@@ -150,7 +150,7 @@ func (l *versions) Concatenate(first, second abs.VersionLike) abs.VersionLike {
 // version string being incremented. A level that is greater than the size of
 // current version will result in a new level with the value of `1` being
 // appended to the copy of the current version string.
-func (l *versions) GetNextVersion(current abs.VersionLike, level abs.Ordinal) abs.VersionLike {
+func (l *versions_) GetNextVersion(current abs.VersionLike, level abs.Ordinal) abs.VersionLike {
 	// Adjust the size of the ordinals as needed.
 	var ordinals = current.AsArray()
 	var size = abs.Ordinal(len(ordinals))
@@ -186,7 +186,7 @@ func (l *versions) GetNextVersion(current abs.VersionLike, level abs.Ordinal) ab
 //	level 1:    v5.7              v6         (interface/symantic changes)
 //	level 2:    v5.7              v5.8       (optimization/bug fixes)
 //	level 3:    v5.7              v5.7.1     (changes being tested)
-func (l *versions) IsValidNextVersion(current, next abs.VersionLike) bool {
+func (l *versions_) IsValidNextVersion(current, next abs.VersionLike) bool {
 	// Make sure the version sizes are compatible.
 	var currentOrdinals = current.AsArray()
 	var currentSize = len(currentOrdinals)
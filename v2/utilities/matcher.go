@@ -56,7 +56,7 @@ var (
 // of the token types.
 const (
 	angle       = `~(` + magnitude + `|` + zero + `)`
-	authority   = `[^/` + control + `]+`
+	authority   = `[^/>` + control + `]+`
 	base16      = `[0-9a-f]`
 	base32      = `[0-9A-DF-HJ-NP-TV-Z]` // "E", "I", "O", and "U" have been removed.
 	base64      = `[A-Za-z0-9+/]`
@@ -69,7 +69,7 @@ const (
 	complex_    = `\((?:` + rectangular + `|` + polar + `)\)`
 	control     = `\a\f\n\r\t\v`
 	dates       = years + `?` + months + `?` + days + `?`
-	day         = `(?:[012][1-9])|(?:[3][01])`
+	day         = `(?:[0][1-9])|(?:[12][0-9])|(?:[3][01])`
 	days        = `(` + span + `D)`
 	delimiter   = `≠|~|\}|\||\{|\^|\]|\[|@|\?=|>|=|<-|<|;|:=|:|/=|//|/|\.\.|\.|-=|-|,|\+=|\+|\*=|\*|\)|\(|&`
 	digit       = `\pN` // All unicode digits.
@@ -99,10 +99,10 @@ const (
 		`(?:T(` + hour + `)(?::(` + minute + `)(?::((?:` + second + `)(?:` + fraction + `)?))?)?)?)?)?>`
 	month       = `(?:[0][1-9])|(?:[1][012])`
 	months      = `(` + span + `M)`
-	name        = `(?:/` + identifier + `)+` // Cannot capture each identifier...
+	name        = `(?:/` + identifier + `)*/(?:v` + ordinal + `(?:\.` + ordinal + `)+|` + identifier + `)` // Cannot capture each identifier...
 	narrative   = `">` + eol + `((?:.|` + eol + `)*` + eol + `)` + space + `*<"`
 	note        = `! [^` + control + `]*`
-	number      = imaginary + `|` + real_ + `|` + complex_ + `|` + zero + `|` + infinity + `|` + undefined
+	number      = imaginary + `|` + real_ + `|` + complex_ + `|` + zero + `|` + sign + `?(?:` + infinity + `)|` + undefined
 	ordinal     = `[1-9][0-9]*`
 	path        = `[^?#>` + control + `]*`
 	pattern     = `none` + `|` + regex + `|` + `any`
@@ -0,0 +1,173 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package xml
+
+import (
+	_ "embed"
+	enc "encoding/xml"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	sts "strings"
+)
+
+// FORMATTER INTERFACE
+
+// This constant defines the XML namespace of the elements in a BDN document
+// that has been mapped to XML.
+const Namespace = "https://github.com/bali-nebula/go-component-framework/v2/xml"
+
+// This variable contains the XML schema (XSD) that defines the mapping from BDN
+// components to XML elements.
+//
+//go:embed bdn.xsd
+var Schema string
+
+// This function returns the XML string for the specified component. The
+// component is mapped to a "component" element as follows:
+//
+//   - The "type" attribute names the type of its entity (e.g. "moment").
+//   - A catalog contains an "entry" element for each association. The key of
+//     the association is held in a "symbol", "name" or "key" attribute.
+//   - A list, set, queue or stack contains a "component" element for each of
+//     its values.
+//   - The lines of a narrative are held in a "lines" element as CDATA.
+//   - Any other entity whose canonical BDN string spans several lines (e.g. a
+//     procedure) is held in a "source" element as CDATA.
+//   - Any other entity is held in a "value" attribute as its canonical BDN
+//     string.
+//   - The parameters of its context are held in a nested "parameters" element
+//     and its note is held in a "note" element.
+func FormatComponent(component abs.ComponentLike) string {
+	var root = mapComponent(component)
+	root.Namespace = Namespace
+	var bytes, err = enc.MarshalIndent(root, "", "    ")
+	if err != nil {
+		panic(err)
+	}
+	return string(bytes)
+}
+
+// This function returns the XML bytes for the specified component including
+// the XML declaration and the POSIX standard trailing EOL.
+func FormatDocument(component abs.ComponentLike) []byte {
+	var s = enc.Header + FormatComponent(component) + bal.EOL
+	return []byte(s)
+}
+
+// FORMATTER IMPLEMENTATION
+
+// This type defines the XML mapping of a component.
+type element struct {
+	XMLName    enc.Name    `xml:"component"`
+	Namespace  string      `xml:"xmlns,attr,omitempty"`
+	Type       string      `xml:"type,attr"`
+	Value      string      `xml:"value,attr,omitempty"`
+	Lines      *cdata      `xml:"lines"`
+	Source     *cdata      `xml:"source"`
+	Entries    []entry     `xml:"entry"`
+	Values     []element   `xml:"component"`
+	Parameters *parameters `xml:"parameters"`
+	Note       *string     `xml:"note"`
+}
+
+// This type defines the XML mapping of text that is held as CDATA.
+type cdata struct {
+	Text string `xml:",cdata"`
+}
+
+// This type defines the XML mapping of an association in a catalog.
+type entry struct {
+	Symbol string  `xml:"symbol,attr,omitempty"`
+	Name   string  `xml:"name,attr,omitempty"`
+	Key    string  `xml:"key,attr,omitempty"`
+	Value  element `xml:"component"`
+}
+
+// This type defines the XML mapping of the parameters in a context.
+type parameters struct {
+	Parameters []parameter `xml:"parameter"`
+}
+
+// This type defines the XML mapping of a parameter in a context.
+type parameter struct {
+	Symbol string  `xml:"symbol,attr"`
+	Value  element `xml:"component"`
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the XML mapping of the specified component.
+func mapComponent(component abs.ComponentLike) element {
+	var entity = component.GetEntity()
+	var result = element{Type: typeOf(entity)}
+	switch value := entity.(type) {
+	case abs.ValuesLike:
+		var iterator = com.ComponentIterator(value)
+		for iterator.HasNext() {
+			result.Values = append(result.Values, mapComponent(iterator.GetNext()))
+		}
+	case abs.AssociationsLike:
+		var iterator = col.AssociationIterator(value)
+		for iterator.HasNext() {
+			result.Entries = append(result.Entries, mapAssociation(iterator.GetNext()))
+		}
+	case abs.NarrativeLike:
+		result.Lines = &cdata{value.AsString()}
+	default:
+		var source = bal.FormatEntity(value)
+		if sts.Contains(source, bal.EOL) {
+			result.Source = &cdata{source}
+		} else {
+			result.Value = source
+		}
+	}
+	if component.IsParameterized() {
+		result.Parameters = &parameters{}
+		var iterator = com.ParameterIterator(component.GetContext())
+		for iterator.HasNext() {
+			var binding = iterator.GetNext()
+			result.Parameters.Parameters = append(result.Parameters.Parameters, parameter{
+				Symbol: bal.FormatEntity(binding.GetKey()),
+				Value:  mapComponent(binding.GetValue()),
+			})
+		}
+	}
+	if component.IsAnnotated() {
+		var note = string(component.GetNote().AsArray())
+		result.Note = &note
+	}
+	return result
+}
+
+// This function returns the XML mapping of the specified association.
+func mapAssociation(association abs.AssociationLike) entry {
+	var key = association.GetKey()
+	var result = entry{Value: mapComponent(association.GetValue())}
+	// A key (e.g. a quote) may support every method of a symbol or name so its
+	// actual type must be determined.
+	switch typeOf(key) {
+	case "symbol":
+		result.Symbol = bal.FormatEntity(key)
+	case "name":
+		result.Name = bal.FormatEntity(key)
+	default:
+		result.Key = bal.FormatEntity(key)
+	}
+	return result
+}
+
+// This function returns the name of the XML element for the type of the
+// specified entity (e.g. "moment").
+func typeOf(entity abs.Entity) string {
+	return sts.ToLower(bal.GetType(entity))
+}
@@ -0,0 +1,187 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package xml
+
+import (
+	enc "encoding/xml"
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	sts "strings"
+)
+
+// PARSER INTERFACE
+
+// This function parses the specified XML document and returns the
+// corresponding component. The document must use the mapping described by
+// FormatComponent and defined by the Schema. This function panics if the
+// document is not a valid mapping of a component.
+func ParseDocument(document []byte) abs.ComponentLike {
+	var component, err = TryParseDocument(document)
+	if err != nil {
+		panic(err)
+	}
+	return component
+}
+
+// This function parses an XML source string rather than the bytes from an XML
+// document file.
+func ParseComponent(source string) abs.ComponentLike {
+	return ParseDocument([]byte(source))
+}
+
+// This function parses the specified XML document like ParseDocument but
+// returns any error rather than panicking. It is useful when parsing documents
+// from untrusted sources.
+func TryParseDocument(document []byte) (abs.ComponentLike, error) {
+	var root element
+	var err = enc.Unmarshal(document, &root)
+	if err != nil {
+		return nil, err
+	}
+	return parseElement(root)
+}
+
+// PARSER IMPLEMENTATION
+
+// This function returns the component corresponding to the specified XML
+// mapping.
+func parseElement(mapping element) (abs.ComponentLike, error) {
+	var err error
+	var entity abs.Entity
+	switch mapping.Type {
+	case "catalog":
+		entity, err = parseEntries(mapping.Entries)
+	case "list", "queue", "set", "stack":
+		entity, err = parseValues(mapping.Type, mapping.Values)
+	case "narrative":
+		if mapping.Lines == nil {
+			return nil, fmt.Errorf("A narrative component is missing its lines.")
+		}
+		entity, err = parseEntity(mapping.Type, narrativeSource(mapping.Lines.Text))
+	default:
+		var source = mapping.Value
+		if mapping.Source != nil {
+			source = mapping.Source.Text
+		}
+		entity, err = parseEntity(mapping.Type, source)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var context abs.ContextLike
+	if mapping.Parameters != nil {
+		context, err = parseParameters(mapping.Parameters.Parameters)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var component = com.ComponentWithContext(entity, context)
+	if mapping.Note != nil {
+		component.SetNote(com.Note(*mapping.Note))
+	}
+	return component, nil
+}
+
+// This function returns the catalog corresponding to the specified XML
+// entries.
+func parseEntries(entries []entry) (abs.CatalogLike, error) {
+	var catalog = col.Catalog()
+	for _, mapping := range entries {
+		var source = mapping.Symbol + mapping.Name + mapping.Key
+		var key, err = bal.TryParseEntity(source)
+		if err != nil {
+			return nil, err
+		}
+		var value abs.ComponentLike
+		value, err = parseElement(mapping.Value)
+		if err != nil {
+			return nil, err
+		}
+		catalog.SetValue(key, value)
+	}
+	return catalog, nil
+}
+
+// This function parses the specified BDN source string and returns the
+// corresponding entity if it has the specified type.
+func parseEntity(type_ string, source string) (abs.Entity, error) {
+	var entity, err = bal.TryParseEntity(source)
+	if err != nil {
+		return nil, err
+	}
+	var actual = typeOf(entity)
+	if actual != type_ {
+		return nil, fmt.Errorf("Expected a %v but found a %v: %v", type_, actual, source)
+	}
+	return entity, nil
+}
+
+// This function returns the context corresponding to the specified XML
+// parameters.
+func parseParameters(parameters []parameter) (abs.ContextLike, error) {
+	var context = com.Context()
+	for _, mapping := range parameters {
+		var entity, err = parseEntity("symbol", mapping.Symbol)
+		if err != nil {
+			return nil, err
+		}
+		var value abs.ComponentLike
+		value, err = parseElement(mapping.Value)
+		if err != nil {
+			return nil, err
+		}
+		context.SetValue(entity.(abs.SymbolLike), value)
+	}
+	return context, nil
+}
+
+// This function returns the collection of the specified type corresponding to
+// the specified XML values.
+func parseValues(type_ string, values []element) (abs.Entity, error) {
+	var list = col.List()
+	for _, mapping := range values {
+		var value, err = parseElement(mapping)
+		if err != nil {
+			return nil, err
+		}
+		list.AddValue(value)
+	}
+	var collection abs.Entity
+	switch type_ {
+	case "queue":
+		collection = col.QueueFromSequence(list)
+	case "set":
+		collection = col.SetFromSequence(list)
+	case "stack":
+		collection = col.StackFromSequence(list)
+	default:
+		collection = list
+	}
+	return collection, nil
+}
+
+// This function returns the canonical BDN source string for a narrative
+// containing the specified lines.
+func narrativeSource(lines string) string {
+	var builder sts.Builder
+	builder.WriteString(`">`)
+	for _, line := range sts.Split(lines, bal.EOL) {
+		builder.WriteString(bal.EOL)
+		if len(line) > 0 {
+			builder.WriteString("    " + line)
+		}
+	}
+	builder.WriteString(bal.EOL + `<"`)
+	return builder.String()
+}
@@ -0,0 +1,222 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package xml_test
+
+import (
+	enc "encoding/xml"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	xml "github.com/bali-nebula/go-component-framework/v2/xml"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	exe "os/exec"
+	fil "path/filepath"
+	reg "regexp"
+	sts "strings"
+	tes "testing"
+)
+
+const testDirectory = "../bali/test/"
+
+func TestXMLRoundtrips(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ../bali/test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var expected, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(expected)
+			var document = xml.FormatDocument(component)
+			component = xml.ParseDocument(document)
+			ass.Equal(t, string(expected), string(bal.FormatDocument(component)), filename)
+			ass.Equal(t, string(document), string(xml.FormatDocument(component)), filename)
+		}
+	}
+}
+
+func TestXMLMapping(t *tes.T) {
+	var source = `[
+    $moment: <2024-03-31T12:30:15>
+    /bali/names/Example: ">
+        First line
+          with ]]> in it.
+    <"
+    5: [
+        ~π
+        true
+    ]($type: /bali/types/collections/Set/v1)  ! A small set.
+]`
+	var expected = `<component xmlns="https://github.com/bali-nebula/go-component-framework/v2/xml" type="catalog">
+    <entry symbol="$moment">
+        <component type="moment" value="&lt;2024-03-31T12:30:15&gt;"></component>
+    </entry>
+    <entry name="/bali/names/Example">
+        <component type="narrative">
+            <lines><![CDATA[First line
+  with ]]]]><![CDATA[> in it.]]></lines>
+        </component>
+    </entry>
+    <entry key="5">
+        <component type="set">
+            <component type="angle" value="~π"></component>
+            <component type="boolean" value="true"></component>
+            <parameters>
+                <parameter symbol="$type">
+                    <component type="name" value="/bali/types/collections/Set/v1"></component>
+                </parameter>
+            </parameters>
+            <note>A small set.</note>
+        </component>
+    </entry>
+</component>`
+	var component = bal.ParseComponent(source)
+	var document = xml.FormatComponent(component)
+	ass.Equal(t, expected, document)
+	component = xml.ParseComponent(document)
+	ass.Equal(t, source, bal.FormatComponent(component))
+	ass.True(t, sts.Contains(xml.Schema, `targetNamespace="`+xml.Namespace+`"`))
+}
+
+func TestXMLSchema(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ../bali/test directory.")
+	}
+
+	var validate = schemaValidator(t)
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var source, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(source)
+			validate(filename, xml.FormatDocument(component))
+		}
+	}
+
+	// These keys satisfy more than one key type or contain a version.
+	var component = bal.ParseComponent(`[
+    "foo": 1
+    /bali/types/Example/v1.2: 2
+    $type-2: 3
+]`)
+	var document = xml.FormatDocument(component)
+	ass.Contains(t, string(document), `<entry key="&#34;foo&#34;">`)
+	ass.Contains(t, string(document), `<entry name="/bali/types/Example/v1.2">`)
+	ass.Contains(t, string(document), `<entry symbol="$type-2">`)
+	validate("keys", document)
+}
+
+// This type defines the parts of an XML schema that constrain the values of
+// attributes.
+type schema struct {
+	SimpleTypes []struct {
+		Name         string  `xml:"name,attr"`
+		Patterns     []value `xml:"restriction>pattern"`
+		Enumerations []value `xml:"restriction>enumeration"`
+	} `xml:"simpleType"`
+	ComplexTypes []struct {
+		Attributes []struct {
+			Name string `xml:"name,attr"`
+			Type string `xml:"type,attr"`
+		} `xml:"attribute"`
+	} `xml:"complexType"`
+}
+
+type value struct {
+	Value string `xml:"value,attr"`
+}
+
+// This function returns a function that validates an XML document against the
+// Schema. The value of each attribute is checked against the pattern or the
+// enumeration of its simple type. If xmllint is installed the whole document is
+// validated by it as well.
+func schemaValidator(t *tes.T) func(filename string, document []byte) {
+	var definitions schema
+	var err = enc.Unmarshal([]byte(xml.Schema), &definitions)
+	if err != nil {
+		panic(err)
+	}
+	var checks = map[string]func(string) bool{}
+	for _, simpleType := range definitions.SimpleTypes {
+		var patterns []*reg.Regexp
+		for _, pattern := range simpleType.Patterns {
+			patterns = append(patterns, reg.MustCompile(`^(?:`+pattern.Value+`)$`))
+		}
+		var enumerations = simpleType.Enumerations
+		checks["bdn:"+simpleType.Name] = func(attribute string) bool {
+			for _, pattern := range patterns {
+				if !pattern.MatchString(attribute) {
+					return false
+				}
+			}
+			for _, enumeration := range enumerations {
+				if enumeration.Value == attribute {
+					return true
+				}
+			}
+			return len(enumerations) == 0
+		}
+	}
+	var types = map[string]string{}
+	for _, complexType := range definitions.ComplexTypes {
+		for _, attribute := range complexType.Attributes {
+			types[attribute.Name] = attribute.Type
+		}
+	}
+
+	var lint, _ = exe.LookPath("xmllint")
+	var directory = t.TempDir()
+	var path = fil.Join(directory, "bdn.xsd")
+	osx.WriteFile(path, []byte(xml.Schema), 0644)
+	return func(filename string, document []byte) {
+		var decoder = enc.NewDecoder(sts.NewReader(string(document)))
+		for {
+			var token, err = decoder.Token()
+			if err != nil {
+				break
+			}
+			var element, ok = token.(enc.StartElement)
+			if !ok {
+				continue
+			}
+			for _, attribute := range element.Attr {
+				var check, ok = checks[types[attribute.Name.Local]]
+				if ok {
+					ass.True(t, check(attribute.Value), filename+": "+attribute.Value)
+				}
+			}
+		}
+		if len(lint) > 0 {
+			var command = exe.Command(lint, "--noout", "--schema", path, "-")
+			command.Stdin = sts.NewReader(string(document))
+			var output, err = command.CombinedOutput()
+			ass.NoError(t, err, filename+": "+string(output))
+		}
+	}
+}
+
+func TestXMLErrors(t *tes.T) {
+	var documents = []string{
+		`<component type="number" value="none"/>`,
+		`<component type="narrative"/>`,
+		`<component type="catalog"><entry><component type="number" value="1"/></entry></component>`,
+		`<component type="list"><parameters><parameter symbol="type"/></parameters></component>`,
+		`<component type="list">`,
+		`<component type="moment" value="&lt;2024-02-30&gt;"/>`,
+		`<component type="moment" value="&lt;2024-02-31&gt;"/>`,
+	}
+	for _, document := range documents {
+		var _, err = xml.TryParseDocument([]byte(document))
+		ass.Error(t, err, document)
+	}
+}
@@ -0,0 +1,239 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package yaml
+
+import (
+	b64 "encoding/base64"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	yam "gopkg.in/yaml.v3"
+	stc "strconv"
+	sts "strings"
+)
+
+// FORMATTER INTERFACE
+
+// This constant defines the YAML tag for a scalar containing the canonical BDN
+// string for a component that has no YAML equivalent.
+const Tag = "!bali"
+
+// This function returns the YAML bytes for the specified component. The
+// component is mapped to YAML as follows:
+//
+//   - A catalog is mapped to a mapping whose symbol keys are plain identifiers.
+//   - A list is mapped to a sequence.
+//   - The pattern none is mapped to a YAML null.
+//   - A boolean or number is mapped to a YAML boolean, integer or float.
+//   - A moment, duration or resource is mapped to a plain scalar containing
+//     its ISO 8601 date, ISO 8601 duration or URL.
+//   - A quote is mapped to a string and a binary string to a binary scalar.
+//   - Any other component (e.g. a set, a range, a procedure or a component
+//     with a context) is mapped to a scalar tagged "!bali" containing its
+//     canonical BDN string.
+//   - The note for a component is mapped to a comment.
+//
+// Each scalar is checked to make sure that ParseDocument maps it back to the
+// same component, and is tagged "!bali" otherwise.
+func FormatDocument(component abs.ComponentLike) []byte {
+	var document = &yam.Node{Kind: yam.DocumentNode}
+	var node = formatComponent(component)
+	if node.Kind != yam.ScalarNode && component.IsAnnotated() {
+		document.HeadComment = formatComment(component.GetNote())
+	}
+	document.Content = []*yam.Node{node}
+	var bytes, err = yam.Marshal(document)
+	if err != nil {
+		panic(err)
+	}
+	return bytes
+}
+
+// This function loads the BDN document using the specified configurator and
+// returns the corresponding YAML document.
+func ExportConfiguration(configurator abs.ConfiguratorLike) []byte {
+	var component = bal.ParseDocument(configurator.Load())
+	return FormatDocument(component)
+}
+
+// FORMATTER IMPLEMENTATION
+
+// This function returns the YAML node for the specified component. The note
+// for a scalar component is added to the node as a line comment. The note for
+// a collection is left to the caller since where it goes depends on the parent
+// node.
+func formatComponent(component abs.ComponentLike) *yam.Node {
+	var node *yam.Node
+	var entity = component.GetEntity()
+	switch value := entity.(type) {
+	case abs.QueueLike, abs.SetLike, abs.StackLike:
+		// YAML has only one sequence type.
+		node = formatTagged(component)
+	case abs.ValuesLike:
+		node = formatSequence(value)
+	case abs.AssociationsLike:
+		node = formatMapping(value)
+	default:
+		node = formatScalar(bal.FormatEntity(value), false)
+	}
+	if component.IsParameterized() {
+		// YAML has no way to attach a context to a node.
+		node = formatTagged(component)
+	}
+	if node.Kind == yam.ScalarNode && component.IsAnnotated() {
+		node.LineComment = formatComment(component.GetNote())
+	}
+	return node
+}
+
+// This function returns the YAML mapping node for the specified catalog
+// associations. The note for each collection value is added as a comment on
+// its key.
+func formatMapping(associations abs.AssociationsLike) *yam.Node {
+	var node = &yam.Node{Kind: yam.MappingNode}
+	if associations.IsEmpty() {
+		node.Style = yam.FlowStyle
+	}
+	var iterator = col.AssociationIterator(associations)
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var key = formatScalar(bal.FormatEntity(association.GetKey()), true)
+		var component = association.GetValue()
+		var value = formatComponent(component)
+		if value.Kind != yam.ScalarNode && component.IsAnnotated() {
+			var comment = formatComment(component.GetNote())
+			if value.Style == yam.FlowStyle {
+				// An empty collection remains on the same line as its key.
+				value.LineComment = comment
+			} else {
+				key.LineComment = comment
+			}
+		}
+		node.Content = append(node.Content, key, value)
+	}
+	return node
+}
+
+// This function returns the YAML scalar node for the specified canonical BDN
+// string. The scalar is tagged "!bali" unless it maps back to the same BDN
+// string. A key scalar that would otherwise be mapped to a symbol is quoted.
+func formatScalar(bdn string, isKey bool) *yam.Node {
+	var node = &yam.Node{Kind: yam.ScalarNode}
+	switch {
+	case sts.HasPrefix(bdn, "$") && identifier.MatchString(bdn[1:]) && isKey:
+		node.Value = bdn[1:] // A symbol.
+	case sts.HasPrefix(bdn, `"`) && !sts.HasPrefix(bdn, `">`):
+		var value, err = stc.Unquote(bdn) // A quote.
+		if err != nil {
+			return formatTag(bdn)
+		}
+		node.Tag = "!!str"
+		node.Value = value
+		if inferEntity(value) != nil || (isKey && identifier.MatchString(value)) {
+			node.Style = yam.DoubleQuotedStyle
+		}
+	case sts.HasPrefix(bdn, "'"):
+		var binary, ok = bal.ParseEntity(bdn).(abs.BinaryLike) // A binary string.
+		if !ok {
+			return formatTag(bdn)
+		}
+		node.Tag = "!!binary"
+		node.Value = b64.StdEncoding.EncodeToString(binary.AsArray())
+	case sts.HasPrefix(bdn, "<") && sts.HasSuffix(bdn, ">"):
+		node.Value = bdn[1 : len(bdn)-1] // A moment or resource.
+	case sts.HasPrefix(bdn, "~P") || sts.HasPrefix(bdn, "~-P"):
+		node.Value = bdn[1:] // A duration.
+	case bdn == "none":
+		node.Value = "null" // The pattern that matches nothing.
+	default:
+		node.Value = bdn // A boolean, number or other element.
+	}
+	if !isRoundtrip(node, bdn, isKey) {
+		return formatTag(bdn)
+	}
+	return node
+}
+
+// This function returns the YAML sequence node for the specified collection
+// values. The note for each collection value is added as a head comment.
+func formatSequence(values abs.ValuesLike) *yam.Node {
+	var node = &yam.Node{Kind: yam.SequenceNode}
+	if values.IsEmpty() {
+		node.Style = yam.FlowStyle
+	}
+	var iterator = com.ComponentIterator(values)
+	for iterator.HasNext() {
+		var component = iterator.GetNext()
+		var value = formatComponent(component)
+		if value.Kind != yam.ScalarNode && component.IsAnnotated() {
+			value.HeadComment = formatComment(component.GetNote())
+		}
+		node.Content = append(node.Content, value)
+	}
+	return node
+}
+
+// This function returns a YAML scalar node tagged "!bali" containing the
+// canonical BDN string for the specified component without its note.
+func formatTagged(component abs.ComponentLike) *yam.Node {
+	var entity = component.GetEntity()
+	var context = component.GetContext()
+	var copy_ = com.ComponentWithContext(entity, context)
+	return formatTag(bal.FormatComponent(copy_))
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the YAML comment for the specified note.
+func formatComment(note abs.NoteLike) string {
+	return "# " + string(note.AsArray())
+}
+
+// This function returns a YAML scalar node tagged "!bali" containing the
+// specified BDN string. A multiline BDN string uses the literal style so that
+// it remains readable.
+func formatTag(bdn string) *yam.Node {
+	var node = &yam.Node{Kind: yam.ScalarNode, Tag: Tag, Value: bdn}
+	if sts.Contains(bdn, bal.EOL) {
+		node.Style = yam.LiteralStyle
+	}
+	return node
+}
+
+// This function determines whether or not the specified YAML scalar node is
+// parsed back into the component with the specified canonical BDN string.
+func isRoundtrip(node *yam.Node, bdn string, isKey bool) bool {
+	var bytes, err = yam.Marshal(node)
+	if err != nil {
+		return false
+	}
+	var root yam.Node
+	err = yam.Unmarshal(bytes, &root)
+	if err != nil || len(root.Content) != 1 {
+		return false
+	}
+	var result string
+	if isKey {
+		var key abs.Primitive
+		key, err = parseKey(root.Content[0])
+		if err == nil {
+			result = bal.FormatEntity(key)
+		}
+	} else {
+		var component abs.ComponentLike
+		component, err = parseScalar(root.Content[0])
+		if err == nil {
+			result = bal.FormatComponent(component)
+		}
+	}
+	return err == nil && result == bdn
+}
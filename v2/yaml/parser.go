@@ -0,0 +1,316 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package yaml
+
+import (
+	b64 "encoding/base64"
+	fmt "fmt"
+	abs "github.com/bali-nebula/go-component-framework/v2/abstractions"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	col "github.com/bali-nebula/go-component-framework/v2/collections"
+	com "github.com/bali-nebula/go-component-framework/v2/components"
+	yam "gopkg.in/yaml.v3"
+	big "math/big"
+	reg "regexp"
+	stc "strconv"
+	sts "strings"
+	tim "time"
+	uni "unicode"
+)
+
+// PARSER INTERFACE
+
+// This function parses the specified YAML document and returns the
+// corresponding component. Each YAML mapping becomes a catalog, each sequence
+// becomes a list and each scalar becomes the element or string that it is
+// inferred to be:
+//
+//   - A YAML null (e.g. "~" or an empty value) becomes the pattern none.
+//   - A YAML boolean, integer or float becomes a boolean or number.
+//   - A plain scalar containing an ISO 8601 date or time becomes a moment. A
+//     YAML timestamp (e.g. "2024-03-31 12:30:15" or "2024-03-31T12:30:15Z")
+//     becomes the moment for the same instant in UTC.
+//   - A plain scalar containing an ISO 8601 duration (e.g. "PT30S") becomes a
+//     duration.
+//   - A plain scalar containing a URL (e.g. "https://bali-nebula.net") becomes
+//     a resource.
+//   - A binary scalar becomes a binary string.
+//   - A scalar tagged "!bali" becomes the component described by its BDN.
+//   - Any other scalar becomes a quote.
+//
+// A mapping key that is a plain identifier becomes a symbol and each comment
+// on a value becomes the note for that value. This function panics if the
+// document cannot be mapped to a component.
+func ParseDocument(document []byte) abs.ComponentLike {
+	var component, err = TryParseDocument(document)
+	if err != nil {
+		panic(err)
+	}
+	return component
+}
+
+// This function parses the specified YAML document like ParseDocument but
+// returns any error rather than panicking.
+func TryParseDocument(document []byte) (abs.ComponentLike, error) {
+	var root yam.Node
+	var err = yam.Unmarshal(document, &root)
+	if err != nil {
+		return nil, err
+	}
+	if root.Kind != yam.DocumentNode || len(root.Content) != 1 {
+		return nil, fmt.Errorf("The YAML document is empty.")
+	}
+	return parseComponent(root.Content[0], root.HeadComment)
+}
+
+// This function converts the specified YAML document into a BDN document and
+// stores it using the specified configurator. It panics if the document cannot
+// be mapped to a component.
+func ImportConfiguration(configurator abs.ConfiguratorLike, document []byte) {
+	var component = ParseDocument(document)
+	configurator.Store(bal.FormatDocument(component))
+}
+
+// PARSER IMPLEMENTATION
+
+// This constant defines the regular expression for a mapping key that is
+// converted into a symbol.
+var identifier = reg.MustCompile(`^\pL[\pL\pN]*$`)
+
+// This function returns the component for the specified YAML node. The
+// specified comment is used as the note for a collection if the collection
+// itself has no comment.
+func parseComponent(node *yam.Node, comment string) (abs.ComponentLike, error) {
+	if node.Kind == yam.AliasNode {
+		node = node.Alias
+	}
+	var err error
+	var component abs.ComponentLike
+	switch node.Kind {
+	case yam.MappingNode:
+		var catalog abs.CatalogLike
+		catalog, err = parseMapping(node)
+		if err == nil {
+			component = com.Component(catalog)
+		}
+	case yam.SequenceNode:
+		var list abs.ListLike
+		list, err = parseSequence(node)
+		if err == nil {
+			component = com.Component(list)
+		}
+	case yam.ScalarNode:
+		component, err = parseScalar(node)
+		comment = node.LineComment
+	default:
+		err = fmt.Errorf("An unexpected YAML node was found at line %v.", node.Line)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if node.Kind != yam.ScalarNode {
+		// An empty collection is formatted inline so it may have a line comment.
+		if len(node.HeadComment) > 0 {
+			comment = node.HeadComment
+		} else if len(node.LineComment) > 0 {
+			comment = node.LineComment
+		}
+	}
+	if len(comment) > 0 {
+		component.SetNote(com.Note(noteFrom(comment)))
+	}
+	return component, nil
+}
+
+// This function returns the catalog key for the specified YAML node.
+func parseKey(node *yam.Node) (abs.Primitive, error) {
+	if node.Kind == yam.AliasNode {
+		node = node.Alias
+	}
+	if node.Kind != yam.ScalarNode {
+		return nil, fmt.Errorf("The key at line %v is not a YAML scalar.", node.Line)
+	}
+	if node.ShortTag() == "!!str" && node.Style == 0 && identifier.MatchString(node.Value) {
+		return bal.Symbol("$" + node.Value), nil
+	}
+	var component, err = parseScalar(node)
+	if err != nil {
+		return nil, err
+	}
+	var key, ok = component.GetEntity().(abs.Primitive)
+	if !ok || component.IsParameterized() {
+		return nil, fmt.Errorf("The key at line %v is not a primitive value: %v", node.Line, node.Value)
+	}
+	return key, nil
+}
+
+// This function returns the catalog for the specified YAML mapping node. The
+// comment on each key is used as the note for its value if the value has no
+// comment of its own.
+func parseMapping(node *yam.Node) (abs.CatalogLike, error) {
+	var catalog = col.Catalog()
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		var keyNode = node.Content[index]
+		var key, err = parseKey(keyNode)
+		if err != nil {
+			return nil, err
+		}
+		var comment = keyNode.LineComment
+		if len(comment) == 0 {
+			comment = keyNode.HeadComment
+		}
+		var valueNode = node.Content[index+1]
+		var value abs.ComponentLike
+		value, err = parseComponent(valueNode, comment)
+		if err != nil {
+			return nil, err
+		}
+		if valueNode.Kind == yam.ScalarNode && !value.IsAnnotated() && len(comment) > 0 {
+			value.SetNote(com.Note(noteFrom(comment)))
+		}
+		catalog.SetValue(key, value)
+	}
+	return catalog, nil
+}
+
+// This function returns the component for the specified YAML scalar node.
+func parseScalar(node *yam.Node) (abs.ComponentLike, error) {
+	var value = node.Value
+	var isPlain = node.Style&(yam.DoubleQuotedStyle|yam.SingleQuotedStyle|yam.LiteralStyle|yam.FoldedStyle) == 0
+	switch node.ShortTag() {
+	case Tag:
+		return bal.TryParseComponent(sts.TrimRight(value, bal.EOL))
+	case "!!null":
+		return com.Component(bal.Pattern("none")), nil
+	case "!!bool":
+		var boolean bool
+		var err = node.Decode(&boolean)
+		if err != nil {
+			return nil, err
+		}
+		return com.Component(bal.Boolean(boolean)), nil
+	case "!!int":
+		var integer, ok = new(big.Int).SetString(value, 0)
+		if !ok {
+			return nil, fmt.Errorf("The integer %v at line %v is not valid.", value, node.Line)
+		}
+		return parseInteger(integer, node)
+	case "!!float":
+		var integer, ok = new(big.Int).SetString(value, 0)
+		if ok {
+			// An integer too large for YAML to resolve as an integer.
+			return parseInteger(integer, node)
+		}
+		var number float64
+		var err = node.Decode(&number)
+		if err != nil {
+			return nil, err
+		}
+		return com.Component(bal.Number(number)), nil
+	case "!!binary":
+		var bytes, err = b64.StdEncoding.DecodeString(sts.Map(removeSpace, value))
+		if err != nil {
+			return nil, err
+		}
+		return com.Component(bal.Binary(bytes)), nil
+	case "!!timestamp":
+		var moment tim.Time
+		var err = node.Decode(&moment)
+		if err != nil {
+			return nil, err
+		}
+		return com.Component(bal.Moment(int(moment.UnixMilli()))), nil
+	case "!!str":
+		if isPlain {
+			var entity = inferEntity(value)
+			if entity != nil {
+				return com.Component(entity), nil
+			}
+		}
+		// Parse the quoted string so that any EOL characters are allowed.
+		var entity, err = bal.TryParseEntity(stc.Quote(value))
+		if err != nil {
+			return nil, err
+		}
+		return com.Component(entity), nil
+	default:
+		return nil, fmt.Errorf("The YAML tag %v at line %v is not supported.", node.Tag, node.Line)
+	}
+}
+
+// This function returns the number for the specified integer from the specified
+// YAML scalar node. A number is a float so only some integers can be
+// represented exactly, and any other integer is an error.
+func parseInteger(integer *big.Int, node *yam.Node) (abs.ComponentLike, error) {
+	var number, accuracy = new(big.Float).SetInt(integer).Float64()
+	if accuracy != big.Exact {
+		return nil, fmt.Errorf("The integer %v at line %v cannot be represented exactly as a number.", node.Value, node.Line)
+	}
+	return com.Component(bal.Number(number)), nil
+}
+
+// This function returns the list for the specified YAML sequence node.
+func parseSequence(node *yam.Node) (abs.ListLike, error) {
+	var list = col.List()
+	for _, item := range node.Content {
+		var value, err = parseComponent(item, "")
+		if err != nil {
+			return nil, err
+		}
+		list.AddValue(value)
+	}
+	return list, nil
+}
+
+// PRIVATE FUNCTIONS
+
+// This function returns the moment, duration or resource that the specified
+// plain scalar value contains, or nil if it contains none of them.
+func inferEntity(value string) abs.Entity {
+	var source string
+	switch {
+	case sts.Contains(value, "://"):
+		source = "<" + value + ">" // A URL.
+	case sts.HasPrefix(value, "P") || sts.HasPrefix(value, "-P"):
+		source = "~" + value // An ISO 8601 duration.
+	case len(value) > 0 && (uni.IsDigit(rune(value[0])) || value[0] == '-' || value[0] == '+'):
+		source = "<" + value + ">" // An ISO 8601 date or time.
+	default:
+		return nil
+	}
+	var entity, err = bal.TryParseEntity(source)
+	if err != nil {
+		return nil
+	}
+	return entity
+}
+
+// This function returns the note for the specified YAML comment with the "#"
+// characters removed and its lines joined into a single line.
+func noteFrom(comment string) string {
+	var words []string
+	for _, line := range sts.Split(comment, "\n") {
+		line = sts.TrimSpace(sts.TrimPrefix(sts.TrimSpace(line), "#"))
+		if len(line) > 0 {
+			words = append(words, line)
+		}
+	}
+	return sts.Join(words, " ")
+}
+
+// This function removes the specified rune if it is whitespace. It is used
+// with strings.Map() to remove the line breaks from base 64 encoded scalars.
+func removeSpace(r rune) rune {
+	if uni.IsSpace(r) {
+		return -1
+	}
+	return r
+}
@@ -0,0 +1,187 @@
+/*******************************************************************************
+ *   Copyright (c) 2009-2023 Crater Dog Technologies™.  All Rights Reserved.   *
+ *******************************************************************************
+ * DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               *
+ *                                                                             *
+ * This code is free software; you can redistribute it and/or modify it under  *
+ * the terms of The MIT License (MIT), as published by the Open Source         *
+ * Initiative. (See http://opensource.org/licenses/MIT)                        *
+ *******************************************************************************/
+
+package yaml_test
+
+import (
+	age "github.com/bali-nebula/go-component-framework/v2/agents"
+	bal "github.com/bali-nebula/go-component-framework/v2/bali"
+	yml "github.com/bali-nebula/go-component-framework/v2/yaml"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	sts "strings"
+	tes "testing"
+)
+
+const testDirectory = "../bali/test/"
+
+func TestYAMLRoundtrips(t *tes.T) {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the ../bali/test directory.")
+	}
+
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if sts.HasSuffix(filename, ".bali") {
+			var expected, _ = osx.ReadFile(filename)
+			var component = bal.ParseDocument(expected)
+			var document = yml.FormatDocument(component)
+			component = yml.ParseDocument(document)
+			ass.Equal(t, string(expected), string(bal.FormatDocument(component)), filename)
+			ass.Equal(t, string(document), string(yml.FormatDocument(component)), filename)
+		}
+	}
+}
+
+func TestYAMLImport(t *tes.T) {
+	var document = `# The server settings.
+
+enabled: true
+port: 8080
+ratio: 1.5
+started: 2024-03-31
+timeout: PT30S  # How long to wait.
+home: https://bali-nebula.net
+greeting: Hello World!
+literal: "PT30S"
+"quoted key": 5
+tags:
+    - first
+    - 2024-03-31T12:30:15
+`
+	var expected = bal.ParseComponent(`[
+    $enabled: true
+    $port: 8080
+    $ratio: 1.5
+    $started: <2024-03-31>
+    $timeout: ~PT30S  ! How long to wait.
+    $home: <https://bali-nebula.net>
+    $greeting: "Hello World!"
+    $literal: "PT30S"
+    "quoted key": 5
+    $tags: [
+        "first"
+        <2024-03-31T12:30:15>
+    ]
+]  ! The server settings.`)
+	var component = yml.ParseDocument([]byte(document))
+	ass.Equal(t, bal.FormatComponent(expected), bal.FormatComponent(component))
+}
+
+func TestYAMLExport(t *tes.T) {
+	var component = bal.ParseComponent(`[
+    $name: "Alice"  ! The name.
+    $timeout: ~PT30S
+    $angle: ~π
+    $values: [
+        1
+        2
+    ]($type: /bali/types/collections/Set/v1)
+    $check: {
+        return true
+    }
+    $empty: [:]  ! Nothing yet.
+]`)
+	var document = string(yml.FormatDocument(component))
+	ass.Contains(t, document, "name: Alice # The name.\n")
+	ass.Contains(t, document, "timeout: PT30S\n")
+	ass.Contains(t, document, "angle: !bali ~π\n")
+	ass.Contains(t, document, "values: !bali |-\n")
+	ass.Contains(t, document, "check: !bali |-\n")
+	ass.Contains(t, document, "empty: {} # Nothing yet.\n")
+	var result = yml.ParseDocument([]byte(document))
+	ass.Equal(t, bal.FormatComponent(component), bal.FormatComponent(result))
+}
+
+func TestYAMLConfiguration(t *tes.T) {
+	var directory, _ = osx.MkdirTemp("", "yaml")
+	defer osx.RemoveAll(directory)
+	var configurator = age.Configurator(directory, "test.bali")
+	yml.ImportConfiguration(configurator, []byte("count: 5\n"))
+	ass.Equal(t, "[$count: 5]\n", string(configurator.Load()))
+	ass.Equal(t, "count: 5\n", string(yml.ExportConfiguration(configurator)))
+}
+
+func TestYAMLIntegers(t *tes.T) {
+	var document = `decimal: 42
+hexadecimal: 0x1F
+octal: 0o17
+large: 9007199254740992
+huge: 18446744073709551616
+`
+	var component = yml.ParseDocument([]byte(document))
+	ass.Equal(t, `[
+    $decimal: 42
+    $hexadecimal: 31
+    $octal: 15
+    $large: 9.007199254740992E+15
+    $huge: 1.8446744073709552E+19
+]`, bal.FormatComponent(component))
+}
+
+func TestYAMLNulls(t *tes.T) {
+	var document = `tilde: ~
+word: null
+empty:
+`
+	var component = yml.ParseDocument([]byte(document))
+	ass.Equal(t, `[
+    $tilde: none
+    $word: none
+    $empty: none
+]`, bal.FormatComponent(component))
+	ass.Equal(t, "value: null\n", string(yml.FormatDocument(bal.ParseComponent(`[$value: none]`))))
+}
+
+func TestYAMLTimestamps(t *tes.T) {
+	var document = `local: 2024-03-31T12:30:15
+spaced: 2024-03-31 12:30:15
+zoned: 2024-03-31T12:30:15Z
+offset: 2024-03-31T14:30:15+02:00
+date: 2024-03-31
+`
+	var component = yml.ParseDocument([]byte(document))
+	ass.Equal(t, `[
+    $local: <2024-03-31T12:30:15>
+    $spaced: <2024-03-31T12:30:15>
+    $zoned: <2024-03-31T12:30:15>
+    $offset: <2024-03-31T12:30:15>
+    $date: <2024-03-31>
+]`, bal.FormatComponent(component))
+}
+
+func TestYAMLErrors(t *tes.T) {
+	var documents = []string{
+		``,
+		`value: !custom 5`,
+		`? [1, 2]
+: 3`,
+		`value: !bali "[1"`,
+		`[1`,
+		`value: 9007199254740993`,
+		`value: 123456789012345678901234567890`,
+		`value: !!int five`,
+		`value: !bali <2024-02-30>`,
+		`value: !bali <2024-02-31>`,
+	}
+	for _, document := range documents {
+		var _, err = yml.TryParseDocument([]byte(document))
+		ass.Error(t, err, document)
+	}
+
+	// A plain scalar that is not a valid date is a quote.
+	var component, err = yml.TryParseDocument([]byte("a: 2024-02-30\nb: 2024-02-31\n"))
+	ass.NoError(t, err)
+	ass.Equal(t, `[
+    $a: "2024-02-30"
+    $b: "2024-02-31"
+]`, bal.FormatComponent(component))
+}
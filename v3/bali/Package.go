@@ -28,7 +28,9 @@ on interfaces, not on each other.
 package bali
 
 import (
+	ctx "context"
 	col "github.com/craterdog/go-collection-framework/v3/collection"
+	io "io"
 )
 
 // Types
@@ -76,7 +78,10 @@ concrete acceptclause-like class.
 */
 type AcceptClauseClassLike interface {
 	// Constructors
-	MakeWithMessage(message MessageLike) AcceptClauseLike
+	MakeWithAttributes(
+		message MessageLike,
+		span SpanLike,
+	) AcceptClauseLike
 }
 
 /*
@@ -119,7 +124,11 @@ concrete arithmetic-like class.
 */
 type ArithmeticClassLike interface {
 	// Constructors
-	MakeWithExpressions(expressions col.ListLike[ExpressionLike]) ArithmeticLike
+	MakeWithAttributes(
+		expressions col.ListLike[ExpressionLike],
+		operator string,
+		span SpanLike,
+	) ArithmeticLike
 }
 
 /*
@@ -142,6 +151,8 @@ type AssociationClassLike interface {
 	MakeWithAttributes(
 		key KeyLike,
 		value ValueLike,
+		note string,
+		span SpanLike,
 	) AssociationLike
 }
 
@@ -153,10 +164,6 @@ concrete associations-like class.
 type AssociationsClassLike interface {
 	// Constructors
 	MakeWithAssociations(associations col.ListLike[AssociationLike]) AssociationsLike
-	MakeWithAttributes(
-		association AssociationLike,
-		note string,
-	) AssociationsLike
 }
 
 /*
@@ -169,6 +176,7 @@ type AttributeClassLike interface {
 	MakeWithAttributes(
 		variable VariableLike,
 		indices IndicesLike,
+		span SpanLike,
 	) AttributeLike
 }
 
@@ -189,7 +197,7 @@ concrete breakclause-like class.
 */
 type BreakClauseClassLike interface {
 	// Constructors
-	Make() BreakClauseLike
+	MakeWithSpan(span SpanLike) BreakClauseLike
 }
 
 /*
@@ -199,7 +207,10 @@ concrete chaining-like class.
 */
 type ChainingClassLike interface {
 	// Constructors
-	MakeWithExpressions(expressions col.ListLike[ExpressionLike]) ChainingLike
+	MakeWithAttributes(
+		expressions col.ListLike[ExpressionLike],
+		span SpanLike,
+	) ChainingLike
 }
 
 /*
@@ -213,6 +224,7 @@ type CheckoutClauseClassLike interface {
 		recipient RecipientLike,
 		level LevelLike,
 		citation CitationLike,
+		span SpanLike,
 	) CheckoutClauseLike
 }
 
@@ -246,7 +258,11 @@ concrete comparison-like class.
 */
 type ComparisonClassLike interface {
 	// Constructors
-	MakeWithExpressions(expressions col.ListLike[ExpressionLike]) ComparisonLike
+	MakeWithAttributes(
+		expressions col.ListLike[ExpressionLike],
+		operator string,
+		span SpanLike,
+	) ComparisonLike
 }
 
 /*
@@ -256,7 +272,10 @@ concrete complement-like class.
 */
 type ComplementClassLike interface {
 	// Constructors
-	MakeWithExpression(expression ExpressionLike) ComplementLike
+	MakeWithAttributes(
+		expression ExpressionLike,
+		span SpanLike,
+	) ComplementLike
 }
 
 /*
@@ -269,6 +288,7 @@ type ComponentClassLike interface {
 	MakeWithAttributes(
 		entity EntityLike,
 		context ContextLike,
+		span SpanLike,
 	) ComponentLike
 }
 
@@ -309,7 +329,7 @@ concrete continueclause-like class.
 */
 type ContinueClauseClassLike interface {
 	// Constructors
-	Make() ContinueClauseLike
+	MakeWithSpan(span SpanLike) ContinueClauseLike
 }
 
 /*
@@ -319,7 +339,10 @@ concrete dereference-like class.
 */
 type DereferenceClassLike interface {
 	// Constructors
-	MakeWithExpression(expression ExpressionLike) DereferenceLike
+	MakeWithAttributes(
+		expression ExpressionLike,
+		span SpanLike,
+	) DereferenceLike
 }
 
 /*
@@ -329,7 +352,10 @@ concrete discardclause-like class.
 */
 type DiscardClauseClassLike interface {
 	// Constructors
-	MakeWithDraft(draft DraftLike) DiscardClauseLike
+	MakeWithAttributes(
+		draft DraftLike,
+		span SpanLike,
+	) DiscardClauseLike
 }
 
 /*
@@ -414,7 +440,10 @@ concrete exponential-like class.
 */
 type ExponentialClassLike interface {
 	// Constructors
-	MakeWithExpressions(expressions col.ListLike[ExpressionLike]) ExponentialLike
+	MakeWithAttributes(
+		expressions col.ListLike[ExpressionLike],
+		span SpanLike,
+	) ExponentialLike
 }
 
 /*
@@ -508,6 +537,7 @@ type IfClauseClassLike interface {
 	MakeWithAttributes(
 		condition ConditionLike,
 		procedure ProcedureLike,
+		span SpanLike,
 	) IfClauseLike
 }
 
@@ -541,6 +571,7 @@ type IntrinsicClassLike interface {
 	MakeWithAttributes(
 		function FunctionLike,
 		arguments ArgumentsLike,
+		span SpanLike,
 	) IntrinsicLike
 }
 
@@ -551,7 +582,11 @@ concrete inversion-like class.
 */
 type InversionClassLike interface {
 	// Constructors
-	MakeWithExpression(expression ExpressionLike) InversionLike
+	MakeWithAttributes(
+		operator string,
+		expression ExpressionLike,
+		span SpanLike,
+	) InversionLike
 }
 
 /*
@@ -563,8 +598,10 @@ type InvocationClassLike interface {
 	// Constructors
 	MakeWithAttributes(
 		target TargetLike,
+		operator string,
 		method MethodLike,
 		arguments ArgumentsLike,
+		span SpanLike,
 	) InvocationLike
 }
 
@@ -597,7 +634,9 @@ type LetClauseClassLike interface {
 	// Constructors
 	MakeWithAttributes(
 		recipient RecipientLike,
+		operator string,
 		expression ExpressionLike,
+		span SpanLike,
 	) LetClauseLike
 }
 
@@ -621,6 +660,7 @@ type LineClassLike interface {
 	MakeWithAttributes(
 		annotation AnnotationLike,
 		statement StatementLike,
+		note string,
 	) LineLike
 }
 
@@ -632,10 +672,6 @@ concrete lines-like class.
 type LinesClassLike interface {
 	// Constructors
 	MakeWithLines(lines col.ListLike[LineLike]) LinesLike
-	MakeWithAttributes(
-		line LineLike,
-		note string,
-	) LinesLike
 }
 
 /*
@@ -645,7 +681,11 @@ concrete logical-like class.
 */
 type LogicalClassLike interface {
 	// Constructors
-	MakeWithExpressions(expressions col.ListLike[ExpressionLike]) LogicalLike
+	MakeWithAttributes(
+		expressions col.ListLike[ExpressionLike],
+		operator string,
+		span SpanLike,
+	) LogicalLike
 }
 
 /*
@@ -655,7 +695,10 @@ concrete magnitude-like class.
 */
 type MagnitudeClassLike interface {
 	// Constructors
-	MakeWithExpression(expression ExpressionLike) MagnitudeLike
+	MakeWithAttributes(
+		expression ExpressionLike,
+		span SpanLike,
+	) MagnitudeLike
 }
 
 /*
@@ -671,6 +714,19 @@ type MainClauseClassLike interface {
 	MakeWithRepository(repository RepositoryLike) MainClauseLike
 }
 
+/*
+MatchingClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete matching-like class.
+*/
+type MatchingClassLike interface {
+	// Constructors
+	MakeWithAttributes(
+		template TemplateLike,
+		procedure ProcedureLike,
+	) MatchingLike
+}
+
 /*
 MessageClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -715,6 +771,7 @@ type NotarizeClauseClassLike interface {
 	MakeWithAttributes(
 		draft DraftLike,
 		citation CitationLike,
+		span SpanLike,
 	) NotarizeClauseLike
 }
 
@@ -727,8 +784,8 @@ type OnClauseClassLike interface {
 	// Constructors
 	MakeWithAttributes(
 		failure FailureLike,
-		template TemplateLike,
-		procedure ProcedureLike,
+		matchings col.ListLike[MatchingLike],
+		span SpanLike,
 	) OnClauseLike
 }
 
@@ -742,6 +799,8 @@ type ParameterClassLike interface {
 	MakeWithAttributes(
 		symbol string,
 		component ComponentLike,
+		note string,
+		span SpanLike,
 	) ParameterLike
 }
 
@@ -753,10 +812,21 @@ concrete parameters-like class.
 type ParametersClassLike interface {
 	// Constructors
 	MakeWithParameters(parameters col.ListLike[ParameterLike]) ParametersLike
+}
+
+/*
+ParseErrorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete parseerror-like class.
+*/
+type ParseErrorClassLike interface {
+	// Constructors
 	MakeWithAttributes(
-		parameter ParameterLike,
-		note string,
-	) ParametersLike
+		source string,
+		token TokenLike,
+		expected string,
+		rules col.ListLike[string],
+	) ParseErrorLike
 }
 
 /*
@@ -779,6 +849,7 @@ type PostClauseClassLike interface {
 	MakeWithAttributes(
 		message MessageLike,
 		bag BagLike,
+		span SpanLike,
 	) PostClauseLike
 }
 
@@ -789,7 +860,10 @@ concrete precedence-like class.
 */
 type PrecedenceClassLike interface {
 	// Constructors
-	MakeWithExpression(expression ExpressionLike) PrecedenceLike
+	MakeWithAttributes(
+		expression ExpressionLike,
+		span SpanLike,
+	) PrecedenceLike
 }
 
 /*
@@ -820,7 +894,10 @@ concrete publishclause-like class.
 */
 type PublishClauseClassLike interface {
 	// Constructors
-	MakeWithEvent(event EventLike) PublishClauseLike
+	MakeWithAttributes(
+		event EventLike,
+		span SpanLike,
+	) PublishClauseLike
 }
 
 /*
@@ -830,7 +907,12 @@ concrete range-like class.
 */
 type RangeClassLike interface {
 	// Constructors
-	MakeWithPrimitives(primitives col.ListLike[PrimitiveLike]) RangeLike
+	MakeWithAttributes(
+		leftBracket string,
+		primitives col.ListLike[PrimitiveLike],
+		rightBracket string,
+		span SpanLike,
+	) RangeLike
 }
 
 /*
@@ -851,7 +933,10 @@ concrete rejectclause-like class.
 */
 type RejectClauseClassLike interface {
 	// Constructors
-	MakeWithMessage(message MessageLike) RejectClauseLike
+	MakeWithAttributes(
+		message MessageLike,
+		span SpanLike,
+	) RejectClauseLike
 }
 
 /*
@@ -887,6 +972,7 @@ type RetrieveClauseClassLike interface {
 	MakeWithAttributes(
 		recipient RecipientLike,
 		bag BagLike,
+		span SpanLike,
 	) RetrieveClauseLike
 }
 
@@ -897,7 +983,10 @@ concrete returnclause-like class.
 */
 type ReturnClauseClassLike interface {
 	// Constructors
-	MakeWithResult(result ResultLike) ReturnClauseLike
+	MakeWithAttributes(
+		result ResultLike,
+		span SpanLike,
+	) ReturnClauseLike
 }
 
 /*
@@ -910,6 +999,7 @@ type SaveClauseClassLike interface {
 	MakeWithAttributes(
 		draft DraftLike,
 		citation CitationLike,
+		span SpanLike,
 	) SaveClauseLike
 }
 
@@ -931,6 +1021,20 @@ type ScannerClassLike interface {
 		source string,
 		tokens col.QueueLike[TokenLike],
 	) ScannerLike
+	MakeFromReader(
+		reader io.Reader,
+		tokens col.QueueLike[TokenLike],
+	) ScannerLike
+	MakeFromReaderWithContext(
+		context ctx.Context,
+		reader io.Reader,
+		tokens col.QueueLike[TokenLike],
+	) ScannerLike
+	MakeWithContext(
+		context ctx.Context,
+		source string,
+		tokens col.QueueLike[TokenLike],
+	) ScannerLike
 
 	// Functions
 	FormatToken(token TokenLike) string
@@ -949,8 +1053,8 @@ type SelectClauseClassLike interface {
 	// Constructors
 	MakeWithAttributes(
 		target TargetLike,
-		template TemplateLike,
-		procedure ProcedureLike,
+		matchings col.ListLike[MatchingLike],
+		span SpanLike,
 	) SelectClauseLike
 }
 
@@ -964,6 +1068,31 @@ type SequenceClassLike interface {
 	MakeWithExpression(expression ExpressionLike) SequenceLike
 }
 
+/*
+SpanClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete span-like class.
+*/
+type SpanClassLike interface {
+	// Constructors
+	MakeWithAttributes(
+		startLine int,
+		startPosition int,
+		startOffset int,
+		endLine int,
+		endPosition int,
+		endOffset int,
+	) SpanLike
+	MakeFromTokens(
+		first TokenLike,
+		last TokenLike,
+	) SpanLike
+	MakeFromSpans(
+		first SpanLike,
+		last SpanLike,
+	) SpanLike
+}
+
 /*
 StatementClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -974,6 +1103,7 @@ type StatementClassLike interface {
 	MakeWithAttributes(
 		mainClause MainClauseLike,
 		onClause OnClauseLike,
+		span SpanLike,
 	) StatementLike
 }
 
@@ -1004,6 +1134,7 @@ type SubcomponentClassLike interface {
 	MakeWithAttributes(
 		composite CompositeLike,
 		indices IndicesLike,
+		span SpanLike,
 	) SubcomponentLike
 }
 
@@ -1034,7 +1165,10 @@ concrete throwclause-like class.
 */
 type ThrowClauseClassLike interface {
 	// Constructors
-	MakeWithException(exception ExceptionLike) ThrowClauseLike
+	MakeWithAttributes(
+		exception ExceptionLike,
+		span SpanLike,
+	) ThrowClauseLike
 }
 
 /*
@@ -1047,6 +1181,8 @@ type TokenClassLike interface {
 	MakeWithAttributes(
 		line int,
 		position int,
+		offset int,
+		length int,
 		type_ TokenType,
 		value string,
 	) TokenLike
@@ -1069,7 +1205,10 @@ concrete value-like class.
 */
 type ValueClassLike interface {
 	// Constructors
-	MakeWithComponent(component ComponentLike) ValueLike
+	MakeWithAttributes(
+		component ComponentLike,
+		note string,
+	) ValueLike
 }
 
 /*
@@ -1080,10 +1219,6 @@ concrete values-like class.
 type ValuesClassLike interface {
 	// Constructors
 	MakeWithValues(values col.ListLike[ValueLike]) ValuesLike
-	MakeWithAttributes(
-		value ValueLike,
-		note string,
-	) ValuesLike
 }
 
 /*
@@ -1093,7 +1228,23 @@ concrete variable-like class.
 */
 type VariableClassLike interface {
 	// Constructors
-	MakeWithIdentifier(identifier string) VariableLike
+	MakeWithAttributes(
+		identifier string,
+		span SpanLike,
+	) VariableLike
+}
+
+/*
+ViolationClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete violation-like class.
+*/
+type ViolationClassLike interface {
+	// Constructors
+	MakeWithAttributes(
+		span SpanLike,
+		message string,
+	) ViolationLike
 }
 
 /*
@@ -1106,6 +1257,7 @@ type WhileClauseClassLike interface {
 	MakeWithAttributes(
 		condition ConditionLike,
 		procedure ProcedureLike,
+		span SpanLike,
 	) WhileClauseLike
 }
 
@@ -1120,6 +1272,7 @@ type WithClauseClassLike interface {
 		item ItemLike,
 		sequence SequenceLike,
 		procedure ProcedureLike,
+		span SpanLike,
 	) WithClauseLike
 }
 
@@ -1133,6 +1286,7 @@ instance of a concrete acceptclause-like class.
 type AcceptClauseLike interface {
 	// Attributes
 	GetMessage() MessageLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1174,6 +1328,8 @@ instance of a concrete arithmetic-like class.
 type ArithmeticLike interface {
 	// Attributes
 	GetExpressions() col.ListLike[ExpressionLike]
+	GetOperator() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -1195,6 +1351,8 @@ type AssociationLike interface {
 	// Attributes
 	GetKey() KeyLike
 	GetValue() ValueLike
+	GetNote() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -1205,7 +1363,6 @@ instance of a concrete associations-like class.
 type AssociationsLike interface {
 	// Attributes
 	GetAssociations() col.ListLike[AssociationLike]
-	GetNote() string
 }
 
 /*
@@ -1217,6 +1374,7 @@ type AttributeLike interface {
 	// Attributes
 	GetVariable() VariableLike
 	GetIndices() IndicesLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1235,6 +1393,8 @@ instance attributes, abstractions and methods that must be supported by each
 instance of a concrete breakclause-like class.
 */
 type BreakClauseLike interface {
+	// Attributes
+	GetSpan() SpanLike
 }
 
 /*
@@ -1245,6 +1405,7 @@ instance of a concrete chaining-like class.
 type ChainingLike interface {
 	// Attributes
 	GetExpressions() col.ListLike[ExpressionLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -1257,6 +1418,7 @@ type CheckoutClauseLike interface {
 	GetRecipient() RecipientLike
 	GetLevel() LevelLike
 	GetCitation() CitationLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1288,6 +1450,8 @@ instance of a concrete comparison-like class.
 type ComparisonLike interface {
 	// Attributes
 	GetExpressions() col.ListLike[ExpressionLike]
+	GetOperator() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -1298,6 +1462,7 @@ instance of a concrete complement-like class.
 type ComplementLike interface {
 	// Attributes
 	GetExpression() ExpressionLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1309,6 +1474,7 @@ type ComponentLike interface {
 	// Attributes
 	GetEntity() EntityLike
 	GetContext() ContextLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1347,6 +1513,8 @@ instance attributes, abstractions and methods that must be supported by each
 instance of a concrete continueclause-like class.
 */
 type ContinueClauseLike interface {
+	// Attributes
+	GetSpan() SpanLike
 }
 
 /*
@@ -1357,6 +1525,7 @@ instance of a concrete dereference-like class.
 type DereferenceLike interface {
 	// Attributes
 	GetExpression() ExpressionLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1367,6 +1536,7 @@ instance of a concrete discardclause-like class.
 type DiscardClauseLike interface {
 	// Attributes
 	GetDraft() DraftLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1450,6 +1620,7 @@ instance of a concrete exponential-like class.
 type ExponentialLike interface {
 	// Attributes
 	GetExpressions() col.ListLike[ExpressionLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -1474,6 +1645,9 @@ type ExpressionLike interface {
 	GetComparison() ComparisonLike
 	GetComplement() ComplementLike
 	GetLogical() LogicalLike
+
+	// Methods
+	GetSpan() SpanLike
 }
 
 /*
@@ -1542,6 +1716,7 @@ type IfClauseLike interface {
 	// Attributes
 	GetCondition() ConditionLike
 	GetProcedure() ProcedureLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1573,6 +1748,7 @@ type IntrinsicLike interface {
 	// Attributes
 	GetFunction() FunctionLike
 	GetArguments() ArgumentsLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1582,7 +1758,9 @@ instance of a concrete inversion-like class.
 */
 type InversionLike interface {
 	// Attributes
+	GetOperator() string
 	GetExpression() ExpressionLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1593,8 +1771,10 @@ instance of a concrete invocation-like class.
 type InvocationLike interface {
 	// Attributes
 	GetTarget() TargetLike
+	GetOperator() string
 	GetMethod() MethodLike
 	GetArguments() ArgumentsLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1625,7 +1805,9 @@ instance of a concrete letclause-like class.
 type LetClauseLike interface {
 	// Attributes
 	GetRecipient() RecipientLike
+	GetOperator() string
 	GetExpression() ExpressionLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1647,6 +1829,7 @@ type LineLike interface {
 	// Attributes
 	GetAnnotation() AnnotationLike
 	GetStatement() StatementLike
+	GetNote() string
 }
 
 /*
@@ -1657,7 +1840,6 @@ instance of a concrete lines-like class.
 type LinesLike interface {
 	// Attributes
 	GetLines() col.ListLike[LineLike]
-	GetNote() string
 }
 
 /*
@@ -1668,6 +1850,8 @@ instance of a concrete logical-like class.
 type LogicalLike interface {
 	// Attributes
 	GetExpressions() col.ListLike[ExpressionLike]
+	GetOperator() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -1678,6 +1862,7 @@ instance of a concrete magnitude-like class.
 type MagnitudeLike interface {
 	// Attributes
 	GetExpression() ExpressionLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1693,6 +1878,17 @@ type MainClauseLike interface {
 	GetRepository() RepositoryLike
 }
 
+/*
+MatchingLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete matching-like class.
+*/
+type MatchingLike interface {
+	// Attributes
+	GetTemplate() TemplateLike
+	GetProcedure() ProcedureLike
+}
+
 /*
 MessageLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -1736,6 +1932,7 @@ type NotarizeClauseLike interface {
 	// Attributes
 	GetDraft() DraftLike
 	GetCitation() CitationLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1746,8 +1943,8 @@ instance of a concrete onclause-like class.
 type OnClauseLike interface {
 	// Attributes
 	GetFailure() FailureLike
-	GetTemplate() TemplateLike
-	GetProcedure() ProcedureLike
+	GetMatchings() col.ListLike[MatchingLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -1759,6 +1956,8 @@ type ParameterLike interface {
 	// Attributes
 	GetSymbol() string
 	GetComponent() ComponentLike
+	GetNote() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -1769,7 +1968,25 @@ instance of a concrete parameters-like class.
 type ParametersLike interface {
 	// Attributes
 	GetParameters() col.ListLike[ParameterLike]
-	GetNote() string
+}
+
+/*
+ParseErrorLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete parseerror-like class.
+*/
+type ParseErrorLike interface {
+	// Attributes
+	GetLine() int
+	GetPosition() int
+	GetToken() TokenLike
+	GetExpected() string
+	GetRules() col.ListLike[string]
+	GetMessage() string
+
+	// Methods
+	Error() string
+	Colorized() string
 }
 
 /*
@@ -1779,7 +1996,26 @@ instance of a concrete parser-like class.
 */
 type ParserLike interface {
 	// Methods
+	ParseReader(reader io.Reader) DocumentLike
+	ParseReaderWithContext(
+		context ctx.Context,
+		reader io.Reader,
+	) (
+		document DocumentLike,
+		err error,
+	)
 	ParseSource(source string) DocumentLike
+	ParseSourceWithContext(
+		context ctx.Context,
+		source string,
+	) (
+		document DocumentLike,
+		err error,
+	)
+	TryParseSource(source string) (
+		document DocumentLike,
+		err error,
+	)
 }
 
 /*
@@ -1791,6 +2027,7 @@ type PostClauseLike interface {
 	// Attributes
 	GetMessage() MessageLike
 	GetBag() BagLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1801,6 +2038,7 @@ instance of a concrete precedence-like class.
 type PrecedenceLike interface {
 	// Attributes
 	GetExpression() ExpressionLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1832,6 +2070,7 @@ instance of a concrete publishclause-like class.
 type PublishClauseLike interface {
 	// Attributes
 	GetEvent() EventLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1841,7 +2080,10 @@ instance of a concrete range-like class.
 */
 type RangeLike interface {
 	// Attributes
+	GetLeftBracket() string
 	GetPrimitives() col.ListLike[PrimitiveLike]
+	GetRightBracket() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -1863,6 +2105,7 @@ instance of a concrete rejectclause-like class.
 type RejectClauseLike interface {
 	// Attributes
 	GetMessage() MessageLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1897,6 +2140,7 @@ type RetrieveClauseLike interface {
 	// Attributes
 	GetRecipient() RecipientLike
 	GetBag() BagLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1907,6 +2151,7 @@ instance of a concrete returnclause-like class.
 type ReturnClauseLike interface {
 	// Attributes
 	GetResult() ResultLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1918,6 +2163,7 @@ type SaveClauseLike interface {
 	// Attributes
 	GetDraft() DraftLike
 	GetCitation() CitationLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1936,8 +2182,8 @@ instance of a concrete selectclause-like class.
 type SelectClauseLike interface {
 	// Attributes
 	GetTarget() TargetLike
-	GetTemplate() TemplateLike
-	GetProcedure() ProcedureLike
+	GetMatchings() col.ListLike[MatchingLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -1950,6 +2196,24 @@ type SequenceLike interface {
 	GetExpression() ExpressionLike
 }
 
+/*
+SpanLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete span-like class.
+*/
+type SpanLike interface {
+	// Attributes
+	GetStartLine() int
+	GetStartPosition() int
+	GetStartOffset() int
+	GetEndLine() int
+	GetEndPosition() int
+	GetEndOffset() int
+
+	// Methods
+	AsString() string
+}
+
 /*
 StatementLike is an instance interface that defines the complete set of
 instance attributes, abstractions and methods that must be supported by each
@@ -1959,6 +2223,7 @@ type StatementLike interface {
 	// Attributes
 	GetMainClause() MainClauseLike
 	GetOnClause() OnClauseLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -1987,6 +2252,7 @@ type SubcomponentLike interface {
 	// Attributes
 	GetComposite() CompositeLike
 	GetIndices() IndicesLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -2017,6 +2283,7 @@ instance of a concrete throwclause-like class.
 type ThrowClauseLike interface {
 	// Attributes
 	GetException() ExceptionLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -2028,6 +2295,8 @@ type TokenLike interface {
 	// Attributes
 	GetLine() int
 	GetPosition() int
+	GetOffset() int
+	GetLength() int
 	GetType() TokenType
 	GetValue() string
 }
@@ -2039,7 +2308,7 @@ instance of a concrete validator-like class.
 */
 type ValidatorLike interface {
 	// Methods
-	ValidateDocument(document DocumentLike)
+	ValidateDocument(document DocumentLike) col.ListLike[ViolationLike]
 }
 
 /*
@@ -2050,6 +2319,7 @@ instance of a concrete value-like class.
 type ValueLike interface {
 	// Attributes
 	GetComponent() ComponentLike
+	GetNote() string
 }
 
 /*
@@ -2060,7 +2330,6 @@ instance of a concrete values-like class.
 type ValuesLike interface {
 	// Attributes
 	GetValues() col.ListLike[ValueLike]
-	GetNote() string
 }
 
 /*
@@ -2071,6 +2340,21 @@ instance of a concrete variable-like class.
 type VariableLike interface {
 	// Attributes
 	GetIdentifier() string
+	GetSpan() SpanLike
+}
+
+/*
+ViolationLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete violation-like class.
+*/
+type ViolationLike interface {
+	// Attributes
+	GetSpan() SpanLike
+	GetMessage() string
+
+	// Methods
+	AsString() string
 }
 
 /*
@@ -2082,6 +2366,7 @@ type WhileClauseLike interface {
 	// Attributes
 	GetCondition() ConditionLike
 	GetProcedure() ProcedureLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -2094,4 +2379,5 @@ type WithClauseLike interface {
 	GetItem() ItemLike
 	GetSequence() SequenceLike
 	GetProcedure() ProcedureLike
+	GetSpan() SpanLike
 }
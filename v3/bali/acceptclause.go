@@ -40,9 +40,13 @@ type acceptClauseClass_ struct {
 
 // Constructors
 
-func (c *acceptClauseClass_) MakeWithMessage(message MessageLike) AcceptClauseLike {
+func (c *acceptClauseClass_) MakeWithAttributes(
+	message MessageLike,
+	span SpanLike,
+) AcceptClauseLike {
 	return &acceptClause_{
 		message_: message,
+		span_:    span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *acceptClauseClass_) MakeWithMessage(message MessageLike) AcceptClauseLi
 
 type acceptClause_ struct {
 	message_ MessageLike
+	span_    SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *acceptClause_) GetMessage() MessageLike {
 	return v.message_
 }
 
+func (v *acceptClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -42,9 +42,15 @@ type arithmeticClass_ struct {
 
 // Constructors
 
-func (c *arithmeticClass_) MakeWithExpressions(expressions col.ListLike[ExpressionLike]) ArithmeticLike {
+func (c *arithmeticClass_) MakeWithAttributes(
+	expressions col.ListLike[ExpressionLike],
+	operator string,
+	span SpanLike,
+) ArithmeticLike {
 	return &arithmetic_{
 		expressions_: expressions,
+		operator_:    operator,
+		span_:        span,
 	}
 }
 
@@ -56,6 +62,8 @@ func (c *arithmeticClass_) MakeWithExpressions(expressions col.ListLike[Expressi
 
 type arithmetic_ struct {
 	expressions_ col.ListLike[ExpressionLike]
+	operator_    string
+	span_        SpanLike
 }
 
 // Attributes
@@ -64,6 +72,14 @@ func (v *arithmetic_) GetExpressions() col.ListLike[ExpressionLike] {
 	return v.expressions_
 }
 
+func (v *arithmetic_) GetOperator() string {
+	return v.operator_
+}
+
+func (v *arithmetic_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -43,10 +43,14 @@ type associationClass_ struct {
 func (c *associationClass_) MakeWithAttributes(
 	key KeyLike,
 	value ValueLike,
+	note string,
+	span SpanLike,
 ) AssociationLike {
 	return &association_{
 		key_:   key,
 		value_: value,
+		note_:  note,
+		span_:  span,
 	}
 }
 
@@ -59,6 +63,8 @@ func (c *associationClass_) MakeWithAttributes(
 type association_ struct {
 	key_   KeyLike
 	value_ ValueLike
+	note_  string
+	span_  SpanLike
 }
 
 // Attributes
@@ -71,6 +77,14 @@ func (v *association_) GetValue() ValueLike {
 	return v.value_
 }
 
+func (v *association_) GetNote() string {
+	return v.note_
+}
+
+func (v *association_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
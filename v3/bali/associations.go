@@ -48,16 +48,6 @@ func (c *associationsClass_) MakeWithAssociations(associations col.ListLike[Asso
 	}
 }
 
-func (c *associationsClass_) MakeWithAttributes(
-	association AssociationLike,
-	note string,
-) AssociationsLike {
-	return &associations_{
-		association_: association,
-		note_:        note,
-	}
-}
-
 // Functions
 
 // INSTANCE METHODS
@@ -66,8 +56,6 @@ func (c *associationsClass_) MakeWithAttributes(
 
 type associations_ struct {
 	associations_ col.ListLike[AssociationLike]
-	note_         string
-	association_  AssociationLike
 }
 
 // Attributes
@@ -76,10 +64,6 @@ func (v *associations_) GetAssociations() col.ListLike[AssociationLike] {
 	return v.associations_
 }
 
-func (v *associations_) GetNote() string {
-	return v.note_
-}
-
 // Public
 
 // Private
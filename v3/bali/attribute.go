@@ -43,10 +43,12 @@ type attributeClass_ struct {
 func (c *attributeClass_) MakeWithAttributes(
 	variable VariableLike,
 	indices IndicesLike,
+	span SpanLike,
 ) AttributeLike {
 	return &attribute_{
 		variable_: variable,
 		indices_:  indices,
+		span_:     span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *attributeClass_) MakeWithAttributes(
 type attribute_ struct {
 	variable_ VariableLike
 	indices_  IndicesLike
+	span_     SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *attribute_) GetIndices() IndicesLike {
 	return v.indices_
 }
 
+func (v *attribute_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,8 +40,10 @@ type breakClauseClass_ struct {
 
 // Constructors
 
-func (c *breakClauseClass_) Make() BreakClauseLike {
-	return &breakClause_{}
+func (c *breakClauseClass_) MakeWithSpan(span SpanLike) BreakClauseLike {
+	return &breakClause_{
+		span_: span,
+	}
 }
 
 // Functions
@@ -51,11 +53,15 @@ func (c *breakClauseClass_) Make() BreakClauseLike {
 // Target
 
 type breakClause_ struct {
-	// TBA - Add private instance attributes.
+	span_ SpanLike
 }
 
 // Attributes
 
+func (v *breakClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -42,9 +42,13 @@ type chainingClass_ struct {
 
 // Constructors
 
-func (c *chainingClass_) MakeWithExpressions(expressions col.ListLike[ExpressionLike]) ChainingLike {
+func (c *chainingClass_) MakeWithAttributes(
+	expressions col.ListLike[ExpressionLike],
+	span SpanLike,
+) ChainingLike {
 	return &chaining_{
 		expressions_: expressions,
+		span_:        span,
 	}
 }
 
@@ -56,6 +60,7 @@ func (c *chainingClass_) MakeWithExpressions(expressions col.ListLike[Expression
 
 type chaining_ struct {
 	expressions_ col.ListLike[ExpressionLike]
+	span_        SpanLike
 }
 
 // Attributes
@@ -64,6 +69,10 @@ func (v *chaining_) GetExpressions() col.ListLike[ExpressionLike] {
 	return v.expressions_
 }
 
+func (v *chaining_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -44,11 +44,13 @@ func (c *checkoutClauseClass_) MakeWithAttributes(
 	recipient RecipientLike,
 	level LevelLike,
 	citation CitationLike,
+	span SpanLike,
 ) CheckoutClauseLike {
 	return &checkoutClause_{
 		recipient_: recipient,
 		level_:     level,
 		citation_:  citation,
+		span_:      span,
 	}
 }
 
@@ -62,6 +64,7 @@ type checkoutClause_ struct {
 	recipient_ RecipientLike
 	level_     LevelLike
 	citation_  CitationLike
+	span_      SpanLike
 }
 
 // Attributes
@@ -78,6 +81,10 @@ func (v *checkoutClause_) GetCitation() CitationLike {
 	return v.citation_
 }
 
+func (v *checkoutClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -42,9 +42,15 @@ type comparisonClass_ struct {
 
 // Constructors
 
-func (c *comparisonClass_) MakeWithExpressions(expressions col.ListLike[ExpressionLike]) ComparisonLike {
+func (c *comparisonClass_) MakeWithAttributes(
+	expressions col.ListLike[ExpressionLike],
+	operator string,
+	span SpanLike,
+) ComparisonLike {
 	return &comparison_{
 		expressions_: expressions,
+		operator_:    operator,
+		span_:        span,
 	}
 }
 
@@ -56,6 +62,8 @@ func (c *comparisonClass_) MakeWithExpressions(expressions col.ListLike[Expressi
 
 type comparison_ struct {
 	expressions_ col.ListLike[ExpressionLike]
+	operator_    string
+	span_        SpanLike
 }
 
 // Attributes
@@ -64,6 +72,14 @@ func (v *comparison_) GetExpressions() col.ListLike[ExpressionLike] {
 	return v.expressions_
 }
 
+func (v *comparison_) GetOperator() string {
+	return v.operator_
+}
+
+func (v *comparison_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,9 +40,13 @@ type complementClass_ struct {
 
 // Constructors
 
-func (c *complementClass_) MakeWithExpression(expression ExpressionLike) ComplementLike {
+func (c *complementClass_) MakeWithAttributes(
+	expression ExpressionLike,
+	span SpanLike,
+) ComplementLike {
 	return &complement_{
 		expression_: expression,
+		span_:       span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *complementClass_) MakeWithExpression(expression ExpressionLike) Complem
 
 type complement_ struct {
 	expression_ ExpressionLike
+	span_       SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *complement_) GetExpression() ExpressionLike {
 	return v.expression_
 }
 
+func (v *complement_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
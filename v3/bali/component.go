@@ -43,10 +43,12 @@ type componentClass_ struct {
 func (c *componentClass_) MakeWithAttributes(
 	entity EntityLike,
 	context ContextLike,
+	span SpanLike,
 ) ComponentLike {
 	return &component_{
 		entity_:  entity,
 		context_: context,
+		span_:    span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *componentClass_) MakeWithAttributes(
 type component_ struct {
 	entity_  EntityLike
 	context_ ContextLike
+	span_    SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *component_) GetContext() ContextLike {
 	return v.context_
 }
 
+func (v *component_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
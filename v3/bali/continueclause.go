@@ -40,8 +40,10 @@ type continueClauseClass_ struct {
 
 // Constructors
 
-func (c *continueClauseClass_) Make() ContinueClauseLike {
-	return &continueClause_{}
+func (c *continueClauseClass_) MakeWithSpan(span SpanLike) ContinueClauseLike {
+	return &continueClause_{
+		span_: span,
+	}
 }
 
 // Functions
@@ -51,11 +53,15 @@ func (c *continueClauseClass_) Make() ContinueClauseLike {
 // Target
 
 type continueClause_ struct {
-	// TBA - Add private instance attributes.
+	span_ SpanLike
 }
 
 // Attributes
 
+func (v *continueClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,9 +40,13 @@ type dereferenceClass_ struct {
 
 // Constructors
 
-func (c *dereferenceClass_) MakeWithExpression(expression ExpressionLike) DereferenceLike {
+func (c *dereferenceClass_) MakeWithAttributes(
+	expression ExpressionLike,
+	span SpanLike,
+) DereferenceLike {
 	return &dereference_{
 		expression_: expression,
+		span_:       span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *dereferenceClass_) MakeWithExpression(expression ExpressionLike) Derefe
 
 type dereference_ struct {
 	expression_ ExpressionLike
+	span_       SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *dereference_) GetExpression() ExpressionLike {
 	return v.expression_
 }
 
+func (v *dereference_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,9 +40,13 @@ type discardClauseClass_ struct {
 
 // Constructors
 
-func (c *discardClauseClass_) MakeWithDraft(draft DraftLike) DiscardClauseLike {
+func (c *discardClauseClass_) MakeWithAttributes(
+	draft DraftLike,
+	span SpanLike,
+) DiscardClauseLike {
 	return &discardClause_{
 		draft_: draft,
+		span_:  span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *discardClauseClass_) MakeWithDraft(draft DraftLike) DiscardClauseLike {
 
 type discardClause_ struct {
 	draft_ DraftLike
+	span_  SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *discardClause_) GetDraft() DraftLike {
 	return v.draft_
 }
 
+func (v *discardClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
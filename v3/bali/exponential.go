@@ -42,9 +42,13 @@ type exponentialClass_ struct {
 
 // Constructors
 
-func (c *exponentialClass_) MakeWithExpressions(expressions col.ListLike[ExpressionLike]) ExponentialLike {
+func (c *exponentialClass_) MakeWithAttributes(
+	expressions col.ListLike[ExpressionLike],
+	span SpanLike,
+) ExponentialLike {
 	return &exponential_{
 		expressions_: expressions,
+		span_:        span,
 	}
 }
 
@@ -56,6 +60,7 @@ func (c *exponentialClass_) MakeWithExpressions(expressions col.ListLike[Express
 
 type exponential_ struct {
 	expressions_ col.ListLike[ExpressionLike]
+	span_        SpanLike
 }
 
 // Attributes
@@ -64,6 +69,10 @@ func (v *exponential_) GetExpressions() col.ListLike[ExpressionLike] {
 	return v.expressions_
 }
 
+func (v *exponential_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -218,4 +218,40 @@ func (v *expression_) GetLogical() LogicalLike {
 
 // Public
 
+func (v *expression_) GetSpan() SpanLike {
+	// An expression spans the same source as the expression it contains.
+	switch {
+	case v.component_ != nil:
+		return v.component_.GetSpan()
+	case v.intrinsic_ != nil:
+		return v.intrinsic_.GetSpan()
+	case v.variable_ != nil:
+		return v.variable_.GetSpan()
+	case v.precedence_ != nil:
+		return v.precedence_.GetSpan()
+	case v.dereference_ != nil:
+		return v.dereference_.GetSpan()
+	case v.invocation_ != nil:
+		return v.invocation_.GetSpan()
+	case v.subcomponent_ != nil:
+		return v.subcomponent_.GetSpan()
+	case v.chaining_ != nil:
+		return v.chaining_.GetSpan()
+	case v.exponential_ != nil:
+		return v.exponential_.GetSpan()
+	case v.inversion_ != nil:
+		return v.inversion_.GetSpan()
+	case v.arithmetic_ != nil:
+		return v.arithmetic_.GetSpan()
+	case v.magnitude_ != nil:
+		return v.magnitude_.GetSpan()
+	case v.comparison_ != nil:
+		return v.comparison_.GetSpan()
+	case v.complement_ != nil:
+		return v.complement_.GetSpan()
+	default:
+		return v.logical_.GetSpan()
+	}
+}
+
 // Private
@@ -13,6 +13,7 @@
 package bali
 
 import (
+	col "github.com/craterdog/go-collection-framework/v3/collection"
 	sts "strings"
 )
 
@@ -64,23 +65,585 @@ func (v *formatter_) FormatDocument(document DocumentLike) string {
 
 func (v *formatter_) appendNewline() {
 	var separator = "\n"
-	var indentation = "\t"
+	var indentation = "    "
 	for level := 0; level < v.depth_; level++ {
 		separator += indentation
 	}
 	v.appendString(separator)
 }
 
+/*
+This private instance method appends a note to the current line, separated from
+any preceding text on that line by two spaces.
+*/
+func (v *formatter_) appendNote(note string) {
+	if len(note) > 0 {
+		v.appendString("  " + note)
+	}
+}
+
 func (v *formatter_) appendString(s string) {
 	v.result_.WriteString(s)
 }
 
+/*
+This private instance method appends a token that may span multiple lines (e.g.
+a comment, narrative or binary string).  Each subsequent line is reindented to
+the current depth relative to the indentation of its closing delimiter line so
+that the result is canonical regardless of the original indentation.
+*/
+func (v *formatter_) appendText(text string) {
+	var lines = sts.Split(text, "\n")
+	var last = lines[len(lines)-1]
+	var indentation = last[:len(last)-len(sts.TrimLeft(last, " "))]
+	v.appendString(lines[0])
+	for _, line := range lines[1:] {
+		line = sts.TrimPrefix(line, indentation)
+		if len(line) == 0 {
+			// Blank lines never contain trailing whitespace.
+			v.appendString("\n")
+			continue
+		}
+		v.appendNewline()
+		v.appendString(line)
+	}
+}
+
+func (v *formatter_) formatAcceptClause(acceptClause AcceptClauseLike) {
+	v.appendString("accept ")
+	v.formatExpression(acceptClause.GetMessage().GetExpression())
+}
+
+func (v *formatter_) formatAnnotation(annotation AnnotationLike) {
+	var note = annotation.GetNote()
+	if len(note) > 0 {
+		v.appendString(note)
+		return
+	}
+	v.appendText(annotation.GetComment())
+}
+
+func (v *formatter_) formatArguments(arguments ArgumentsLike) {
+	if arguments == nil {
+		return
+	}
+	var iterator = arguments.GetArguments().GetIterator()
+	for iterator.HasNext() {
+		var argument = iterator.GetNext()
+		v.formatExpression(argument.GetExpression())
+		if iterator.HasNext() {
+			v.appendString(", ")
+		}
+	}
+}
+
+func (v *formatter_) formatAssignment(assignment AssignmentLike) {
+	v.formatLetClause(assignment.GetLetClause())
+}
+
+func (v *formatter_) formatAssociation(association AssociationLike) {
+	v.formatPrimitive(association.GetKey().GetPrimitive())
+	v.appendString(": ")
+	v.formatComponent(association.GetValue().GetComponent())
+	v.appendNote(association.GetNote())
+}
+
+func (v *formatter_) formatAssociations(associations AssociationsLike) {
+	var list = associations.GetAssociations()
+	var iterator = list.GetIterator()
+	switch {
+	case list.IsEmpty():
+		v.appendString(":")
+	case list.GetSize() == 1 && len(list.GetValue(1).GetNote()) == 0:
+		v.formatAssociation(iterator.GetNext())
+	default:
+		v.depth_++
+		for iterator.HasNext() {
+			v.appendNewline()
+			v.formatAssociation(iterator.GetNext())
+		}
+		v.depth_--
+		v.appendNewline()
+	}
+}
+
+func (v *formatter_) formatAttribute(attribute AttributeLike) {
+	v.appendString(attribute.GetVariable().GetIdentifier())
+	v.appendString("[")
+	v.formatIndices(attribute.GetIndices())
+	v.appendString("]")
+}
+
+func (v *formatter_) formatCheckoutClause(checkoutClause CheckoutClauseLike) {
+	v.appendString("checkout ")
+	v.formatRecipient(checkoutClause.GetRecipient())
+	var level = checkoutClause.GetLevel()
+	if level != nil {
+		v.appendString(" at level ")
+		v.formatExpression(level.GetExpression())
+	}
+	v.appendString(" from ")
+	v.formatExpression(checkoutClause.GetCitation().GetExpression())
+}
+
+func (v *formatter_) formatCollection(collection CollectionLike) {
+	v.appendString("[")
+	var associations = collection.GetAssociations()
+	if associations != nil {
+		v.formatAssociations(associations)
+	} else {
+		v.formatValues(collection.GetValues())
+	}
+	v.appendString("]")
+}
+
+func (v *formatter_) formatComponent(component ComponentLike) {
+	v.formatEntity(component.GetEntity())
+	var context = component.GetContext()
+	if context != nil {
+		v.formatContext(context)
+	}
+}
+
+func (v *formatter_) formatContext(context ContextLike) {
+	v.appendString("(")
+	var list = context.GetParameters().GetParameters()
+	var iterator = list.GetIterator()
+	switch {
+	case list.GetSize() == 1 && len(list.GetValue(1).GetNote()) == 0:
+		v.formatParameter(iterator.GetNext())
+	default:
+		v.depth_++
+		for iterator.HasNext() {
+			v.appendNewline()
+			v.formatParameter(iterator.GetNext())
+		}
+		v.depth_--
+		v.appendNewline()
+	}
+	v.appendString(")")
+}
+
+func (v *formatter_) formatDiscardClause(discardClause DiscardClauseLike) {
+	v.appendString("discard ")
+	v.formatExpression(discardClause.GetDraft().GetExpression())
+}
+
 func (v *formatter_) formatDocument(document DocumentLike) {
-	// TBA - Add real method implementation.
-	v.depth_++
-	v.appendString("test")
-	v.appendNewline()
-	v.depth_--
+	v.appendText(document.GetHeader().GetComment())
+	v.appendString("\n\n")
+	v.formatComponent(document.GetComponent())
+	v.appendString("\n")
+}
+
+func (v *formatter_) formatElement(element ElementLike) {
+	switch {
+	case len(element.GetAngle()) > 0:
+		v.appendString(element.GetAngle())
+	case len(element.GetBoolean()) > 0:
+		v.appendString(element.GetBoolean())
+	case len(element.GetDuration()) > 0:
+		v.appendString(element.GetDuration())
+	case len(element.GetMoment()) > 0:
+		v.appendString(element.GetMoment())
+	case len(element.GetNumber()) > 0:
+		v.appendString(element.GetNumber())
+	case len(element.GetPattern()) > 0:
+		v.appendString(element.GetPattern())
+	case len(element.GetPercentage()) > 0:
+		v.appendString(element.GetPercentage())
+	case len(element.GetProbability()) > 0:
+		v.appendString(element.GetProbability())
+	case len(element.GetResource()) > 0:
+		v.appendString(element.GetResource())
+	}
+}
+
+func (v *formatter_) formatEntity(entity EntityLike) {
+	switch {
+	case entity.GetElement() != nil:
+		v.formatElement(entity.GetElement())
+	case entity.GetString() != nil:
+		v.formatString(entity.GetString())
+	case entity.GetRange() != nil:
+		v.formatRange(entity.GetRange())
+	case entity.GetCollection() != nil:
+		v.formatCollection(entity.GetCollection())
+	case entity.GetProcedure() != nil:
+		v.formatProcedure(entity.GetProcedure())
+	}
+}
+
+func (v *formatter_) formatExpression(expression ExpressionLike) {
+	switch {
+	case expression.GetComponent() != nil:
+		v.formatComponent(expression.GetComponent())
+	case expression.GetIntrinsic() != nil:
+		var intrinsic = expression.GetIntrinsic()
+		v.appendString(intrinsic.GetFunction().GetIdentifier())
+		v.appendString("(")
+		v.formatArguments(intrinsic.GetArguments())
+		v.appendString(")")
+	case expression.GetVariable() != nil:
+		v.appendString(expression.GetVariable().GetIdentifier())
+	case expression.GetPrecedence() != nil:
+		v.appendString("(")
+		v.formatExpression(expression.GetPrecedence().GetExpression())
+		v.appendString(")")
+	case expression.GetDereference() != nil:
+		v.appendString("@")
+		v.formatExpression(expression.GetDereference().GetExpression())
+	case expression.GetInvocation() != nil:
+		var invocation = expression.GetInvocation()
+		v.formatExpression(invocation.GetTarget().GetExpression())
+		v.appendString(invocation.GetOperator())
+		v.appendString(invocation.GetMethod().GetIdentifier())
+		v.appendString("(")
+		v.formatArguments(invocation.GetArguments())
+		v.appendString(")")
+	case expression.GetSubcomponent() != nil:
+		var subcomponent = expression.GetSubcomponent()
+		v.formatExpression(subcomponent.GetComposite().GetExpression())
+		v.appendString("[")
+		v.formatIndices(subcomponent.GetIndices())
+		v.appendString("]")
+	case expression.GetChaining() != nil:
+		var expressions = expression.GetChaining().GetExpressions()
+		v.formatOperation(expressions, "&")
+	case expression.GetExponential() != nil:
+		var expressions = expression.GetExponential().GetExpressions()
+		v.formatOperation(expressions, "^")
+	case expression.GetInversion() != nil:
+		var inversion = expression.GetInversion()
+		v.appendString(inversion.GetOperator())
+		v.formatExpression(inversion.GetExpression())
+	case expression.GetArithmetic() != nil:
+		var arithmetic = expression.GetArithmetic()
+		v.formatOperation(arithmetic.GetExpressions(), arithmetic.GetOperator())
+	case expression.GetMagnitude() != nil:
+		v.appendString("|")
+		v.formatExpression(expression.GetMagnitude().GetExpression())
+		v.appendString("|")
+	case expression.GetComparison() != nil:
+		var comparison = expression.GetComparison()
+		v.formatOperation(comparison.GetExpressions(), comparison.GetOperator())
+	case expression.GetComplement() != nil:
+		v.appendString("NOT ")
+		v.formatExpression(expression.GetComplement().GetExpression())
+	case expression.GetLogical() != nil:
+		var logical = expression.GetLogical()
+		v.formatOperation(logical.GetExpressions(), logical.GetOperator())
+	}
+}
+
+func (v *formatter_) formatFlow(flow FlowLike) {
+	switch {
+	case flow.GetIfClause() != nil:
+		v.formatIfClause(flow.GetIfClause())
+	case flow.GetSelectClause() != nil:
+		v.formatSelectClause(flow.GetSelectClause())
+	case flow.GetWhileClause() != nil:
+		v.formatWhileClause(flow.GetWhileClause())
+	case flow.GetWithClause() != nil:
+		v.formatWithClause(flow.GetWithClause())
+	case flow.GetContinueClause() != nil:
+		v.appendString("continue loop")
+	case flow.GetBreakClause() != nil:
+		v.appendString("break loop")
+	case flow.GetReturnClause() != nil:
+		v.appendString("return ")
+		v.formatExpression(flow.GetReturnClause().GetResult().GetExpression())
+	case flow.GetThrowClause() != nil:
+		v.appendString("throw ")
+		v.formatExpression(flow.GetThrowClause().GetException().GetExpression())
+	}
+}
+
+func (v *formatter_) formatIfClause(ifClause IfClauseLike) {
+	v.appendString("if ")
+	v.formatExpression(ifClause.GetCondition().GetExpression())
+	v.appendString(" do ")
+	v.formatProcedure(ifClause.GetProcedure())
+}
+
+func (v *formatter_) formatIndices(indices IndicesLike) {
+	var iterator = indices.GetIndexs().GetIterator()
+	for iterator.HasNext() {
+		var index = iterator.GetNext()
+		v.formatExpression(index.GetExpression())
+		if iterator.HasNext() {
+			v.appendString(", ")
+		}
+	}
+}
+
+func (v *formatter_) formatLetClause(letClause LetClauseLike) {
+	var recipient = letClause.GetRecipient()
+	if recipient != nil {
+		v.appendString("let ")
+		v.formatRecipient(recipient)
+		v.appendString(" " + letClause.GetOperator() + " ")
+	}
+	v.formatExpression(letClause.GetExpression())
+}
+
+func (v *formatter_) formatLine(line LineLike) {
+	var annotation = line.GetAnnotation()
+	var statement = line.GetStatement()
+	if annotation != nil {
+		v.formatAnnotation(annotation)
+		if statement != nil {
+			v.appendNewline()
+		}
+	}
+	if statement != nil {
+		v.formatStatement(statement)
+	}
+	v.appendNote(line.GetNote())
+}
+
+func (v *formatter_) formatMainClause(mainClause MainClauseLike) {
+	switch {
+	case mainClause.GetFlow() != nil:
+		v.formatFlow(mainClause.GetFlow())
+	case mainClause.GetAssignment() != nil:
+		v.formatAssignment(mainClause.GetAssignment())
+	case mainClause.GetMessaging() != nil:
+		v.formatMessaging(mainClause.GetMessaging())
+	case mainClause.GetRepository() != nil:
+		v.formatRepository(mainClause.GetRepository())
+	}
+}
+
+func (v *formatter_) formatMatchings(matchings col.ListLike[MatchingLike]) {
+	var iterator = matchings.GetIterator()
+	for iterator.HasNext() {
+		var matching = iterator.GetNext()
+		v.appendString(" matching ")
+		v.formatExpression(matching.GetTemplate().GetExpression())
+		v.appendString(" do ")
+		v.formatProcedure(matching.GetProcedure())
+	}
+}
+
+func (v *formatter_) formatMessaging(messaging MessagingLike) {
+	switch {
+	case messaging.GetPostClause() != nil:
+		v.formatPostClause(messaging.GetPostClause())
+	case messaging.GetRetrieveClause() != nil:
+		v.formatRetrieveClause(messaging.GetRetrieveClause())
+	case messaging.GetAcceptClause() != nil:
+		v.formatAcceptClause(messaging.GetAcceptClause())
+	case messaging.GetRejectClause() != nil:
+		v.formatRejectClause(messaging.GetRejectClause())
+	case messaging.GetPublishClause() != nil:
+		v.formatPublishClause(messaging.GetPublishClause())
+	}
+}
+
+func (v *formatter_) formatNotarizeClause(notarizeClause NotarizeClauseLike) {
+	v.appendString("notarize ")
+	v.formatExpression(notarizeClause.GetDraft().GetExpression())
+	v.appendString(" as ")
+	v.formatExpression(notarizeClause.GetCitation().GetExpression())
+}
+
+func (v *formatter_) formatOnClause(onClause OnClauseLike) {
+	v.appendString(" on ")
+	v.appendString(onClause.GetFailure().GetSymbol())
+	v.formatMatchings(onClause.GetMatchings())
+}
+
+/*
+This private instance method formats a sequence of operands that are separated
+by the specified binary operator.
+*/
+func (v *formatter_) formatOperation(
+	expressions col.ListLike[ExpressionLike],
+	operator string,
+) {
+	var iterator = expressions.GetIterator()
+	for iterator.HasNext() {
+		v.formatExpression(iterator.GetNext())
+		if iterator.HasNext() {
+			v.appendString(" " + operator + " ")
+		}
+	}
+}
+
+func (v *formatter_) formatParameter(parameter ParameterLike) {
+	v.appendString(parameter.GetSymbol())
+	v.appendString(": ")
+	v.formatComponent(parameter.GetComponent())
+	v.appendNote(parameter.GetNote())
+}
+
+func (v *formatter_) formatPostClause(postClause PostClauseLike) {
+	v.appendString("post ")
+	v.formatExpression(postClause.GetMessage().GetExpression())
+	v.appendString(" to ")
+	v.formatExpression(postClause.GetBag().GetExpression())
+}
+
+func (v *formatter_) formatPrimitive(primitive PrimitiveLike) {
+	var element = primitive.GetElement()
+	if element != nil {
+		v.formatElement(element)
+		return
+	}
+	v.formatString(primitive.GetString())
+}
+
+func (v *formatter_) formatProcedure(procedure ProcedureLike) {
+	v.appendString("{")
+	var list = procedure.GetLines().GetLines()
+	if list.IsEmpty() {
+		v.appendString(" ")
+	} else {
+		v.depth_++
+		var iterator = list.GetIterator()
+		for iterator.HasNext() {
+			var line = iterator.GetNext()
+			var isBlank = line.GetAnnotation() == nil && line.GetStatement() == nil
+			if isBlank {
+				// Blank lines never contain trailing whitespace.
+				v.appendString("\n")
+				continue
+			}
+			v.appendNewline()
+			v.formatLine(line)
+		}
+		v.depth_--
+		v.appendNewline()
+	}
+	v.appendString("}")
+}
+
+func (v *formatter_) formatPublishClause(publishClause PublishClauseLike) {
+	v.appendString("publish ")
+	v.formatExpression(publishClause.GetEvent().GetExpression())
+}
+
+func (v *formatter_) formatRange(range_ RangeLike) {
+	v.appendString(range_.GetLeftBracket())
+	var primitives = range_.GetPrimitives()
+	v.formatPrimitive(primitives.GetValue(1))
+	v.appendString("..")
+	v.formatPrimitive(primitives.GetValue(2))
+	v.appendString(range_.GetRightBracket())
+}
+
+func (v *formatter_) formatRecipient(recipient RecipientLike) {
+	var attribute = recipient.GetAttribute()
+	if attribute != nil {
+		v.formatAttribute(attribute)
+		return
+	}
+	v.appendString(recipient.GetSymbol())
+}
+
+func (v *formatter_) formatRejectClause(rejectClause RejectClauseLike) {
+	v.appendString("reject ")
+	v.formatExpression(rejectClause.GetMessage().GetExpression())
+}
+
+func (v *formatter_) formatRepository(repository RepositoryLike) {
+	switch {
+	case repository.GetCheckoutClause() != nil:
+		v.formatCheckoutClause(repository.GetCheckoutClause())
+	case repository.GetSaveClause() != nil:
+		v.formatSaveClause(repository.GetSaveClause())
+	case repository.GetDiscardClause() != nil:
+		v.formatDiscardClause(repository.GetDiscardClause())
+	case repository.GetNotarizeClause() != nil:
+		v.formatNotarizeClause(repository.GetNotarizeClause())
+	}
+}
+
+func (v *formatter_) formatRetrieveClause(retrieveClause RetrieveClauseLike) {
+	v.appendString("retrieve ")
+	v.formatRecipient(retrieveClause.GetRecipient())
+	v.appendString(" from ")
+	v.formatExpression(retrieveClause.GetBag().GetExpression())
+}
+
+func (v *formatter_) formatSaveClause(saveClause SaveClauseLike) {
+	v.appendString("save ")
+	v.formatExpression(saveClause.GetDraft().GetExpression())
+	v.appendString(" as ")
+	v.formatExpression(saveClause.GetCitation().GetExpression())
+}
+
+func (v *formatter_) formatSelectClause(selectClause SelectClauseLike) {
+	v.appendString("select ")
+	v.formatExpression(selectClause.GetTarget().GetExpression())
+	v.formatMatchings(selectClause.GetMatchings())
+}
+
+func (v *formatter_) formatStatement(statement StatementLike) {
+	v.formatMainClause(statement.GetMainClause())
+	var onClause = statement.GetOnClause()
+	if onClause != nil {
+		v.formatOnClause(onClause)
+	}
+}
+
+func (v *formatter_) formatString(string_ StringLike) {
+	switch {
+	case len(string_.GetBinary()) > 0:
+		v.appendText(string_.GetBinary())
+	case len(string_.GetBytecode()) > 0:
+		v.appendString(string_.GetBytecode())
+	case len(string_.GetName()) > 0:
+		v.appendString(string_.GetName())
+	case len(string_.GetNarrative()) > 0:
+		v.appendText(string_.GetNarrative())
+	case len(string_.GetQuote()) > 0:
+		v.appendString(string_.GetQuote())
+	case len(string_.GetSymbol()) > 0:
+		v.appendString(string_.GetSymbol())
+	case len(string_.GetTag()) > 0:
+		v.appendString(string_.GetTag())
+	case len(string_.GetVersion()) > 0:
+		v.appendString(string_.GetVersion())
+	}
+}
+
+func (v *formatter_) formatValues(values ValuesLike) {
+	var list = values.GetValues()
+	var iterator = list.GetIterator()
+	switch {
+	case list.IsEmpty():
+		v.appendString(" ")
+	case list.GetSize() == 1 && len(list.GetValue(1).GetNote()) == 0:
+		v.formatComponent(iterator.GetNext().GetComponent())
+	default:
+		v.depth_++
+		for iterator.HasNext() {
+			var value = iterator.GetNext()
+			v.appendNewline()
+			v.formatComponent(value.GetComponent())
+			v.appendNote(value.GetNote())
+		}
+		v.depth_--
+		v.appendNewline()
+	}
+}
+
+func (v *formatter_) formatWhileClause(whileClause WhileClauseLike) {
+	v.appendString("while ")
+	v.formatExpression(whileClause.GetCondition().GetExpression())
+	v.appendString(" do ")
+	v.formatProcedure(whileClause.GetProcedure())
+}
+
+func (v *formatter_) formatWithClause(withClause WithClauseLike) {
+	v.appendString("with each ")
+	v.appendString(withClause.GetItem().GetSymbol())
+	v.appendString(" in ")
+	v.formatExpression(withClause.GetSequence().GetExpression())
+	v.appendString(" do ")
+	v.formatProcedure(withClause.GetProcedure())
 }
 
 func (v *formatter_) getResult() string {
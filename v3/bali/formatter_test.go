@@ -0,0 +1,129 @@
+/*
+................................................................................
+.                   Copyright (c) 2024.  All Rights Reserved.                  .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package bali_test
+
+import (
+	bal "github.com/bali-nebula/go-component-framework/v3/bali"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	sts "strings"
+	tes "testing"
+)
+
+// The test documents are shared with v2.
+const testDirectory = "../../v2/bali/test/"
+
+// These v2 test documents use syntax that v3 does not accept. Each difference
+// is covered by its own test in parser_test.go.
+var v2Only = map[string]bool{
+	"elements.bali":   true, // Uppercase exponents and (1, -i).
+	"procedures.bali": true, // Names ending with a dotted version.
+	"strings.bali":    true, // An empty binary and names ending with a dotted version.
+}
+
+// This function returns the v2 test documents that are also valid v3 source.
+func readDocuments() map[string]string {
+	var files, err = osx.ReadDir(testDirectory)
+	if err != nil {
+		panic("Could not find the test directory.")
+	}
+	var documents = map[string]string{}
+	for _, file := range files {
+		var filename = testDirectory + file.Name()
+		if !sts.HasSuffix(filename, ".bali") || v2Only[file.Name()] {
+			continue
+		}
+		documents[filename] = readDocument(filename)
+	}
+	return documents
+}
+
+// This function returns the specified v2 test document.
+func readDocument(filename string) string {
+	var bytes, err = osx.ReadFile(filename)
+	if err != nil {
+		panic("Could not read the test file.")
+	}
+	return string(bytes)
+}
+
+func TestRoundTrips(t *tes.T) {
+	for filename, source := range readDocuments() {
+		// The v2 documents have no header so we add one.
+		source = header + source
+		var document = bal.Parser().Make().ParseSource(source)
+		var formatted = bal.Formatter().Make().FormatDocument(document)
+		ass.Equal(t, source, formatted, filename)
+
+		// Parsing the canonical source must reproduce the same document.
+		document = bal.Parser().Make().ParseSource(formatted)
+		ass.Equal(t, formatted, bal.Formatter().Make().FormatDocument(document), filename)
+	}
+
+	// The remaining documents must be rejected until v3 accepts their syntax.
+	for filename := range v2Only {
+		var source = header + readDocument(testDirectory+filename)
+		var _, err = bal.Parser().Make().TryParseSource(source)
+		ass.Error(t, err, filename)
+	}
+}
+
+func TestFormatCanonical(t *tes.T) {
+	var source = header + `[$first: 1, $second: "two" ($type: $Quote)]
+`
+	var document = bal.Parser().Make().ParseSource(source)
+	var formatted = bal.Formatter().Make().FormatDocument(document)
+	ass.Equal(t, header+`[
+    $first: 1
+    $second: "two"($type: $Quote)
+]
+`, formatted)
+
+	source = header + `{ if x > 1 do { return -x ; break loop } }
+`
+	document = bal.Parser().Make().ParseSource(source)
+	formatted = bal.Formatter().Make().FormatDocument(document)
+	ass.Equal(t, header+`{
+    if x > 1 do {
+        return -x
+        break loop
+    }
+}
+`, formatted)
+
+	// Multi-line tokens keep their indentation relative to the closing line.
+	source = header + `{
+  !>
+    An oddly indented comment.
+
+  <!
+  let $x := [
+         1  ! One.
+         2
+       ]
+}
+`
+	document = bal.Parser().Make().ParseSource(source)
+	formatted = bal.Formatter().Make().FormatDocument(document)
+	ass.Equal(t, header+`{
+    !>
+      An oddly indented comment.
+
+    <!
+    let $x := [
+        1  ! One.
+        2
+    ]
+}
+`, formatted)
+}
@@ -43,10 +43,12 @@ type ifClauseClass_ struct {
 func (c *ifClauseClass_) MakeWithAttributes(
 	condition ConditionLike,
 	procedure ProcedureLike,
+	span SpanLike,
 ) IfClauseLike {
 	return &ifClause_{
 		condition_: condition,
 		procedure_: procedure,
+		span_:      span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *ifClauseClass_) MakeWithAttributes(
 type ifClause_ struct {
 	condition_ ConditionLike
 	procedure_ ProcedureLike
+	span_      SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *ifClause_) GetProcedure() ProcedureLike {
 	return v.procedure_
 }
 
+func (v *ifClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
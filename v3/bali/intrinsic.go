@@ -43,10 +43,12 @@ type intrinsicClass_ struct {
 func (c *intrinsicClass_) MakeWithAttributes(
 	function FunctionLike,
 	arguments ArgumentsLike,
+	span SpanLike,
 ) IntrinsicLike {
 	return &intrinsic_{
 		function_:  function,
 		arguments_: arguments,
+		span_:      span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *intrinsicClass_) MakeWithAttributes(
 type intrinsic_ struct {
 	function_  FunctionLike
 	arguments_ ArgumentsLike
+	span_      SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *intrinsic_) GetArguments() ArgumentsLike {
 	return v.arguments_
 }
 
+func (v *intrinsic_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,9 +40,15 @@ type inversionClass_ struct {
 
 // Constructors
 
-func (c *inversionClass_) MakeWithExpression(expression ExpressionLike) InversionLike {
+func (c *inversionClass_) MakeWithAttributes(
+	operator string,
+	expression ExpressionLike,
+	span SpanLike,
+) InversionLike {
 	return &inversion_{
+		operator_:   operator,
 		expression_: expression,
+		span_:       span,
 	}
 }
 
@@ -53,15 +59,25 @@ func (c *inversionClass_) MakeWithExpression(expression ExpressionLike) Inversio
 // Target
 
 type inversion_ struct {
+	operator_   string
 	expression_ ExpressionLike
+	span_       SpanLike
 }
 
 // Attributes
 
+func (v *inversion_) GetOperator() string {
+	return v.operator_
+}
+
 func (v *inversion_) GetExpression() ExpressionLike {
 	return v.expression_
 }
 
+func (v *inversion_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
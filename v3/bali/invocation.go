@@ -42,13 +42,17 @@ type invocationClass_ struct {
 
 func (c *invocationClass_) MakeWithAttributes(
 	target TargetLike,
+	operator string,
 	method MethodLike,
 	arguments ArgumentsLike,
+	span SpanLike,
 ) InvocationLike {
 	return &invocation_{
 		target_:    target,
+		operator_:  operator,
 		method_:    method,
 		arguments_: arguments,
+		span_:      span,
 	}
 }
 
@@ -60,8 +64,10 @@ func (c *invocationClass_) MakeWithAttributes(
 
 type invocation_ struct {
 	target_    TargetLike
+	operator_  string
 	method_    MethodLike
 	arguments_ ArgumentsLike
+	span_      SpanLike
 }
 
 // Attributes
@@ -70,6 +76,10 @@ func (v *invocation_) GetTarget() TargetLike {
 	return v.target_
 }
 
+func (v *invocation_) GetOperator() string {
+	return v.operator_
+}
+
 func (v *invocation_) GetMethod() MethodLike {
 	return v.method_
 }
@@ -78,6 +88,10 @@ func (v *invocation_) GetArguments() ArgumentsLike {
 	return v.arguments_
 }
 
+func (v *invocation_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -42,11 +42,15 @@ type letClauseClass_ struct {
 
 func (c *letClauseClass_) MakeWithAttributes(
 	recipient RecipientLike,
+	operator string,
 	expression ExpressionLike,
+	span SpanLike,
 ) LetClauseLike {
 	return &letClause_{
 		recipient_:  recipient,
+		operator_:   operator,
 		expression_: expression,
+		span_:       span,
 	}
 }
 
@@ -58,7 +62,9 @@ func (c *letClauseClass_) MakeWithAttributes(
 
 type letClause_ struct {
 	recipient_  RecipientLike
+	operator_   string
 	expression_ ExpressionLike
+	span_       SpanLike
 }
 
 // Attributes
@@ -67,10 +73,18 @@ func (v *letClause_) GetRecipient() RecipientLike {
 	return v.recipient_
 }
 
+func (v *letClause_) GetOperator() string {
+	return v.operator_
+}
+
 func (v *letClause_) GetExpression() ExpressionLike {
 	return v.expression_
 }
 
+func (v *letClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -43,10 +43,12 @@ type lineClass_ struct {
 func (c *lineClass_) MakeWithAttributes(
 	annotation AnnotationLike,
 	statement StatementLike,
+	note string,
 ) LineLike {
 	return &line_{
 		annotation_: annotation,
 		statement_:  statement,
+		note_:       note,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *lineClass_) MakeWithAttributes(
 type line_ struct {
 	annotation_ AnnotationLike
 	statement_  StatementLike
+	note_       string
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *line_) GetStatement() StatementLike {
 	return v.statement_
 }
 
+func (v *line_) GetNote() string {
+	return v.note_
+}
+
 // Public
 
 // Private
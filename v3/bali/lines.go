@@ -48,16 +48,6 @@ func (c *linesClass_) MakeWithLines(lines col.ListLike[LineLike]) LinesLike {
 	}
 }
 
-func (c *linesClass_) MakeWithAttributes(
-	line LineLike,
-	note string,
-) LinesLike {
-	return &lines_{
-		line_: line,
-		note_: note,
-	}
-}
-
 // Functions
 
 // INSTANCE METHODS
@@ -66,8 +56,6 @@ func (c *linesClass_) MakeWithAttributes(
 
 type lines_ struct {
 	lines_ col.ListLike[LineLike]
-	note_  string
-	line_  LineLike
 }
 
 // Attributes
@@ -76,10 +64,6 @@ func (v *lines_) GetLines() col.ListLike[LineLike] {
 	return v.lines_
 }
 
-func (v *lines_) GetNote() string {
-	return v.note_
-}
-
 // Public
 
 // Private
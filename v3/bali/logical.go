@@ -42,9 +42,15 @@ type logicalClass_ struct {
 
 // Constructors
 
-func (c *logicalClass_) MakeWithExpressions(expressions col.ListLike[ExpressionLike]) LogicalLike {
+func (c *logicalClass_) MakeWithAttributes(
+	expressions col.ListLike[ExpressionLike],
+	operator string,
+	span SpanLike,
+) LogicalLike {
 	return &logical_{
 		expressions_: expressions,
+		operator_:    operator,
+		span_:        span,
 	}
 }
 
@@ -56,6 +62,8 @@ func (c *logicalClass_) MakeWithExpressions(expressions col.ListLike[ExpressionL
 
 type logical_ struct {
 	expressions_ col.ListLike[ExpressionLike]
+	operator_    string
+	span_        SpanLike
 }
 
 // Attributes
@@ -64,6 +72,14 @@ func (v *logical_) GetExpressions() col.ListLike[ExpressionLike] {
 	return v.expressions_
 }
 
+func (v *logical_) GetOperator() string {
+	return v.operator_
+}
+
+func (v *logical_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,9 +40,13 @@ type magnitudeClass_ struct {
 
 // Constructors
 
-func (c *magnitudeClass_) MakeWithExpression(expression ExpressionLike) MagnitudeLike {
+func (c *magnitudeClass_) MakeWithAttributes(
+	expression ExpressionLike,
+	span SpanLike,
+) MagnitudeLike {
 	return &magnitude_{
 		expression_: expression,
+		span_:       span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *magnitudeClass_) MakeWithExpression(expression ExpressionLike) Magnitud
 
 type magnitude_ struct {
 	expression_ ExpressionLike
+	span_       SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *magnitude_) GetExpression() ExpressionLike {
 	return v.expression_
 }
 
+func (v *magnitude_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -0,0 +1,76 @@
+/*
+................................................................................
+.                   Copyright (c) 2024.  All Rights Reserved.                  .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package bali
+
+import ()
+
+// CLASS ACCESS
+
+// Reference
+
+var matchingClass = &matchingClass_{
+	// This class has no private constants to initialize.
+}
+
+// Function
+
+func Matching() MatchingClassLike {
+	return matchingClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type matchingClass_ struct {
+	// This class has no private constants.
+}
+
+// Constants
+
+// Constructors
+
+func (c *matchingClass_) MakeWithAttributes(
+	template TemplateLike,
+	procedure ProcedureLike,
+) MatchingLike {
+	return &matching_{
+		template_:  template,
+		procedure_: procedure,
+	}
+}
+
+// Functions
+
+// INSTANCE METHODS
+
+// Target
+
+type matching_ struct {
+	template_  TemplateLike
+	procedure_ ProcedureLike
+}
+
+// Attributes
+
+func (v *matching_) GetTemplate() TemplateLike {
+	return v.template_
+}
+
+func (v *matching_) GetProcedure() ProcedureLike {
+	return v.procedure_
+}
+
+// Public
+
+// Private
@@ -43,10 +43,12 @@ type notarizeClauseClass_ struct {
 func (c *notarizeClauseClass_) MakeWithAttributes(
 	draft DraftLike,
 	citation CitationLike,
+	span SpanLike,
 ) NotarizeClauseLike {
 	return &notarizeClause_{
 		draft_:    draft,
 		citation_: citation,
+		span_:     span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *notarizeClauseClass_) MakeWithAttributes(
 type notarizeClause_ struct {
 	draft_    DraftLike
 	citation_ CitationLike
+	span_     SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *notarizeClause_) GetCitation() CitationLike {
 	return v.citation_
 }
 
+func (v *notarizeClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -12,7 +12,9 @@
 
 package bali
 
-import ()
+import (
+	col "github.com/craterdog/go-collection-framework/v3/collection"
+)
 
 // CLASS ACCESS
 
@@ -42,13 +44,13 @@ type onClauseClass_ struct {
 
 func (c *onClauseClass_) MakeWithAttributes(
 	failure FailureLike,
-	template TemplateLike,
-	procedure ProcedureLike,
+	matchings col.ListLike[MatchingLike],
+	span SpanLike,
 ) OnClauseLike {
 	return &onClause_{
 		failure_:   failure,
-		template_:  template,
-		procedure_: procedure,
+		matchings_: matchings,
+		span_:      span,
 	}
 }
 
@@ -60,8 +62,8 @@ func (c *onClauseClass_) MakeWithAttributes(
 
 type onClause_ struct {
 	failure_   FailureLike
-	template_  TemplateLike
-	procedure_ ProcedureLike
+	matchings_ col.ListLike[MatchingLike]
+	span_      SpanLike
 }
 
 // Attributes
@@ -70,12 +72,12 @@ func (v *onClause_) GetFailure() FailureLike {
 	return v.failure_
 }
 
-func (v *onClause_) GetTemplate() TemplateLike {
-	return v.template_
+func (v *onClause_) GetMatchings() col.ListLike[MatchingLike] {
+	return v.matchings_
 }
 
-func (v *onClause_) GetProcedure() ProcedureLike {
-	return v.procedure_
+func (v *onClause_) GetSpan() SpanLike {
+	return v.span_
 }
 
 // Public
@@ -43,10 +43,14 @@ type parameterClass_ struct {
 func (c *parameterClass_) MakeWithAttributes(
 	symbol string,
 	component ComponentLike,
+	note string,
+	span SpanLike,
 ) ParameterLike {
 	return &parameter_{
 		symbol_:    symbol,
 		component_: component,
+		note_:      note,
+		span_:      span,
 	}
 }
 
@@ -59,6 +63,8 @@ func (c *parameterClass_) MakeWithAttributes(
 type parameter_ struct {
 	symbol_    string
 	component_ ComponentLike
+	note_      string
+	span_      SpanLike
 }
 
 // Attributes
@@ -71,6 +77,14 @@ func (v *parameter_) GetComponent() ComponentLike {
 	return v.component_
 }
 
+func (v *parameter_) GetNote() string {
+	return v.note_
+}
+
+func (v *parameter_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
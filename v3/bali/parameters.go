@@ -48,16 +48,6 @@ func (c *parametersClass_) MakeWithParameters(parameters col.ListLike[ParameterL
 	}
 }
 
-func (c *parametersClass_) MakeWithAttributes(
-	parameter ParameterLike,
-	note string,
-) ParametersLike {
-	return &parameters_{
-		parameter_: parameter,
-		note_:      note,
-	}
-}
-
 // Functions
 
 // INSTANCE METHODS
@@ -66,8 +56,6 @@ func (c *parametersClass_) MakeWithAttributes(
 
 type parameters_ struct {
 	parameters_ col.ListLike[ParameterLike]
-	note_       string
-	parameter_  ParameterLike
 }
 
 // Attributes
@@ -76,10 +64,6 @@ func (v *parameters_) GetParameters() col.ListLike[ParameterLike] {
 	return v.parameters_
 }
 
-func (v *parameters_) GetNote() string {
-	return v.note_
-}
-
 // Public
 
 // Private
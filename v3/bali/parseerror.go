@@ -0,0 +1,184 @@
+/*
+................................................................................
+.                   Copyright (c) 2024.  All Rights Reserved.                  .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package bali
+
+import (
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v3/collection"
+	sts "strings"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var parseErrorClass = &parseErrorClass_{
+	cyan_:   "\033[36m",
+	green_:  "\033[32m",
+	yellow_: "\033[33m",
+	reset_:  "\033[0m",
+}
+
+// Function
+
+func ParseError() ParseErrorClassLike {
+	return parseErrorClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type parseErrorClass_ struct {
+	cyan_   string
+	green_  string
+	yellow_ string
+	reset_  string
+}
+
+// Constants
+
+// Constructors
+
+func (c *parseErrorClass_) MakeWithAttributes(
+	source string,
+	token TokenLike,
+	expected string,
+	rules col.ListLike[string],
+) ParseErrorLike {
+	var message = fmt.Sprintf(
+		"An unexpected token was received by the parser: %v",
+		Scanner().FormatToken(token),
+	)
+	return &parseError_{
+		class_:    c,
+		source_:   source,
+		token_:    token,
+		expected_: expected,
+		rules_:    rules,
+		message_:  message,
+	}
+}
+
+// Functions
+
+// INSTANCE METHODS
+
+// Target
+
+type parseError_ struct {
+	class_    *parseErrorClass_
+	source_   string
+	token_    TokenLike
+	expected_ string
+	rules_    col.ListLike[string]
+	message_  string
+}
+
+// Attributes
+
+func (v *parseError_) GetLine() int {
+	return v.token_.GetLine()
+}
+
+func (v *parseError_) GetPosition() int {
+	return v.token_.GetPosition()
+}
+
+func (v *parseError_) GetToken() TokenLike {
+	return v.token_
+}
+
+func (v *parseError_) GetExpected() string {
+	return v.expected_
+}
+
+func (v *parseError_) GetRules() col.ListLike[string] {
+	return v.rules_
+}
+
+func (v *parseError_) GetMessage() string {
+	return v.message_
+}
+
+// Public
+
+func (v *parseError_) Error() string {
+	return v.render(false)
+}
+
+func (v *parseError_) Colorized() string {
+	return v.render(true)
+}
+
+// Private
+
+/*
+This private instance method renders the parse error along with the source lines
+surrounding the unexpected token and the rules that were expected.  The ANSI
+terminal colors are only included if requested.
+*/
+func (v *parseError_) render(colored bool) string {
+	var cyan, green, yellow, reset string
+	if colored {
+		cyan = v.class_.cyan_
+		green = v.class_.green_
+		yellow = v.class_.yellow_
+		reset = v.class_.reset_
+	}
+	var message = v.message_ + "\n"
+	var line = v.token_.GetLine()
+	var lines = sts.Split(v.source_, "\n")
+
+	// Append the source line with the error in it.
+	message += cyan
+	if line > 1 && line-1 <= len(lines) {
+		message += fmt.Sprintf("%04d: ", line-1) + lines[line-2] + "\n"
+	}
+	if line > 0 && line <= len(lines) {
+		message += fmt.Sprintf("%04d: ", line) + lines[line-1] + "\n"
+	}
+
+	// Append an arrow pointing to the error.
+	message += " " + green + ">>>─"
+	var count = 0
+	for count < v.token_.GetPosition() {
+		message += "─"
+		count++
+	}
+	message += "⌃" + cyan + "\n"
+
+	// Append the following source line for context.
+	if line > 0 && line < len(lines) {
+		message += fmt.Sprintf("%04d: ", line+1) + lines[line] + "\n"
+	}
+	message += reset + "\n"
+
+	// Append the rules that were being parsed.
+	if len(v.expected_) > 0 {
+		message += "Was expecting '" + v.expected_ + "' from:\n"
+		var iterator = v.rules_.GetIterator()
+		for iterator.HasNext() {
+			var name = iterator.GetNext()
+			message += fmt.Sprintf(
+				"  %v%v: %v%v%v\n\n",
+				green,
+				name,
+				yellow,
+				syntax[name],
+				reset,
+			)
+		}
+	}
+	return message
+}
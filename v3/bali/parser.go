@@ -13,9 +13,9 @@
 package bali
 
 import (
-	fmt "fmt"
+	ctx "context"
 	col "github.com/craterdog/go-collection-framework/v3/collection"
-	sts "strings"
+	io "io"
 )
 
 // CLASS ACCESS
@@ -23,8 +23,9 @@ import (
 // Reference
 
 var parserClass = &parserClass_{
-	queueSize_: 16,
-	stackSize_: 4,
+	queueSize_:   16,
+	stackSize_:   4,
+	historySize_: 8,
 }
 
 // Function
@@ -38,8 +39,9 @@ func Parser() ParserClassLike {
 // Target
 
 type parserClass_ struct {
-	queueSize_ int
-	stackSize_ int
+	queueSize_   int
+	stackSize_   int
+	historySize_ int
 }
 
 // Constructors
@@ -48,6 +50,7 @@ func (c *parserClass_) Make() ParserLike {
 	return &parser_{
 		tokens_: col.Queue[TokenLike]().MakeWithCapacity(c.queueSize_),
 		next_:   col.Stack[TokenLike]().MakeWithCapacity(c.stackSize_),
+		size_:   c.historySize_,
 	}
 }
 
@@ -59,24 +62,3107 @@ type parser_ struct {
 	source_ string                   // The original source code.
 	tokens_ col.QueueLike[TokenLike] // A queue of unread tokens from the scanner.
 	next_   col.StackLike[TokenLike] // A stack of read, but unprocessed tokens.
+	last_   []TokenLike              // The most recently processed tokens.
+	size_   int                      // The maximum number of processed tokens kept.
+	done_   bool                     // Whether or not the scanner has finished.
 }
 
 // Public
 
+func (v *parser_) ParseReader(reader io.Reader) DocumentLike {
+	var document, err = v.ParseReaderWithContext(ctx.Background(), reader)
+	if err != nil {
+		panic(err)
+	}
+	return document
+}
+
+func (v *parser_) ParseReaderWithContext(
+	context ctx.Context,
+	reader io.Reader,
+) (
+	document DocumentLike,
+	err error,
+) {
+	// The scanner reads the source in a separate Go routine.  Since the source
+	// is never held in memory as a whole, parse errors are reported without the
+	// surrounding source lines.
+	v.source_ = ""
+	var scanning, cancel = ctx.WithCancel(context)
+	v.startParsing()
+	Scanner().MakeFromReaderWithContext(scanning, reader, v.tokens_)
+	return v.parseWithContext(context, cancel)
+}
+
 func (v *parser_) ParseSource(source string) DocumentLike {
+	var document, err = v.ParseSourceWithContext(ctx.Background(), source)
+	if err != nil {
+		panic(err)
+	}
+	return document
+}
+
+func (v *parser_) ParseSourceWithContext(
+	context ctx.Context,
+	source string,
+) (
+	document DocumentLike,
+	err error,
+) {
 	// The scanner runs in a separate Go routine.
 	v.source_ = source
-	Scanner().Make(v.source_, v.tokens_)
+	var scanning, cancel = ctx.WithCancel(context)
+	v.startParsing()
+	Scanner().MakeWithContext(scanning, v.source_, v.tokens_)
+	return v.parseWithContext(context, cancel)
+}
+
+func (v *parser_) TryParseSource(source string) (
+	document DocumentLike,
+	err error,
+) {
+	// Any parse error is returned rather than passed along as a panic.
+	return v.ParseSourceWithContext(ctx.Background(), source)
+}
+
+// Private
+
+/*
+This private instance method attempts to read the next token from the token
+stream and return it.
+*/
+func (v *parser_) getNextToken() TokenLike {
+	// Check for any read, but unprocessed tokens.
+	if !v.next_.IsEmpty() {
+		return v.next_.RemoveTop()
+	}
+
+	// Read a new token from the token stream.
+	var token, ok = v.tokens_.RemoveHead() // This will wait for a token.
+	if !ok {
+		panic("The token channel terminated without an EOF token.")
+	}
+	switch token.GetType() {
+	case EOFToken, ErrorToken:
+		v.done_ = true // The scanner has finished.
+	}
+
+	// Check for an error token.
+	if token.GetType() == ErrorToken {
+		var err = v.makeError(token, "")
+		panic(err)
+	}
+
+	return token
+}
+
+/*
+This private instance method returns a parse error describing the unexpected
+token and the grammatical rules that were being parsed when it was found.
+*/
+func (v *parser_) makeError(
+	token TokenLike,
+	expected string,
+	names ...string,
+) ParseErrorLike {
+	var rules = col.List[string]().MakeFromArray(names)
+	return ParseError().MakeWithAttributes(v.source_, token, expected, rules)
+}
+
+/*
+This private instance method returns a span that starts with the first operand
+and ends with the last operand of an operation.
+*/
+func (v *parser_) makeOperationSpan(expressions col.ListLike[ExpressionLike]) SpanLike {
+	var first = expressions.GetValue(1).GetSpan()
+	var last = expressions.GetValue(-1).GetSpan()
+	return Span().MakeFromSpans(first, last)
+}
+
+/*
+This private instance method returns the span of source code from the specified
+first token through the most recently processed token.
+*/
+func (v *parser_) makeSpan(first TokenLike) SpanLike {
+	var last = v.last_[len(v.last_)-1]
+	return Span().MakeFromTokens(first, last)
+}
+
+func (v *parser_) parseAcceptClause() (
+	acceptClause AcceptClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "accept" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "accept")
+	if !ok {
+		// This is not an accept clause.
+		return acceptClause, first, false
+	}
+
+	// Attempt to parse a message.
+	var message MessageLike
+	message, token, ok = v.parseMessage()
+	if !ok {
+		var err = v.makeError(token, "Message",
+			"AcceptClause",
+			"Message",
+		)
+		panic(err)
+	}
+
+	// Found an accept clause.
+	var span = v.makeSpan(first)
+	acceptClause = AcceptClause().MakeWithAttributes(message, span)
+	return acceptClause, token, true
+}
+
+func (v *parser_) parseAdditiveOperation() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the first operand.
+	expression, token, ok = v.parseMultiplicativeOperation()
+	if !ok {
+		// This is not an additive operation.
+		return expression, token, false
+	}
+
+	// Attempt to parse any additional operands.
+	var current string
+	var expressions col.ListLike[ExpressionLike]
+	for {
+		var operator string
+		operator, token, ok = v.parseDelimiter("+", "-")
+		if !ok {
+			break
+		}
+		var operand ExpressionLike
+		operand, token, ok = v.parseMultiplicativeOperation()
+		if !ok {
+			var err = v.makeError(token, "Expression",
+				"Arithmetic",
+				"Expression",
+			)
+			panic(err)
+		}
+		if operator == current {
+			expressions.AppendValue(operand)
+			continue
+		}
+		if len(current) > 0 {
+			var span = v.makeOperationSpan(expressions)
+			var arithmetic = Arithmetic().MakeWithAttributes(expressions, current, span)
+			expression = Expression().MakeWithArithmetic(arithmetic)
+		}
+		expressions = col.List[ExpressionLike]().Make()
+		expressions.AppendValue(expression)
+		expressions.AppendValue(operand)
+		current = operator
+	}
+	if len(current) > 0 {
+		var span = v.makeOperationSpan(expressions)
+		var arithmetic = Arithmetic().MakeWithAttributes(expressions, current, span)
+		expression = Expression().MakeWithArithmetic(arithmetic)
+	}
+
+	// Found an additive operation.
+	return expression, token, true
+}
+
+func (v *parser_) parseAnnotation() (
+	annotation AnnotationLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a note or comment.
+	var note string
+	var comment string
+	var first TokenLike
+	note, first, ok = v.parseToken(NoteToken, "")
+	if !ok {
+		comment, first, ok = v.parseToken(CommentToken, "")
+		if !ok {
+			// This is not an annotation.
+			return annotation, first, false
+		}
+	}
+
+	// Attempt to parse an end-of-line character.
+	_, token, ok = v.parseToken(EOLToken, "")
+	if !ok {
+		// This is a trailing note rather than an annotation.
+		v.putBack(first)
+		return annotation, token, false
+	}
+
+	// Found an annotation.
+	annotation = Annotation().MakeWithAttributes(note, comment)
+	return annotation, token, true
+}
+
+func (v *parser_) parseArgument() (
+	argument ArgumentLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not an argument.
+		return argument, token, false
+	}
+
+	// Found an argument.
+	argument = Argument().MakeWithExpression(expression)
+	return argument, token, true
+}
+
+func (v *parser_) parseArguments() (
+	arguments ArgumentsLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the first argument.
+	var argument ArgumentLike
+	argument, token, ok = v.parseArgument()
+	if !ok {
+		// This is not a sequence of arguments.
+		return arguments, token, false
+	}
+
+	// Attempt to parse any additional arguments.
+	var list = col.List[ArgumentLike]().Make()
+	for {
+		list.AppendValue(argument)
+		_, token, ok = v.parseToken(DelimiterToken, ",")
+		if !ok {
+			break
+		}
+		argument, token, ok = v.parseArgument()
+		if !ok {
+			var err = v.makeError(token, "Argument",
+				"Arguments",
+				"Argument",
+			)
+			panic(err)
+		}
+	}
+
+	// Found a sequence of arguments.
+	arguments = Arguments().MakeWithArguments(list)
+	return arguments, token, true
+}
+
+func (v *parser_) parseAssignment() (
+	assignment AssignmentLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a let clause.
+	var letClause LetClauseLike
+	letClause, token, ok = v.parseLetClause()
+	if !ok {
+		// This is not an assignment.
+		return assignment, token, false
+	}
+
+	// Found an assignment.
+	assignment = Assignment().MakeWithLetClause(letClause)
+	return assignment, token, true
+}
+
+func (v *parser_) parseAssociation() (
+	association AssociationLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a key.
+	var key KeyLike
+	var first TokenLike
+	key, first, ok = v.parseKey()
+	if !ok {
+		// This is not an association.
+		return association, first, false
+	}
+
+	// Attempt to parse the ":" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, ":")
+	if !ok {
+		// This is not an association.
+		v.putBack(first)
+		return association, token, false
+	}
+
+	// Attempt to parse a value (whose note belongs to the association).
+	var component ComponentLike
+	component, token, ok = v.parseComponent()
+	if !ok {
+		var err = v.makeError(token, "Value",
+			"Association",
+			"Key",
+			"Value",
+		)
+		panic(err)
+	}
+	var value = Value().MakeWithAttributes(component, "")
+	var span = v.makeSpan(first)
+
+	// Attempt to parse an optional note.
+	var note string
+	note, _, _ = v.parseToken(NoteToken, "")
+
+	// Found an association.
+	association = Association().MakeWithAttributes(key, value, note, span)
+	return association, token, true
+}
+
+func (v *parser_) parseAssociations() (
+	associations AssociationsLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse no associations.
+	var list = col.List[AssociationLike]().Make()
+	_, token, ok = v.parseToken(DelimiterToken, ":")
+	if ok {
+		// Found no associations.
+		associations = Associations().MakeWithAssociations(list)
+		return associations, token, true
+	}
+
+	// Attempt to parse multi-line associations.
+	var association AssociationLike
+	var eol TokenLike
+	_, eol, ok = v.parseToken(EOLToken, "")
+	if ok {
+		association, token, ok = v.parseAssociation()
+		if !ok {
+			// These are not associations.
+			v.putBack(eol)
+			return associations, token, false
+		}
+		for ok {
+			list.AppendValue(association)
+			_, token, ok = v.parseToken(EOLToken, "")
+			if !ok {
+				var err = v.makeError(token, "EOL",
+					"Associations",
+					"Association",
+				)
+				panic(err)
+			}
+			association, token, ok = v.parseAssociation()
+		}
+
+		// Found multi-line associations.
+		associations = Associations().MakeWithAssociations(list)
+		return associations, token, true
+	}
+
+	// Attempt to parse inline associations.
+	association, token, ok = v.parseAssociation()
+	if !ok {
+		// These are not associations.
+		return associations, token, false
+	}
+	for {
+		list.AppendValue(association)
+		_, token, ok = v.parseToken(DelimiterToken, ",")
+		if !ok {
+			break
+		}
+		association, token, ok = v.parseAssociation()
+		if !ok {
+			var err = v.makeError(token, "Association",
+				"Associations",
+				"Association",
+			)
+			panic(err)
+		}
+	}
+
+	// Found inline associations.
+	associations = Associations().MakeWithAssociations(list)
+	return associations, token, true
+}
+
+func (v *parser_) parseAttribute() (
+	attribute AttributeLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a variable.
+	var variable VariableLike
+	var first TokenLike
+	variable, first, ok = v.parseVariable()
+	if !ok {
+		// This is not an attribute.
+		return attribute, first, false
+	}
+
+	// Attempt to parse the "[" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "[")
+	if !ok {
+		// This is not an attribute.
+		v.putBack(first)
+		return attribute, token, false
+	}
+
+	// Attempt to parse a sequence of indices.
+	var indices IndicesLike
+	indices, token, ok = v.parseIndices()
+	if !ok {
+		var err = v.makeError(token, "Indices",
+			"Attribute",
+			"Variable",
+			"Indices",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "]" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "]")
+	if !ok {
+		var err = v.makeError(token, "]",
+			"Attribute",
+			"Variable",
+			"Indices",
+		)
+		panic(err)
+	}
+
+	// Found an attribute.
+	var span = Span().MakeFromTokens(first, token)
+	attribute = Attribute().MakeWithAttributes(variable, indices, span)
+	return attribute, token, true
+}
+
+func (v *parser_) parseBag() (
+	bag BagLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not a bag.
+		return bag, token, false
+	}
+
+	// Found a bag.
+	bag = Bag().MakeWithExpression(expression)
+	return bag, token, true
+}
+
+func (v *parser_) parseBreakClause() (
+	breakClause BreakClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "break" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "break")
+	if !ok {
+		// This is not a break clause.
+		return breakClause, first, false
+	}
+
+	// Attempt to parse the "loop" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "loop")
+	if !ok {
+		var err = v.makeError(token, "loop",
+			"BreakClause",
+		)
+		panic(err)
+	}
+
+	// Found a break clause.
+	var span = Span().MakeFromTokens(first, token)
+	breakClause = BreakClause().MakeWithSpan(span)
+	return breakClause, token, true
+}
+
+func (v *parser_) parseChainingOperation() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the first operand.
+	expression, token, ok = v.parsePostfixOperation()
+	if !ok {
+		// This is not a chaining operation.
+		return expression, token, false
+	}
+
+	// Attempt to parse any additional operands.
+	var expressions = col.List[ExpressionLike]().Make()
+	expressions.AppendValue(expression)
+	for {
+		_, token, ok = v.parseToken(DelimiterToken, "&")
+		if !ok {
+			break
+		}
+		var operand ExpressionLike
+		operand, token, ok = v.parsePostfixOperation()
+		if !ok {
+			var err = v.makeError(token, "Expression",
+				"Chaining",
+				"Expression",
+			)
+			panic(err)
+		}
+		expressions.AppendValue(operand)
+	}
+	if expressions.GetSize() > 1 {
+		var span = v.makeOperationSpan(expressions)
+		var chaining = Chaining().MakeWithAttributes(expressions, span)
+		expression = Expression().MakeWithChaining(chaining)
+	}
+
+	// Found a chaining operation.
+	return expression, token, true
+}
+
+func (v *parser_) parseCheckoutClause() (
+	checkoutClause CheckoutClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "checkout" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "checkout")
+	if !ok {
+		// This is not a checkout clause.
+		return checkoutClause, first, false
+	}
+
+	// Attempt to parse a recipient.
+	var recipient RecipientLike
+	recipient, token, ok = v.parseRecipient()
+	if !ok {
+		var err = v.makeError(token, "Recipient",
+			"CheckoutClause",
+			"Recipient",
+			"Level",
+			"Citation",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse an optional level.
+	var level LevelLike
+	_, token, ok = v.parseToken(DelimiterToken, "at")
+	if ok {
+		_, token, ok = v.parseToken(DelimiterToken, "level")
+		if !ok {
+			var err = v.makeError(token, "level",
+				"CheckoutClause",
+				"Recipient",
+				"Level",
+				"Citation",
+			)
+			panic(err)
+		}
+		level, token, ok = v.parseLevel()
+		if !ok {
+			var err = v.makeError(token, "Level",
+				"CheckoutClause",
+				"Recipient",
+				"Level",
+				"Citation",
+			)
+			panic(err)
+		}
+	}
+
+	// Attempt to parse the "from" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "from")
+	if !ok {
+		var err = v.makeError(token, "from",
+			"CheckoutClause",
+			"Recipient",
+			"Level",
+			"Citation",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a citation.
+	var citation CitationLike
+	citation, token, ok = v.parseCitation()
+	if !ok {
+		var err = v.makeError(token, "Citation",
+			"CheckoutClause",
+			"Recipient",
+			"Level",
+			"Citation",
+		)
+		panic(err)
+	}
+
+	// Found a checkout clause.
+	var span = v.makeSpan(first)
+	checkoutClause = CheckoutClause().MakeWithAttributes(recipient, level, citation, span)
+	return checkoutClause, token, true
+}
+
+func (v *parser_) parseCitation() (
+	citation CitationLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not a citation.
+		return citation, token, false
+	}
+
+	// Found a citation.
+	citation = Citation().MakeWithExpression(expression)
+	return citation, token, true
+}
+
+func (v *parser_) parseCollection() (
+	collection CollectionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "[" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "[")
+	if !ok {
+		// This is not a collection.
+		return collection, token, false
+	}
+
+	// Attempt to parse a sequence of associations or values.
+	var associations AssociationsLike
+	var values ValuesLike
+	associations, token, ok = v.parseAssociations()
+	if !ok {
+		// The values may be empty so this always succeeds.
+		values, token, _ = v.parseValues()
+	}
+
+	// Attempt to parse the "]" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "]")
+	if !ok {
+		var err = v.makeError(token, "]",
+			"Collection",
+			"Associations",
+			"Values",
+		)
+		panic(err)
+	}
+
+	// Found a collection.
+	collection = Collection().MakeWithAttributes(associations, values)
+	return collection, token, true
+}
+
+func (v *parser_) parseComparisonOperation() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the first operand.
+	expression, token, ok = v.parseAdditiveOperation()
+	if !ok {
+		// This is not a comparison operation.
+		return expression, token, false
+	}
+
+	// Attempt to parse any additional operands.
+	var current string
+	var expressions col.ListLike[ExpressionLike]
+	for {
+		var operator string
+		operator, token, ok = v.parseDelimiter("<", "=", ">", "≠", "IS", "MATCHES")
+		if !ok {
+			break
+		}
+		var operand ExpressionLike
+		operand, token, ok = v.parseAdditiveOperation()
+		if !ok {
+			var err = v.makeError(token, "Expression",
+				"Comparison",
+				"Expression",
+			)
+			panic(err)
+		}
+		if operator == current {
+			expressions.AppendValue(operand)
+			continue
+		}
+		if len(current) > 0 {
+			var span = v.makeOperationSpan(expressions)
+			var comparison = Comparison().MakeWithAttributes(expressions, current, span)
+			expression = Expression().MakeWithComparison(comparison)
+		}
+		expressions = col.List[ExpressionLike]().Make()
+		expressions.AppendValue(expression)
+		expressions.AppendValue(operand)
+		current = operator
+	}
+	if len(current) > 0 {
+		var span = v.makeOperationSpan(expressions)
+		var comparison = Comparison().MakeWithAttributes(expressions, current, span)
+		expression = Expression().MakeWithComparison(comparison)
+	}
+
+	// Found a comparison operation.
+	return expression, token, true
+}
+
+func (v *parser_) parseComplement() (
+	complement ComplementLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "NOT" operator.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "NOT")
+	if !ok {
+		// This is not a complement.
+		return complement, first, false
+	}
+
+	// Attempt to parse the operand.
+	var expression ExpressionLike
+	expression, token, ok = v.parseComplementOperation()
+	if !ok {
+		var err = v.makeError(token, "Expression",
+			"Complement",
+			"Expression",
+		)
+		panic(err)
+	}
+
+	// Found a complement.
+	var span = v.makeSpan(first)
+	complement = Complement().MakeWithAttributes(expression, span)
+	return complement, token, true
+}
+
+func (v *parser_) parseComplementOperation() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a complement.
+	var complement ComplementLike
+	complement, token, ok = v.parseComplement()
+	if ok {
+		// Found a complement operation.
+		expression = Expression().MakeWithComplement(complement)
+		return expression, token, true
+	}
+
+	// Attempt to parse a comparison operation.
+	expression, token, ok = v.parseComparisonOperation()
+	if !ok {
+		// This is not a complement operation.
+		return expression, token, false
+	}
+
+	// Found a complement operation.
+	return expression, token, true
+}
+
+func (v *parser_) parseComponent() (
+	component ComponentLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an entity.
+	var first = v.peekToken()
+	var entity EntityLike
+	entity, token, ok = v.parseEntity()
+	if !ok {
+		// This is not a component.
+		return component, token, false
+	}
+
+	// Attempt to parse an optional context.
+	var context ContextLike
+	var next TokenLike
+	context, next, ok = v.parseContext()
+	if ok {
+		token = next
+	}
+
+	// Found a component.
+	var span = v.makeSpan(first)
+	component = Component().MakeWithAttributes(entity, context, span)
+	return component, token, true
+}
+
+func (v *parser_) parseCondition() (
+	condition ConditionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not a condition.
+		return condition, token, false
+	}
+
+	// Found a condition.
+	condition = Condition().MakeWithExpression(expression)
+	return condition, token, true
+}
+
+func (v *parser_) parseContext() (
+	context ContextLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "(" delimiter.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "(")
+	if !ok {
+		// This is not a context.
+		return context, first, false
+	}
+
+	// Attempt to parse a sequence of parameters.
+	var parameters ParametersLike
+	parameters, token, ok = v.parseParameters()
+	if !ok {
+		// This is not a context.
+		v.putBack(first)
+		return context, token, false
+	}
+
+	// Attempt to parse the ")" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, ")")
+	if !ok {
+		var err = v.makeError(token, ")",
+			"Context",
+			"Parameters",
+		)
+		panic(err)
+	}
+
+	// Found a context.
+	context = Context().MakeWithParameters(parameters)
+	return context, token, true
+}
+
+func (v *parser_) parseContinueClause() (
+	continueClause ContinueClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "continue" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "continue")
+	if !ok {
+		// This is not a continue clause.
+		return continueClause, first, false
+	}
+
+	// Attempt to parse the "loop" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "loop")
+	if !ok {
+		var err = v.makeError(token, "loop",
+			"ContinueClause",
+		)
+		panic(err)
+	}
+
+	// Found a continue clause.
+	var span = Span().MakeFromTokens(first, token)
+	continueClause = ContinueClause().MakeWithSpan(span)
+	return continueClause, token, true
+}
+
+/*
+This private instance method attempts to parse any one of the specified
+delimiters and returns the delimiter that was found.
+*/
+func (v *parser_) parseDelimiter(delimiters ...string) (
+	delimiter string,
+	token TokenLike,
+	ok bool,
+) {
+	for _, expected := range delimiters {
+		delimiter, token, ok = v.parseToken(DelimiterToken, expected)
+		if ok {
+			// Found one of the delimiters.
+			return delimiter, token, true
+		}
+	}
+
+	// This is not one of the delimiters.
+	return delimiter, token, false
+}
+
+func (v *parser_) parseDereference() (
+	dereference DereferenceLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "@" operator.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "@")
+	if !ok {
+		// This is not a dereference.
+		return dereference, first, false
+	}
+
+	// Attempt to parse the operand.
+	var expression ExpressionLike
+	expression, token, ok = v.parsePostfixOperation()
+	if !ok {
+		var err = v.makeError(token, "Expression",
+			"Dereference",
+			"Expression",
+		)
+		panic(err)
+	}
+
+	// Found a dereference.
+	var span = v.makeSpan(first)
+	dereference = Dereference().MakeWithAttributes(expression, span)
+	return dereference, token, true
+}
+
+func (v *parser_) parseDiscardClause() (
+	discardClause DiscardClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "discard" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "discard")
+	if !ok {
+		// This is not a discard clause.
+		return discardClause, first, false
+	}
+
+	// Attempt to parse a draft.
+	var draft DraftLike
+	draft, token, ok = v.parseDraft()
+	if !ok {
+		var err = v.makeError(token, "Draft",
+			"DiscardClause",
+			"Draft",
+		)
+		panic(err)
+	}
+
+	// Found a discard clause.
+	var span = v.makeSpan(first)
+	discardClause = DiscardClause().MakeWithAttributes(draft, span)
+	return discardClause, token, true
+}
+
+func (v *parser_) parseDocument() (
+	document DocumentLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a header.
+	var header HeaderLike
+	header, token, ok = v.parseHeader()
+	if !ok {
+		var err = v.makeError(token, "Header",
+			"Document",
+			"Header",
+			"Component",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a component.
+	var component ComponentLike
+	component, token, ok = v.parseComponent()
+	if !ok {
+		var err = v.makeError(token, "Component",
+			"Document",
+			"Header",
+			"Component",
+		)
+		panic(err)
+	}
+
+	// Found a document.
+	document = Document().MakeWithAttributes(header, component)
+	return document, token, true
+}
+
+func (v *parser_) parseDraft() (
+	draft DraftLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not a draft.
+		return draft, token, false
+	}
+
+	// Found a draft.
+	draft = Draft().MakeWithExpression(expression)
+	return draft, token, true
+}
+
+func (v *parser_) parseElement() (
+	element ElementLike,
+	token TokenLike,
+	ok bool,
+) {
+	var value string
+
+	// Attempt to parse an angle.
+	value, token, ok = v.parseToken(AngleToken, "")
+	if ok {
+		element = Element().MakeWithAngle(value)
+		return element, token, true
+	}
+
+	// Attempt to parse a boolean.
+	value, token, ok = v.parseToken(BooleanToken, "")
+	if ok {
+		element = Element().MakeWithBoolean(value)
+		return element, token, true
+	}
+
+	// Attempt to parse a duration.
+	value, token, ok = v.parseToken(DurationToken, "")
+	if ok {
+		element = Element().MakeWithDuration(value)
+		return element, token, true
+	}
+
+	// Attempt to parse a moment.
+	value, token, ok = v.parseToken(MomentToken, "")
+	if ok {
+		element = Element().MakeWithMoment(value)
+		return element, token, true
+	}
+
+	// Attempt to parse a number.
+	value, token, ok = v.parseToken(NumberToken, "")
+	if ok {
+		element = Element().MakeWithNumber(value)
+		return element, token, true
+	}
+
+	// Attempt to parse a pattern.
+	value, token, ok = v.parseToken(PatternToken, "")
+	if ok {
+		element = Element().MakeWithPattern(value)
+		return element, token, true
+	}
+
+	// Attempt to parse a percentage.
+	value, token, ok = v.parseToken(PercentageToken, "")
+	if ok {
+		element = Element().MakeWithPercentage(value)
+		return element, token, true
+	}
+
+	// Attempt to parse a probability.
+	value, token, ok = v.parseToken(ProbabilityToken, "")
+	if ok {
+		element = Element().MakeWithProbability(value)
+		return element, token, true
+	}
+
+	// Attempt to parse a resource.
+	value, token, ok = v.parseToken(ResourceToken, "")
+	if ok {
+		element = Element().MakeWithResource(value)
+		return element, token, true
+	}
+
+	// This is not an element.
+	return element, token, false
+}
+
+func (v *parser_) parseEntity() (
+	entity EntityLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an element.
+	var element ElementLike
+	element, token, ok = v.parseElement()
+	if ok {
+		entity = Entity().MakeWithElement(element)
+		return entity, token, true
+	}
+
+	// Attempt to parse a string.
+	var string_ StringLike
+	string_, token, ok = v.parseString()
+	if ok {
+		entity = Entity().MakeWithString(string_)
+		return entity, token, true
+	}
+
+	// Attempt to parse a range (which must be attempted before a collection).
+	var range_ RangeLike
+	range_, token, ok = v.parseRange()
+	if ok {
+		entity = Entity().MakeWithRange(range_)
+		return entity, token, true
+	}
+
+	// Attempt to parse a collection.
+	var collection CollectionLike
+	collection, token, ok = v.parseCollection()
+	if ok {
+		entity = Entity().MakeWithCollection(collection)
+		return entity, token, true
+	}
+
+	// Attempt to parse a procedure.
+	var procedure ProcedureLike
+	procedure, token, ok = v.parseProcedure()
+	if ok {
+		entity = Entity().MakeWithProcedure(procedure)
+		return entity, token, true
+	}
+
+	// This is not an entity.
+	return entity, token, false
+}
+
+func (v *parser_) parseEvent() (
+	event EventLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not an event.
+		return event, token, false
+	}
+
+	// Found an event.
+	event = Event().MakeWithExpression(expression)
+	return event, token, true
+}
+
+func (v *parser_) parseException() (
+	exception ExceptionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not an exception.
+		return exception, token, false
+	}
+
+	// Found an exception.
+	exception = Exception().MakeWithExpression(expression)
+	return exception, token, true
+}
+
+func (v *parser_) parseExponentialOperation() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the first operand.
+	expression, token, ok = v.parseChainingOperation()
+	if !ok {
+		// This is not an exponential operation.
+		return expression, token, false
+	}
+
+	// Attempt to parse any additional (right associative) operands.
+	var expressions = col.List[ExpressionLike]().Make()
+	expressions.AppendValue(expression)
+	for {
+		_, token, ok = v.parseToken(DelimiterToken, "^")
+		if !ok {
+			break
+		}
+		var operand ExpressionLike
+		operand, token, ok = v.parseChainingOperation()
+		if !ok {
+			var err = v.makeError(token, "Expression",
+				"Exponential",
+				"Expression",
+			)
+			panic(err)
+		}
+		expressions.AppendValue(operand)
+	}
+	if expressions.GetSize() > 1 {
+		var span = v.makeOperationSpan(expressions)
+		var exponential = Exponential().MakeWithAttributes(expressions, span)
+		expression = Expression().MakeWithExponential(exponential)
+	}
+
+	// Found an exponential operation.
+	return expression, token, true
+}
+
+/*
+This private instance method parses an expression using the following operator
+precedence (from lowest to highest):
+  - logical: AND, SANS, OR, XOR
+  - complement: NOT
+  - comparison: <, =, >, ≠, IS, MATCHES
+  - additive: +, -
+  - multiplicative: *, /, //
+  - inversion: -, /, *
+  - exponential: ^ (right associative)
+  - chaining: &
+  - postfix: invocation, subcomponent
+  - primary: component, intrinsic, variable, precedence, dereference, magnitude
+*/
+func (v *parser_) parseExpression() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a logical operation (the lowest precedence).
+	expression, token, ok = v.parseLogicalOperation()
+	if !ok {
+		// This is not an expression.
+		return expression, token, false
+	}
+
+	// Found an expression.
+	return expression, token, true
+}
+
+func (v *parser_) parseFailure() (
+	failure FailureLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a symbol.
+	var symbol string
+	symbol, token, ok = v.parseToken(SymbolToken, "")
+	if !ok {
+		// This is not a failure.
+		return failure, token, false
+	}
+
+	// Found a failure.
+	failure = Failure().MakeWithSymbol(symbol)
+	return failure, token, true
+}
+
+func (v *parser_) parseFlow() (
+	flow FlowLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an if clause.
+	var ifClause IfClauseLike
+	ifClause, token, ok = v.parseIfClause()
+	if ok {
+		flow = Flow().MakeWithIfClause(ifClause)
+		return flow, token, true
+	}
+
+	// Attempt to parse a select clause.
+	var selectClause SelectClauseLike
+	selectClause, token, ok = v.parseSelectClause()
+	if ok {
+		flow = Flow().MakeWithSelectClause(selectClause)
+		return flow, token, true
+	}
+
+	// Attempt to parse a while clause.
+	var whileClause WhileClauseLike
+	whileClause, token, ok = v.parseWhileClause()
+	if ok {
+		flow = Flow().MakeWithWhileClause(whileClause)
+		return flow, token, true
+	}
+
+	// Attempt to parse a with clause.
+	var withClause WithClauseLike
+	withClause, token, ok = v.parseWithClause()
+	if ok {
+		flow = Flow().MakeWithWithClause(withClause)
+		return flow, token, true
+	}
+
+	// Attempt to parse a continue clause.
+	var continueClause ContinueClauseLike
+	continueClause, token, ok = v.parseContinueClause()
+	if ok {
+		flow = Flow().MakeWithContinueClause(continueClause)
+		return flow, token, true
+	}
+
+	// Attempt to parse a break clause.
+	var breakClause BreakClauseLike
+	breakClause, token, ok = v.parseBreakClause()
+	if ok {
+		flow = Flow().MakeWithBreakClause(breakClause)
+		return flow, token, true
+	}
+
+	// Attempt to parse a return clause.
+	var returnClause ReturnClauseLike
+	returnClause, token, ok = v.parseReturnClause()
+	if ok {
+		flow = Flow().MakeWithReturnClause(returnClause)
+		return flow, token, true
+	}
+
+	// Attempt to parse a throw clause.
+	var throwClause ThrowClauseLike
+	throwClause, token, ok = v.parseThrowClause()
+	if ok {
+		flow = Flow().MakeWithThrowClause(throwClause)
+		return flow, token, true
+	}
+
+	// This is not a flow.
+	return flow, token, false
+}
+
+func (v *parser_) parseFunction() (
+	function FunctionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an identifier.
+	var identifier string
+	identifier, token, ok = v.parseToken(IdentifierToken, "")
+	if !ok {
+		// This is not a function.
+		return function, token, false
+	}
+
+	// Found a function.
+	function = Function().MakeWithIdentifier(identifier)
+	return function, token, true
+}
+
+func (v *parser_) parseHeader() (
+	header HeaderLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a comment.
+	var comment string
+	comment, token, ok = v.parseToken(CommentToken, "")
+	if !ok {
+		// This is not a header.
+		return header, token, false
+	}
+
+	// Attempt to parse one or more end-of-line characters.
+	_, token, ok = v.parseToken(EOLToken, "")
+	if !ok {
+		var err = v.makeError(token, "EOL",
+			"Header",
+		)
+		panic(err)
+	}
+	for ok {
+		_, _, ok = v.parseToken(EOLToken, "")
+	}
+
+	// Found a header.
+	header = Header().MakeWithComment(comment)
+	return header, token, true
+}
+
+func (v *parser_) parseIfClause() (
+	ifClause IfClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "if" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "if")
+	if !ok {
+		// This is not an if clause.
+		return ifClause, first, false
+	}
+
+	// Attempt to parse a condition.
+	var condition ConditionLike
+	condition, token, ok = v.parseCondition()
+	if !ok {
+		var err = v.makeError(token, "Condition",
+			"IfClause",
+			"Condition",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "do" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "do")
+	if !ok {
+		var err = v.makeError(token, "do",
+			"IfClause",
+			"Condition",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a procedure.
+	var procedure ProcedureLike
+	procedure, token, ok = v.parseProcedure()
+	if !ok {
+		var err = v.makeError(token, "Procedure",
+			"IfClause",
+			"Condition",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Found an if clause.
+	var span = v.makeSpan(first)
+	ifClause = IfClause().MakeWithAttributes(condition, procedure, span)
+	return ifClause, token, true
+}
+
+func (v *parser_) parseIndex() (
+	index IndexLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not an index.
+		return index, token, false
+	}
+
+	// Found an index.
+	index = Index().MakeWithExpression(expression)
+	return index, token, true
+}
+
+func (v *parser_) parseIndices() (
+	indices IndicesLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the first index.
+	var index IndexLike
+	index, token, ok = v.parseIndex()
+	if !ok {
+		// This is not a sequence of indices.
+		return indices, token, false
+	}
+
+	// Attempt to parse any additional indices.
+	var list = col.List[IndexLike]().Make()
+	for {
+		list.AppendValue(index)
+		_, token, ok = v.parseToken(DelimiterToken, ",")
+		if !ok {
+			break
+		}
+		index, token, ok = v.parseIndex()
+		if !ok {
+			var err = v.makeError(token, "Index",
+				"Indices",
+				"Index",
+			)
+			panic(err)
+		}
+	}
+
+	// Found a sequence of indices.
+	indices = Indices().MakeWithIndexs(list)
+	return indices, token, true
+}
+
+func (v *parser_) parseIntrinsic() (
+	intrinsic IntrinsicLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a function.
+	var function FunctionLike
+	var first TokenLike
+	function, first, ok = v.parseFunction()
+	if !ok {
+		// This is not an intrinsic.
+		return intrinsic, first, false
+	}
+
+	// Attempt to parse the "(" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "(")
+	if !ok {
+		// This is not an intrinsic.
+		v.putBack(first)
+		return intrinsic, token, false
+	}
+
+	// Attempt to parse an optional sequence of arguments.
+	var arguments ArgumentsLike
+	arguments, token, _ = v.parseArguments()
+
+	// Attempt to parse the ")" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, ")")
+	if !ok {
+		var err = v.makeError(token, ")",
+			"Intrinsic",
+			"Function",
+			"Arguments",
+		)
+		panic(err)
+	}
+
+	// Found an intrinsic.
+	var span = v.makeSpan(first)
+	intrinsic = Intrinsic().MakeWithAttributes(function, arguments, span)
+	return intrinsic, token, true
+}
+
+func (v *parser_) parseInversion() (
+	inversion InversionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an inversion operator.
+	var operator string
+	var first TokenLike
+	operator, first, ok = v.parseDelimiter("-", "/", "*")
+	if !ok {
+		// This is not an inversion.
+		return inversion, first, false
+	}
+
+	// Attempt to parse the operand.
+	var expression ExpressionLike
+	expression, token, ok = v.parseInversionOperation()
+	if !ok {
+		var err = v.makeError(token, "Expression",
+			"Inversion",
+			"Expression",
+		)
+		panic(err)
+	}
+
+	// Found an inversion.
+	var span = v.makeSpan(first)
+	inversion = Inversion().MakeWithAttributes(operator, expression, span)
+	return inversion, token, true
+}
+
+func (v *parser_) parseInversionOperation() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an inversion.
+	var inversion InversionLike
+	inversion, token, ok = v.parseInversion()
+	if ok {
+		// Found an inversion operation.
+		expression = Expression().MakeWithInversion(inversion)
+		return expression, token, true
+	}
+
+	// Attempt to parse an exponential operation.
+	expression, token, ok = v.parseExponentialOperation()
+	if !ok {
+		// This is not an inversion operation.
+		return expression, token, false
+	}
+
+	// Found an inversion operation.
+	return expression, token, true
+}
+
+func (v *parser_) parseInvocation(expression ExpressionLike) (
+	invocation InvocationLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an invocation operator.
+	var operator string
+	operator, token, ok = v.parseDelimiter(".", "<-")
+	if !ok {
+		// This is not an invocation.
+		return invocation, token, false
+	}
+
+	// Attempt to parse a method.
+	var method MethodLike
+	method, token, ok = v.parseMethod()
+	if !ok {
+		var err = v.makeError(token, "Method",
+			"Invocation",
+			"Target",
+			"Method",
+			"Arguments",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "(" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "(")
+	if !ok {
+		var err = v.makeError(token, "(",
+			"Invocation",
+			"Target",
+			"Method",
+			"Arguments",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse an optional sequence of arguments.
+	var arguments ArgumentsLike
+	arguments, token, _ = v.parseArguments()
+
+	// Attempt to parse the ")" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, ")")
+	if !ok {
+		var err = v.makeError(token, ")",
+			"Invocation",
+			"Target",
+			"Method",
+			"Arguments",
+		)
+		panic(err)
+	}
+
+	// Found an invocation.
+	var target = Target().MakeWithExpression(expression)
+	var span = Span().MakeFromSpans(expression.GetSpan(), v.makeSpan(token))
+	invocation = Invocation().MakeWithAttributes(
+		target,
+		operator,
+		method,
+		arguments,
+		span,
+	)
+	return invocation, token, true
+}
+
+func (v *parser_) parseItem() (
+	item ItemLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a symbol.
+	var symbol string
+	symbol, token, ok = v.parseToken(SymbolToken, "")
+	if !ok {
+		// This is not an item.
+		return item, token, false
+	}
+
+	// Found an item.
+	item = Item().MakeWithSymbol(symbol)
+	return item, token, true
+}
+
+func (v *parser_) parseKey() (
+	key KeyLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a primitive.
+	var primitive PrimitiveLike
+	primitive, token, ok = v.parsePrimitive()
+	if !ok {
+		// This is not a key.
+		return key, token, false
+	}
+
+	// Found a key.
+	key = Key().MakeWithPrimitive(primitive)
+	return key, token, true
+}
+
+func (v *parser_) parseLetClause() (
+	letClause LetClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an optional recipient and assignment operator.
+	var recipient RecipientLike
+	var operator string
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "let")
+	if ok {
+		recipient, token, ok = v.parseRecipient()
+		if !ok {
+			var err = v.makeError(token, "Recipient",
+				"LetClause",
+				"Recipient",
+			)
+			panic(err)
+		}
+		operator, token, ok = v.parseDelimiter(":=", "?=", "+=", "-=", "*=", "/=")
+		if !ok {
+			var err = v.makeError(token, ":=",
+				"LetClause",
+				"Recipient",
+			)
+			panic(err)
+		}
+	}
+
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		if recipient == nil {
+			// This is not a let clause.
+			return letClause, token, false
+		}
+		var err = v.makeError(token, "Expression",
+			"LetClause",
+			"Recipient",
+		)
+		panic(err)
+	}
+
+	// Found a let clause.
+	var span = expression.GetSpan()
+	if recipient != nil {
+		span = v.makeSpan(first)
+	}
+	letClause = LetClause().MakeWithAttributes(recipient, operator, expression, span)
+	return letClause, token, true
+}
+
+func (v *parser_) parseLevel() (
+	level LevelLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not a level.
+		return level, token, false
+	}
+
+	// Found a level.
+	level = Level().MakeWithExpression(expression)
+	return level, token, true
+}
+
+func (v *parser_) parseLine() (
+	line LineLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an optional annotation.
+	var annotation AnnotationLike
+	var eol TokenLike
+	annotation, eol, ok = v.parseAnnotation()
+
+	// Attempt to parse an optional statement.
+	var statement StatementLike
+	statement, token, ok = v.parseStatement()
+	if !ok && annotation != nil {
+		// A standalone annotation does not consume its end-of-line character.
+		v.putBack(eol)
+	}
+
+	// Attempt to parse an optional note.
+	var note string
+	note, _, _ = v.parseToken(NoteToken, "")
+
+	// Check for a blank line.
+	if annotation == nil && statement == nil && len(note) == 0 {
+		// This is not a line.
+		return line, token, false
+	}
+
+	// Found a line.
+	line = Line().MakeWithAttributes(annotation, statement, note)
+	return line, token, true
+}
+
+func (v *parser_) parseLines() (
+	lines LinesLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse multi-line lines.
+	var list = col.List[LineLike]().Make()
+	var line LineLike
+	_, token, ok = v.parseToken(EOLToken, "")
+	if ok {
+		for {
+			line, token, ok = v.parseLine()
+			if !ok {
+				// A blank line is followed by another end-of-line character.
+				var eol TokenLike
+				_, eol, ok = v.parseToken(EOLToken, "")
+				if !ok {
+					// The previous end-of-line character terminated the lines.
+					break
+				}
+				v.putBack(eol)
+				line = Line().MakeWithAttributes(nil, nil, "")
+			}
+			list.AppendValue(line)
+			_, token, ok = v.parseToken(EOLToken, "")
+			if !ok {
+				var err = v.makeError(token, "EOL",
+					"Lines",
+					"Line",
+				)
+				panic(err)
+			}
+		}
+
+		// Found multi-line lines.
+		lines = Lines().MakeWithLines(list)
+		return lines, token, true
+	}
+
+	// Attempt to parse inline lines.
+	line, token, ok = v.parseLine()
+	if !ok {
+		// Found no lines.
+		lines = Lines().MakeWithLines(list)
+		return lines, token, true
+	}
+	for {
+		list.AppendValue(line)
+		_, token, ok = v.parseToken(DelimiterToken, ";")
+		if !ok {
+			break
+		}
+		line, token, ok = v.parseLine()
+		if !ok {
+			var err = v.makeError(token, "Line",
+				"Lines",
+				"Line",
+			)
+			panic(err)
+		}
+	}
+
+	// Found inline lines.
+	lines = Lines().MakeWithLines(list)
+	return lines, token, true
+}
+
+func (v *parser_) parseLogicalOperation() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the first operand.
+	expression, token, ok = v.parseComplementOperation()
+	if !ok {
+		// This is not a logical operation.
+		return expression, token, false
+	}
+
+	// Attempt to parse any additional operands.
+	var current string
+	var expressions col.ListLike[ExpressionLike]
+	for {
+		var operator string
+		operator, token, ok = v.parseDelimiter("AND", "SANS", "OR", "XOR")
+		if !ok {
+			break
+		}
+		var operand ExpressionLike
+		operand, token, ok = v.parseComplementOperation()
+		if !ok {
+			var err = v.makeError(token, "Expression",
+				"Logical",
+				"Expression",
+			)
+			panic(err)
+		}
+		if operator == current {
+			expressions.AppendValue(operand)
+			continue
+		}
+		if len(current) > 0 {
+			var span = v.makeOperationSpan(expressions)
+			var logical = Logical().MakeWithAttributes(expressions, current, span)
+			expression = Expression().MakeWithLogical(logical)
+		}
+		expressions = col.List[ExpressionLike]().Make()
+		expressions.AppendValue(expression)
+		expressions.AppendValue(operand)
+		current = operator
+	}
+	if len(current) > 0 {
+		var span = v.makeOperationSpan(expressions)
+		var logical = Logical().MakeWithAttributes(expressions, current, span)
+		expression = Expression().MakeWithLogical(logical)
+	}
+
+	// Found a logical operation.
+	return expression, token, true
+}
+
+func (v *parser_) parseMagnitude() (
+	magnitude MagnitudeLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the opening "|" delimiter.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "|")
+	if !ok {
+		// This is not a magnitude.
+		return magnitude, first, false
+	}
+
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		var err = v.makeError(token, "Expression",
+			"Magnitude",
+			"Expression",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the closing "|" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "|")
+	if !ok {
+		var err = v.makeError(token, "|",
+			"Magnitude",
+			"Expression",
+		)
+		panic(err)
+	}
+
+	// Found a magnitude.
+	var span = v.makeSpan(first)
+	magnitude = Magnitude().MakeWithAttributes(expression, span)
+	return magnitude, token, true
+}
+
+func (v *parser_) parseMainClause() (
+	mainClause MainClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a flow.
+	var flow FlowLike
+	flow, token, ok = v.parseFlow()
+	if ok {
+		mainClause = MainClause().MakeWithFlow(flow)
+		return mainClause, token, true
+	}
+
+	// Attempt to parse an assignment.
+	var assignment AssignmentLike
+	assignment, token, ok = v.parseAssignment()
+	if ok {
+		mainClause = MainClause().MakeWithAssignment(assignment)
+		return mainClause, token, true
+	}
+
+	// Attempt to parse a messaging.
+	var messaging MessagingLike
+	messaging, token, ok = v.parseMessaging()
+	if ok {
+		mainClause = MainClause().MakeWithMessaging(messaging)
+		return mainClause, token, true
+	}
+
+	// Attempt to parse a repository.
+	var repository RepositoryLike
+	repository, token, ok = v.parseRepository()
+	if ok {
+		mainClause = MainClause().MakeWithRepository(repository)
+		return mainClause, token, true
+	}
+
+	// This is not a main clause.
+	return mainClause, token, false
+}
+
+func (v *parser_) parseMatching() (
+	matching MatchingLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "matching" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "matching")
+	if !ok {
+		// This is not a matching.
+		return matching, token, false
+	}
+
+	// Attempt to parse a template.
+	var template TemplateLike
+	template, token, ok = v.parseTemplate()
+	if !ok {
+		var err = v.makeError(token, "Template",
+			"Matching",
+			"Template",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "do" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "do")
+	if !ok {
+		var err = v.makeError(token, "do",
+			"Matching",
+			"Template",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a procedure.
+	var procedure ProcedureLike
+	procedure, token, ok = v.parseProcedure()
+	if !ok {
+		var err = v.makeError(token, "Procedure",
+			"Matching",
+			"Template",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Found a matching.
+	matching = Matching().MakeWithAttributes(template, procedure)
+	return matching, token, true
+}
+
+func (v *parser_) parseMatchings(rule string) (
+	matchings col.ListLike[MatchingLike],
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the first matching.
+	var matching MatchingLike
+	matching, token, ok = v.parseMatching()
+	if !ok {
+		var err = v.makeError(token, "matching",
+			rule,
+			"Matching",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse any additional matchings.
+	matchings = col.List[MatchingLike]().Make()
+	for ok {
+		matchings.AppendValue(matching)
+		matching, _, ok = v.parseMatching()
+	}
+
+	// Found one or more matchings.
+	return matchings, token, true
+}
+
+func (v *parser_) parseMessage() (
+	message MessageLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not a message.
+		return message, token, false
+	}
+
+	// Found a message.
+	message = Message().MakeWithExpression(expression)
+	return message, token, true
+}
+
+func (v *parser_) parseMessaging() (
+	messaging MessagingLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a post clause.
+	var postClause PostClauseLike
+	postClause, token, ok = v.parsePostClause()
+	if ok {
+		messaging = Messaging().MakeWithPostClause(postClause)
+		return messaging, token, true
+	}
+
+	// Attempt to parse a retrieve clause.
+	var retrieveClause RetrieveClauseLike
+	retrieveClause, token, ok = v.parseRetrieveClause()
+	if ok {
+		messaging = Messaging().MakeWithRetrieveClause(retrieveClause)
+		return messaging, token, true
+	}
+
+	// Attempt to parse an accept clause.
+	var acceptClause AcceptClauseLike
+	acceptClause, token, ok = v.parseAcceptClause()
+	if ok {
+		messaging = Messaging().MakeWithAcceptClause(acceptClause)
+		return messaging, token, true
+	}
+
+	// Attempt to parse a reject clause.
+	var rejectClause RejectClauseLike
+	rejectClause, token, ok = v.parseRejectClause()
+	if ok {
+		messaging = Messaging().MakeWithRejectClause(rejectClause)
+		return messaging, token, true
+	}
+
+	// Attempt to parse a publish clause.
+	var publishClause PublishClauseLike
+	publishClause, token, ok = v.parsePublishClause()
+	if ok {
+		messaging = Messaging().MakeWithPublishClause(publishClause)
+		return messaging, token, true
+	}
+
+	// This is not a messaging.
+	return messaging, token, false
+}
+
+func (v *parser_) parseMethod() (
+	method MethodLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an identifier.
+	var identifier string
+	identifier, token, ok = v.parseToken(IdentifierToken, "")
+	if !ok {
+		// This is not a method.
+		return method, token, false
+	}
+
+	// Found a method.
+	method = Method().MakeWithIdentifier(identifier)
+	return method, token, true
+}
+
+func (v *parser_) parseMultiplicativeOperation() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the first operand.
+	expression, token, ok = v.parseInversionOperation()
+	if !ok {
+		// This is not a multiplicative operation.
+		return expression, token, false
+	}
+
+	// Attempt to parse any additional operands.
+	var current string
+	var expressions col.ListLike[ExpressionLike]
+	for {
+		var operator string
+		operator, token, ok = v.parseDelimiter("*", "//", "/")
+		if !ok {
+			break
+		}
+		var operand ExpressionLike
+		operand, token, ok = v.parseInversionOperation()
+		if !ok {
+			var err = v.makeError(token, "Expression",
+				"Arithmetic",
+				"Expression",
+			)
+			panic(err)
+		}
+		if operator == current {
+			expressions.AppendValue(operand)
+			continue
+		}
+		if len(current) > 0 {
+			var span = v.makeOperationSpan(expressions)
+			var arithmetic = Arithmetic().MakeWithAttributes(expressions, current, span)
+			expression = Expression().MakeWithArithmetic(arithmetic)
+		}
+		expressions = col.List[ExpressionLike]().Make()
+		expressions.AppendValue(expression)
+		expressions.AppendValue(operand)
+		current = operator
+	}
+	if len(current) > 0 {
+		var span = v.makeOperationSpan(expressions)
+		var arithmetic = Arithmetic().MakeWithAttributes(expressions, current, span)
+		expression = Expression().MakeWithArithmetic(arithmetic)
+	}
+
+	// Found a multiplicative operation.
+	return expression, token, true
+}
+
+func (v *parser_) parseNotarizeClause() (
+	notarizeClause NotarizeClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "notarize" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "notarize")
+	if !ok {
+		// This is not a notarize clause.
+		return notarizeClause, first, false
+	}
+
+	// Attempt to parse a draft.
+	var draft DraftLike
+	draft, token, ok = v.parseDraft()
+	if !ok {
+		var err = v.makeError(token, "Draft",
+			"NotarizeClause",
+			"Draft",
+			"Citation",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "as" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "as")
+	if !ok {
+		var err = v.makeError(token, "as",
+			"NotarizeClause",
+			"Draft",
+			"Citation",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a citation.
+	var citation CitationLike
+	citation, token, ok = v.parseCitation()
+	if !ok {
+		var err = v.makeError(token, "Citation",
+			"NotarizeClause",
+			"Draft",
+			"Citation",
+		)
+		panic(err)
+	}
+
+	// Found a notarize clause.
+	var span = v.makeSpan(first)
+	notarizeClause = NotarizeClause().MakeWithAttributes(draft, citation, span)
+	return notarizeClause, token, true
+}
+
+func (v *parser_) parseOnClause() (
+	onClause OnClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "on" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "on")
+	if !ok {
+		// This is not an on clause.
+		return onClause, first, false
+	}
+
+	// Attempt to parse a failure.
+	var failure FailureLike
+	failure, token, ok = v.parseFailure()
+	if !ok {
+		var err = v.makeError(token, "Failure",
+			"OnClause",
+			"Failure",
+			"Matching",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse one or more matchings.
+	var matchings col.ListLike[MatchingLike]
+	matchings, token, _ = v.parseMatchings("OnClause")
+
+	// Found an on clause.
+	var span = v.makeSpan(first)
+	onClause = OnClause().MakeWithAttributes(failure, matchings, span)
+	return onClause, token, true
+}
+
+func (v *parser_) parseParameter() (
+	parameter ParameterLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a symbol.
+	var symbol string
+	var first TokenLike
+	symbol, first, ok = v.parseToken(SymbolToken, "")
+	if !ok {
+		// This is not a parameter.
+		return parameter, first, false
+	}
+
+	// Attempt to parse the ":" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, ":")
+	if !ok {
+		// This is not a parameter.
+		v.putBack(first)
+		return parameter, token, false
+	}
+
+	// Attempt to parse a component.
+	var component ComponentLike
+	component, token, ok = v.parseComponent()
+	if !ok {
+		var err = v.makeError(token, "Component",
+			"Parameter",
+			"Component",
+		)
+		panic(err)
+	}
+	var span = v.makeSpan(first)
+
+	// Attempt to parse an optional note.
+	var note string
+	note, _, _ = v.parseToken(NoteToken, "")
+
+	// Found a parameter.
+	parameter = Parameter().MakeWithAttributes(symbol, component, note, span)
+	return parameter, token, true
+}
+
+func (v *parser_) parseParameters() (
+	parameters ParametersLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse multi-line parameters.
+	var list = col.List[ParameterLike]().Make()
+	var parameter ParameterLike
+	var eol TokenLike
+	_, eol, ok = v.parseToken(EOLToken, "")
+	if ok {
+		parameter, token, ok = v.parseParameter()
+		if !ok {
+			// These are not parameters.
+			v.putBack(eol)
+			return parameters, token, false
+		}
+		for ok {
+			list.AppendValue(parameter)
+			_, token, ok = v.parseToken(EOLToken, "")
+			if !ok {
+				var err = v.makeError(token, "EOL",
+					"Parameters",
+					"Parameter",
+				)
+				panic(err)
+			}
+			parameter, token, ok = v.parseParameter()
+		}
+
+		// Found multi-line parameters.
+		parameters = Parameters().MakeWithParameters(list)
+		return parameters, token, true
+	}
+
+	// Attempt to parse inline parameters.
+	parameter, token, ok = v.parseParameter()
+	if !ok {
+		// These are not parameters.
+		return parameters, token, false
+	}
+	for {
+		list.AppendValue(parameter)
+		_, token, ok = v.parseToken(DelimiterToken, ",")
+		if !ok {
+			break
+		}
+		parameter, token, ok = v.parseParameter()
+		if !ok {
+			var err = v.makeError(token, "Parameter",
+				"Parameters",
+				"Parameter",
+			)
+			panic(err)
+		}
+	}
+
+	// Found inline parameters.
+	parameters = Parameters().MakeWithParameters(list)
+	return parameters, token, true
+}
+
+func (v *parser_) parsePostClause() (
+	postClause PostClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "post" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "post")
+	if !ok {
+		// This is not a post clause.
+		return postClause, first, false
+	}
+
+	// Attempt to parse a message.
+	var message MessageLike
+	message, token, ok = v.parseMessage()
+	if !ok {
+		var err = v.makeError(token, "Message",
+			"PostClause",
+			"Message",
+			"Bag",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "to" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "to")
+	if !ok {
+		var err = v.makeError(token, "to",
+			"PostClause",
+			"Message",
+			"Bag",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a bag.
+	var bag BagLike
+	bag, token, ok = v.parseBag()
+	if !ok {
+		var err = v.makeError(token, "Bag",
+			"PostClause",
+			"Message",
+			"Bag",
+		)
+		panic(err)
+	}
+
+	// Found a post clause.
+	var span = v.makeSpan(first)
+	postClause = PostClause().MakeWithAttributes(message, bag, span)
+	return postClause, token, true
+}
+
+func (v *parser_) parsePostfixOperation() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a primary expression.
+	expression, token, ok = v.parsePrimaryExpression()
+	if !ok {
+		// This is not a postfix operation.
+		return expression, token, false
+	}
+
+	// Attempt to parse any invocations or subcomponents.
+	for {
+		var invocation InvocationLike
+		invocation, token, ok = v.parseInvocation(expression)
+		if ok {
+			expression = Expression().MakeWithInvocation(invocation)
+			continue
+		}
+		var subcomponent SubcomponentLike
+		subcomponent, token, ok = v.parseSubcomponent(expression)
+		if ok {
+			expression = Expression().MakeWithSubcomponent(subcomponent)
+			continue
+		}
+		break
+	}
+
+	// Found a postfix operation.
+	return expression, token, true
+}
+
+func (v *parser_) parsePrecedence() (
+	precedence PrecedenceLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "(" delimiter.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "(")
+	if !ok {
+		// This is not a precedence.
+		return precedence, first, false
+	}
+
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		var err = v.makeError(token, "Expression",
+			"Precedence",
+			"Expression",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the ")" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, ")")
+	if !ok {
+		var err = v.makeError(token, ")",
+			"Precedence",
+			"Expression",
+		)
+		panic(err)
+	}
+
+	// Found a precedence.
+	var span = v.makeSpan(first)
+	precedence = Precedence().MakeWithAttributes(expression, span)
+	return precedence, token, true
+}
+
+func (v *parser_) parsePrimaryExpression() (
+	expression ExpressionLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a component.
+	var component ComponentLike
+	component, token, ok = v.parseComponent()
+	if ok {
+		expression = Expression().MakeWithComponent(component)
+		return expression, token, true
+	}
+
+	// Attempt to parse an intrinsic (which must be attempted before a variable).
+	var intrinsic IntrinsicLike
+	intrinsic, token, ok = v.parseIntrinsic()
+	if ok {
+		expression = Expression().MakeWithIntrinsic(intrinsic)
+		return expression, token, true
+	}
+
+	// Attempt to parse a variable.
+	var variable VariableLike
+	variable, token, ok = v.parseVariable()
+	if ok {
+		expression = Expression().MakeWithVariable(variable)
+		return expression, token, true
+	}
+
+	// Attempt to parse a precedence.
+	var precedence PrecedenceLike
+	precedence, token, ok = v.parsePrecedence()
+	if ok {
+		expression = Expression().MakeWithPrecedence(precedence)
+		return expression, token, true
+	}
+
+	// Attempt to parse a dereference.
+	var dereference DereferenceLike
+	dereference, token, ok = v.parseDereference()
+	if ok {
+		expression = Expression().MakeWithDereference(dereference)
+		return expression, token, true
+	}
+
+	// Attempt to parse a magnitude.
+	var magnitude MagnitudeLike
+	magnitude, token, ok = v.parseMagnitude()
+	if ok {
+		expression = Expression().MakeWithMagnitude(magnitude)
+		return expression, token, true
+	}
+
+	// This is not a primary expression.
+	return expression, token, false
+}
+
+func (v *parser_) parsePrimitive() (
+	primitive PrimitiveLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an element.
+	var element ElementLike
+	element, token, ok = v.parseElement()
+	if ok {
+		primitive = Primitive().MakeWithElement(element)
+		return primitive, token, true
+	}
+
+	// Attempt to parse a string.
+	var string_ StringLike
+	string_, token, ok = v.parseString()
+	if ok {
+		primitive = Primitive().MakeWithString(string_)
+		return primitive, token, true
+	}
+
+	// This is not a primitive.
+	return primitive, token, false
+}
+
+func (v *parser_) parseProcedure() (
+	procedure ProcedureLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "{" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "{")
+	if !ok {
+		// This is not a procedure.
+		return procedure, token, false
+	}
+
+	// Attempt to parse a sequence of lines (which may be empty).
+	var lines LinesLike
+	lines, token, _ = v.parseLines()
+
+	// Attempt to parse the "}" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "}")
+	if !ok {
+		var err = v.makeError(token, "}",
+			"Procedure",
+			"Lines",
+		)
+		panic(err)
+	}
+
+	// Found a procedure.
+	procedure = Procedure().MakeWithLines(lines)
+	return procedure, token, true
+}
+
+func (v *parser_) parsePublishClause() (
+	publishClause PublishClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "publish" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "publish")
+	if !ok {
+		// This is not a publish clause.
+		return publishClause, first, false
+	}
+
+	// Attempt to parse an event.
+	var event EventLike
+	event, token, ok = v.parseEvent()
+	if !ok {
+		var err = v.makeError(token, "Event",
+			"PublishClause",
+			"Event",
+		)
+		panic(err)
+	}
+
+	// Found a publish clause.
+	var span = v.makeSpan(first)
+	publishClause = PublishClause().MakeWithAttributes(event, span)
+	return publishClause, token, true
+}
+
+func (v *parser_) parseRange() (
+	range_ RangeLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the left bracket.
+	var leftBracket string
+	var first TokenLike
+	leftBracket, first, ok = v.parseDelimiter("[", "(")
+	if !ok {
+		// This is not a range.
+		return range_, first, false
+	}
+
+	// Attempt to parse the first primitive.
+	var primitive PrimitiveLike
+	var second TokenLike
+	primitive, second, ok = v.parsePrimitive()
+	if !ok {
+		// This is not a range.
+		v.putBack(first)
+		return range_, second, false
+	}
+	var primitives = col.List[PrimitiveLike]().Make()
+	primitives.AppendValue(primitive)
+
+	// Attempt to parse the ".." delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "..")
+	if !ok {
+		// This is not a range.
+		v.putBack(second)
+		v.putBack(first)
+		return range_, token, false
+	}
+
+	// Attempt to parse the last primitive.
+	primitive, token, ok = v.parsePrimitive()
+	if !ok {
+		var err = v.makeError(token, "Primitive",
+			"Range",
+			"Primitive",
+		)
+		panic(err)
+	}
+	primitives.AppendValue(primitive)
+
+	// Attempt to parse the right bracket.
+	var rightBracket string
+	rightBracket, token, ok = v.parseDelimiter("]", ")")
+	if !ok {
+		var err = v.makeError(token, "]",
+			"Range",
+			"Primitive",
+		)
+		panic(err)
+	}
+
+	// Found a range.
+	var span = Span().MakeFromTokens(first, token)
+	range_ = Range().MakeWithAttributes(leftBracket, primitives, rightBracket, span)
+	return range_, token, true
+}
+
+func (v *parser_) parseRecipient() (
+	recipient RecipientLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a symbol.
+	var symbol string
+	symbol, token, ok = v.parseToken(SymbolToken, "")
+	if ok {
+		recipient = Recipient().MakeWithSymbol(symbol)
+		return recipient, token, true
+	}
+
+	// Attempt to parse an attribute.
+	var attribute AttributeLike
+	attribute, token, ok = v.parseAttribute()
+	if ok {
+		recipient = Recipient().MakeWithAttribute(attribute)
+		return recipient, token, true
+	}
+
+	// This is not a recipient.
+	return recipient, token, false
+}
+
+func (v *parser_) parseRejectClause() (
+	rejectClause RejectClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "reject" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "reject")
+	if !ok {
+		// This is not a reject clause.
+		return rejectClause, first, false
+	}
+
+	// Attempt to parse a message.
+	var message MessageLike
+	message, token, ok = v.parseMessage()
+	if !ok {
+		var err = v.makeError(token, "Message",
+			"RejectClause",
+			"Message",
+		)
+		panic(err)
+	}
+
+	// Found a reject clause.
+	var span = v.makeSpan(first)
+	rejectClause = RejectClause().MakeWithAttributes(message, span)
+	return rejectClause, token, true
+}
+
+func (v *parser_) parseRepository() (
+	repository RepositoryLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a checkout clause.
+	var checkoutClause CheckoutClauseLike
+	checkoutClause, token, ok = v.parseCheckoutClause()
+	if ok {
+		repository = Repository().MakeWithCheckoutClause(checkoutClause)
+		return repository, token, true
+	}
+
+	// Attempt to parse a save clause.
+	var saveClause SaveClauseLike
+	saveClause, token, ok = v.parseSaveClause()
+	if ok {
+		repository = Repository().MakeWithSaveClause(saveClause)
+		return repository, token, true
+	}
+
+	// Attempt to parse a discard clause.
+	var discardClause DiscardClauseLike
+	discardClause, token, ok = v.parseDiscardClause()
+	if ok {
+		repository = Repository().MakeWithDiscardClause(discardClause)
+		return repository, token, true
+	}
+
+	// Attempt to parse a notarize clause.
+	var notarizeClause NotarizeClauseLike
+	notarizeClause, token, ok = v.parseNotarizeClause()
+	if ok {
+		repository = Repository().MakeWithNotarizeClause(notarizeClause)
+		return repository, token, true
+	}
+
+	// This is not a repository.
+	return repository, token, false
+}
+
+func (v *parser_) parseResult() (
+	result ResultLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not a result.
+		return result, token, false
+	}
+
+	// Found a result.
+	result = Result().MakeWithExpression(expression)
+	return result, token, true
+}
 
+func (v *parser_) parseRetrieveClause() (
+	retrieveClause RetrieveClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "retrieve" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "retrieve")
+	if !ok {
+		// This is not a retrieve clause.
+		return retrieveClause, first, false
+	}
+
+	// Attempt to parse a recipient.
+	var recipient RecipientLike
+	recipient, token, ok = v.parseRecipient()
+	if !ok {
+		var err = v.makeError(token, "Recipient",
+			"RetrieveClause",
+			"Recipient",
+			"Bag",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "from" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "from")
+	if !ok {
+		var err = v.makeError(token, "from",
+			"RetrieveClause",
+			"Recipient",
+			"Bag",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a bag.
+	var bag BagLike
+	bag, token, ok = v.parseBag()
+	if !ok {
+		var err = v.makeError(token, "Bag",
+			"RetrieveClause",
+			"Recipient",
+			"Bag",
+		)
+		panic(err)
+	}
+
+	// Found a retrieve clause.
+	var span = v.makeSpan(first)
+	retrieveClause = RetrieveClause().MakeWithAttributes(recipient, bag, span)
+	return retrieveClause, token, true
+}
+
+func (v *parser_) parseReturnClause() (
+	returnClause ReturnClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "return" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "return")
+	if !ok {
+		// This is not a return clause.
+		return returnClause, first, false
+	}
+
+	// Attempt to parse a result.
+	var result ResultLike
+	result, token, ok = v.parseResult()
+	if !ok {
+		var err = v.makeError(token, "Result",
+			"ReturnClause",
+			"Result",
+		)
+		panic(err)
+	}
+
+	// Found a return clause.
+	var span = v.makeSpan(first)
+	returnClause = ReturnClause().MakeWithAttributes(result, span)
+	return returnClause, token, true
+}
+
+func (v *parser_) parseSaveClause() (
+	saveClause SaveClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "save" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "save")
+	if !ok {
+		// This is not a save clause.
+		return saveClause, first, false
+	}
+
+	// Attempt to parse a draft.
+	var draft DraftLike
+	draft, token, ok = v.parseDraft()
+	if !ok {
+		var err = v.makeError(token, "Draft",
+			"SaveClause",
+			"Draft",
+			"Citation",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "as" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "as")
+	if !ok {
+		var err = v.makeError(token, "as",
+			"SaveClause",
+			"Draft",
+			"Citation",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a citation.
+	var citation CitationLike
+	citation, token, ok = v.parseCitation()
+	if !ok {
+		var err = v.makeError(token, "Citation",
+			"SaveClause",
+			"Draft",
+			"Citation",
+		)
+		panic(err)
+	}
+
+	// Found a save clause.
+	var span = v.makeSpan(first)
+	saveClause = SaveClause().MakeWithAttributes(draft, citation, span)
+	return saveClause, token, true
+}
+
+func (v *parser_) parseSelectClause() (
+	selectClause SelectClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "select" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "select")
+	if !ok {
+		// This is not a select clause.
+		return selectClause, first, false
+	}
+
+	// Attempt to parse a target.
+	var target TargetLike
+	target, token, ok = v.parseTarget()
+	if !ok {
+		var err = v.makeError(token, "Target",
+			"SelectClause",
+			"Target",
+			"Matching",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse one or more matchings.
+	var matchings col.ListLike[MatchingLike]
+	matchings, token, _ = v.parseMatchings("SelectClause")
+
+	// Found a select clause.
+	var span = v.makeSpan(first)
+	selectClause = SelectClause().MakeWithAttributes(target, matchings, span)
+	return selectClause, token, true
+}
+
+func (v *parser_) parseSequence() (
+	sequence SequenceLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not a sequence.
+		return sequence, token, false
+	}
+
+	// Found a sequence.
+	sequence = Sequence().MakeWithExpression(expression)
+	return sequence, token, true
+}
+
+func (v *parser_) parseSource() DocumentLike {
 	// Attempt to parse a model.
 	var model, token, ok = v.parseDocument()
 	if !ok {
-		var message = v.formatError(token)
-		message += v.generateSyntax("Document",
+		var err = v.makeError(token, "Document",
 			"Bali",
 			"Document",
 		)
-		panic(message)
+		panic(err)
 	}
 
 	// Attempt to parse optional end-of-line characters.
@@ -87,106 +3173,218 @@ func (v *parser_) ParseSource(source string) DocumentLike {
 	// Attempt to parse the end-of-file marker.
 	_, token, ok = v.parseToken(EOFToken, "")
 	if !ok {
-		var message = v.formatError(token)
-		message += v.generateSyntax("EOF",
+		var err = v.makeError(token, "EOF",
 			"Bali",
 			"Document",
 		)
-		panic(message)
+		panic(err)
 	}
 
 	// Found a model.
 	return model
 }
 
-// Private
+func (v *parser_) parseStatement() (
+	statement StatementLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a main clause.
+	var first = v.peekToken()
+	var mainClause MainClauseLike
+	mainClause, token, ok = v.parseMainClause()
+	if !ok {
+		// This is not a statement.
+		return statement, token, false
+	}
 
-/*
-This private instance method returns an error message containing the context for
-a parsing error.
-*/
-func (v *parser_) formatError(token TokenLike) string {
-	// Format the error message.
-	var message = fmt.Sprintf(
-		"An unexpected token was received by the parser: %v\n",
-		Scanner().FormatToken(token),
-	)
-	var line = token.GetLine()
-	var lines = sts.Split(v.source_, "\n")
+	// Attempt to parse an optional on clause.
+	var onClause OnClauseLike
+	var next TokenLike
+	onClause, next, ok = v.parseOnClause()
+	if ok {
+		token = next
+	}
+
+	// Found a statement.
+	var span = v.makeSpan(first)
+	statement = Statement().MakeWithAttributes(mainClause, onClause, span)
+	return statement, token, true
+}
+
+func (v *parser_) parseString() (
+	string_ StringLike,
+	token TokenLike,
+	ok bool,
+) {
+	var value string
+
+	// Attempt to parse a binary string.
+	value, token, ok = v.parseToken(BinaryToken, "")
+	if ok {
+		string_ = String().MakeWithBinary(value)
+		return string_, token, true
+	}
+
+	// Attempt to parse a bytecode string.
+	value, token, ok = v.parseToken(BytecodeToken, "")
+	if ok {
+		string_ = String().MakeWithBytecode(value)
+		return string_, token, true
+	}
+
+	// Attempt to parse a name string.
+	value, token, ok = v.parseToken(NameToken, "")
+	if ok {
+		string_ = String().MakeWithName(value)
+		return string_, token, true
+	}
+
+	// Attempt to parse a narrative string.
+	value, token, ok = v.parseToken(NarrativeToken, "")
+	if ok {
+		string_ = String().MakeWithNarrative(value)
+		return string_, token, true
+	}
+
+	// Attempt to parse a quote string.
+	value, token, ok = v.parseToken(QuoteToken, "")
+	if ok {
+		string_ = String().MakeWithQuote(value)
+		return string_, token, true
+	}
 
-	// Append the source line with the error in it.
-	message += "\033[36m"
-	if line > 1 {
-		message += fmt.Sprintf("%04d: ", line-1) + string(lines[line-2]) + "\n"
+	// Attempt to parse a symbol string.
+	value, token, ok = v.parseToken(SymbolToken, "")
+	if ok {
+		string_ = String().MakeWithSymbol(value)
+		return string_, token, true
 	}
-	message += fmt.Sprintf("%04d: ", line) + string(lines[line-1]) + "\n"
 
-	// Append an arrow pointing to the error.
-	message += " \033[32m>>>─"
-	var count = 0
-	for count < token.GetPosition() {
-		message += "─"
-		count++
+	// Attempt to parse a tag string.
+	value, token, ok = v.parseToken(TagToken, "")
+	if ok {
+		string_ = String().MakeWithTag(value)
+		return string_, token, true
 	}
-	message += "⌃\033[36m\n"
 
-	// Append the following source line for context.
-	if line < len(lines) {
-		message += fmt.Sprintf("%04d: ", line+1) + string(lines[line]) + "\n"
+	// Attempt to parse a version string.
+	value, token, ok = v.parseToken(VersionToken, "")
+	if ok {
+		string_ = String().MakeWithVersion(value)
+		return string_, token, true
 	}
-	message += "\033[0m\n"
 
-	return message
+	// This is not a string.
+	return string_, token, false
 }
 
-/*
-This private instance method is useful when creating scanner and parser error
-messages that include the required grammatical rules.
-*/
-func (v *parser_) generateSyntax(expected string, names ...string) string {
-	var message = "Was expecting '" + expected + "' from:\n"
-	for _, name := range names {
-		message += fmt.Sprintf(
-			"  \033[32m%v: \033[33m%v\033[0m\n\n",
-			name,
-			syntax[name],
+func (v *parser_) parseSubcomponent(expression ExpressionLike) (
+	subcomponent SubcomponentLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "[" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "[")
+	if !ok {
+		// This is not a subcomponent.
+		return subcomponent, token, false
+	}
+
+	// Attempt to parse a sequence of indices.
+	var indices IndicesLike
+	indices, token, ok = v.parseIndices()
+	if !ok {
+		var err = v.makeError(token, "Indices",
+			"Subcomponent",
+			"Composite",
+			"Indices",
 		)
+		panic(err)
 	}
-	return message
-}
 
-/*
-This private instance method attempts to read the next token from the token
-stream and return it.
-*/
-func (v *parser_) getNextToken() TokenLike {
-	// Check for any read, but unprocessed tokens.
-	if !v.next_.IsEmpty() {
-		return v.next_.RemoveTop()
+	// Attempt to parse the "]" delimiter.
+	_, token, ok = v.parseToken(DelimiterToken, "]")
+	if !ok {
+		var err = v.makeError(token, "]",
+			"Subcomponent",
+			"Composite",
+			"Indices",
+		)
+		panic(err)
 	}
 
-	// Read a new token from the token stream.
-	var token, ok = v.tokens_.RemoveHead() // This will wait for a token.
+	// Found a subcomponent.
+	var composite = Composite().MakeWithExpression(expression)
+	var span = Span().MakeFromSpans(expression.GetSpan(), v.makeSpan(token))
+	subcomponent = Subcomponent().MakeWithAttributes(composite, indices, span)
+	return subcomponent, token, true
+}
+
+func (v *parser_) parseTarget() (
+	target TargetLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
 	if !ok {
-		panic("The token channel terminated without an EOF token.")
+		// This is not a target.
+		return target, token, false
 	}
 
-	// Check for an error token.
-	if token.GetType() == ErrorToken {
-		var message = v.formatError(token)
-		panic(message)
+	// Found a target.
+	target = Target().MakeWithExpression(expression)
+	return target, token, true
+}
+
+func (v *parser_) parseTemplate() (
+	template TemplateLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an expression.
+	var expression ExpressionLike
+	expression, token, ok = v.parseExpression()
+	if !ok {
+		// This is not a template.
+		return template, token, false
 	}
 
-	return token
+	// Found a template.
+	template = Template().MakeWithExpression(expression)
+	return template, token, true
 }
 
-func (v *parser_) parseDocument() (
-	document DocumentLike,
+func (v *parser_) parseThrowClause() (
+	throwClause ThrowClauseLike,
 	token TokenLike,
 	ok bool,
 ) {
-	// TBA - Add real method implementation.
-	return document, token, ok
+	// Attempt to parse the "throw" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "throw")
+	if !ok {
+		// This is not a throw clause.
+		return throwClause, first, false
+	}
+
+	// Attempt to parse an exception.
+	var exception ExceptionLike
+	exception, token, ok = v.parseException()
+	if !ok {
+		var err = v.makeError(token, "Exception",
+			"ThrowClause",
+			"Exception",
+		)
+		panic(err)
+	}
+
+	// Found a throw clause.
+	var span = v.makeSpan(first)
+	throwClause = ThrowClause().MakeWithAttributes(exception, span)
+	return throwClause, token, true
 }
 
 func (v *parser_) parseToken(expectedType TokenType, expectedValue string) (
@@ -201,6 +3399,10 @@ func (v *parser_) parseToken(expectedType TokenType, expectedValue string) (
 		var constrained = len(expectedValue) > 0
 		if !constrained || value == expectedValue {
 			// Found the expected token.
+			if len(v.last_) == v.size_ {
+				v.last_ = v.last_[1:]
+			}
+			v.last_ = append(v.last_, token)
 			return value, token, true
 		}
 	}
@@ -210,11 +3412,508 @@ func (v *parser_) parseToken(expectedType TokenType, expectedValue string) (
 	return "", token, false
 }
 
+func (v *parser_) parseValue() (
+	value ValueLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a component.
+	var component ComponentLike
+	component, token, ok = v.parseComponent()
+	if !ok {
+		// This is not a value.
+		return value, token, false
+	}
+
+	// Attempt to parse an optional note.
+	var note string
+	note, _, _ = v.parseToken(NoteToken, "")
+
+	// Found a value.
+	value = Value().MakeWithAttributes(component, note)
+	return value, token, true
+}
+
+func (v *parser_) parseValues() (
+	values ValuesLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse multi-line values.
+	var list = col.List[ValueLike]().Make()
+	var value ValueLike
+	var eol TokenLike
+	_, eol, ok = v.parseToken(EOLToken, "")
+	if ok {
+		value, token, ok = v.parseValue()
+		if !ok {
+			// These are not multi-line values.
+			v.putBack(eol)
+		}
+		for ok {
+			list.AppendValue(value)
+			_, token, ok = v.parseToken(EOLToken, "")
+			if !ok {
+				var err = v.makeError(token, "EOL",
+					"Values",
+					"Value",
+				)
+				panic(err)
+			}
+			value, token, ok = v.parseValue()
+		}
+		if !list.IsEmpty() {
+			// Found multi-line values.
+			values = Values().MakeWithValues(list)
+			return values, token, true
+		}
+	}
+
+	// Attempt to parse inline values.
+	value, token, ok = v.parseValue()
+	if !ok {
+		// Found no values.
+		values = Values().MakeWithValues(list)
+		return values, token, true
+	}
+	for {
+		list.AppendValue(value)
+		_, token, ok = v.parseToken(DelimiterToken, ",")
+		if !ok {
+			break
+		}
+		value, token, ok = v.parseValue()
+		if !ok {
+			var err = v.makeError(token, "Value",
+				"Values",
+				"Value",
+			)
+			panic(err)
+		}
+	}
+
+	// Found inline values.
+	values = Values().MakeWithValues(list)
+	return values, token, true
+}
+
+func (v *parser_) parseVariable() (
+	variable VariableLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse an identifier.
+	var identifier string
+	identifier, token, ok = v.parseToken(IdentifierToken, "")
+	if !ok {
+		// This is not a variable.
+		return variable, token, false
+	}
+
+	// Found a variable.
+	var span = v.makeSpan(token)
+	variable = Variable().MakeWithAttributes(identifier, span)
+	return variable, token, true
+}
+
+func (v *parser_) parseWhileClause() (
+	whileClause WhileClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "while" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "while")
+	if !ok {
+		// This is not a while clause.
+		return whileClause, first, false
+	}
+
+	// Attempt to parse a condition.
+	var condition ConditionLike
+	condition, token, ok = v.parseCondition()
+	if !ok {
+		var err = v.makeError(token, "Condition",
+			"WhileClause",
+			"Condition",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "do" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "do")
+	if !ok {
+		var err = v.makeError(token, "do",
+			"WhileClause",
+			"Condition",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a procedure.
+	var procedure ProcedureLike
+	procedure, token, ok = v.parseProcedure()
+	if !ok {
+		var err = v.makeError(token, "Procedure",
+			"WhileClause",
+			"Condition",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Found a while clause.
+	var span = v.makeSpan(first)
+	whileClause = WhileClause().MakeWithAttributes(condition, procedure, span)
+	return whileClause, token, true
+}
+
+func (v *parser_) parseWithClause() (
+	withClause WithClauseLike,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse the "with" keyword.
+	var first TokenLike
+	_, first, ok = v.parseToken(DelimiterToken, "with")
+	if !ok {
+		// This is not a with clause.
+		return withClause, first, false
+	}
+
+	// Attempt to parse the "each" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "each")
+	if !ok {
+		var err = v.makeError(token, "each",
+			"WithClause",
+			"Item",
+			"Sequence",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse an item.
+	var item ItemLike
+	item, token, ok = v.parseItem()
+	if !ok {
+		var err = v.makeError(token, "Item",
+			"WithClause",
+			"Item",
+			"Sequence",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "in" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "in")
+	if !ok {
+		var err = v.makeError(token, "in",
+			"WithClause",
+			"Item",
+			"Sequence",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a sequence.
+	var sequence SequenceLike
+	sequence, token, ok = v.parseSequence()
+	if !ok {
+		var err = v.makeError(token, "Sequence",
+			"WithClause",
+			"Item",
+			"Sequence",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse the "do" keyword.
+	_, token, ok = v.parseToken(DelimiterToken, "do")
+	if !ok {
+		var err = v.makeError(token, "do",
+			"WithClause",
+			"Item",
+			"Sequence",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Attempt to parse a procedure.
+	var procedure ProcedureLike
+	procedure, token, ok = v.parseProcedure()
+	if !ok {
+		var err = v.makeError(token, "Procedure",
+			"WithClause",
+			"Item",
+			"Sequence",
+			"Procedure",
+		)
+		panic(err)
+	}
+
+	// Found a with clause.
+	var span = v.makeSpan(first)
+	withClause = WithClause().MakeWithAttributes(item, sequence, procedure, span)
+	return withClause, token, true
+}
+
+/*
+This private instance method parses the tokens from the scanner into a document.
+Any parse error is returned rather than passed along as a panic.  Once parsing
+has finished, whether or not it succeeded, the scanner is stopped.  If the
+specified context is done before parsing finishes, its error is returned.
+*/
+func (v *parser_) parseWithContext(
+	context ctx.Context,
+	cancel ctx.CancelFunc,
+) (
+	document DocumentLike,
+	err error,
+) {
+	defer func() {
+		var e = recover()
+		v.stopScanning(cancel)
+		if e == nil {
+			return
+		}
+		if context.Err() != nil {
+			err = context.Err()
+			return
+		}
+		var parseError, ok = e.(ParseErrorLike)
+		if !ok {
+			panic(e)
+		}
+		err = parseError
+	}()
+	document = v.parseSource()
+	return document, err
+}
+
+/*
+This private instance method returns the next token without processing it.
+*/
+func (v *parser_) peekToken() TokenLike {
+	var token = v.getNextToken()
+	v.next_.AddValue(token)
+	return token
+}
+
 func (v *parser_) putBack(token TokenLike) {
 	//fmt.Printf("Put Back %v\n", token)
+	var count = len(v.last_)
+	if count > 0 && v.last_[count-1] == token {
+		// The token is no longer processed.
+		v.last_ = v.last_[:count-1]
+	}
 	v.next_.AddValue(token)
 }
 
+/*
+This private instance method prepares the parser for parsing a new stream of
+tokens.  A new queue is used since any tokens left over from a previous stream
+may still be draining from its queue.  Any tokens left over from a previous
+parse that failed are discarded.
+*/
+func (v *parser_) startParsing() {
+	var capacity = v.tokens_.GetCapacity()
+	v.tokens_ = col.Queue[TokenLike]().MakeWithCapacity(capacity)
+	v.next_.RemoveAll()
+	v.last_ = nil
+	v.done_ = false
+}
+
+/*
+This private instance method cancels the scanner if it has not yet finished.  A
+canceled scanner emits a final error token so any remaining tokens are drained
+from the queue in the background, allowing a blocked scanner Go routine to exit.
+*/
+func (v *parser_) stopScanning(cancel ctx.CancelFunc) {
+	cancel()
+	if v.done_ {
+		return
+	}
+	var tokens = v.tokens_
+	go func() {
+		for {
+			var token, ok = tokens.RemoveHead()
+			if !ok {
+				return
+			}
+			switch token.GetType() {
+			case EOFToken, ErrorToken:
+				return
+			}
+		}
+	}()
+}
+
 var syntax = map[string]string{
-	"Bali": "Document EOL* EOF  ! Terminated with an end-of-file marker.",
+	"Bali":      `Document EOL* EOF  ! Terminated with an end-of-file marker.`,
+	"Document":  `Header Component`,
+	"Header":    `comment EOL+`,
+	"Component": `Entity Context?`,
+	"Entity": `
+    Element
+    String
+    Range
+    Collection
+    Procedure`,
+	"Context": `"(" Parameters ")"`,
+	"Parameters": `
+    Parameter ("," Parameter)*
+    (EOL Parameter note?)+ EOL`,
+	"Parameter": `symbol ":" Component`,
+	"Element": `
+    angle
+    boolean
+    duration
+    moment
+    number
+    pattern
+    percentage
+    probability
+    resource`,
+	"String": `
+    binary
+    bytecode
+    name
+    narrative
+    quote
+    symbol
+    tag
+    version`,
+	"Range": `("[" | "(") Primitive ".." Primitive ("]" | ")")`,
+	"Primitive": `
+    Element
+    String`,
+	"Collection": `"[" (Associations | Values) "]"`,
+	"Associations": `
+    Association ("," Association)*
+    (EOL Association note?)+ EOL
+    ":"  ! No associations.`,
+	"Association": `Key ":" Value`,
+	"Key":         `Primitive`,
+	"Values": `
+    Value ("," Value)*
+    (EOL Value note?)+ EOL
+    " "  ! No values.`,
+	"Value":     `Component`,
+	"Procedure": `"{" Lines "}"`,
+	"Lines": `
+    Line (";" Line)*
+    (EOL Line note?)+ EOL
+    " "  ! No lines.`,
+	"Line":       `Annotation? Statement?  ! Allows blank lines.`,
+	"Annotation": `(note | comment) EOL`,
+	"Statement":  `MainClause OnClause?`,
+	"MainClause": `
+    Flow
+    Assignment
+    Messaging
+    Repository`,
+	"Flow": `
+    IfClause
+    SelectClause
+    WhileClause
+    WithClause
+    ContinueClause
+    BreakClause
+    ReturnClause
+    ThrowClause`,
+	"Assignment": `
+    LetClause`,
+	"Messaging": `
+    PostClause
+    RetrieveClause
+    AcceptClause
+    RejectClause
+    PublishClause`,
+	"Repository": `
+    CheckoutClause
+    SaveClause
+    DiscardClause
+    NotarizeClause`,
+	"IfClause":       `"if" Condition "do" Procedure`,
+	"Condition":      `Expression`,
+	"SelectClause":   `"select" Target Matching+`,
+	"Target":         `Expression`,
+	"Matching":       `"matching" Template "do" Procedure`,
+	"Template":       `Expression`,
+	"WhileClause":    `"while" Condition "do" Procedure`,
+	"WithClause":     `"with" "each" Item "in" Sequence "do" Procedure`,
+	"Item":           `symbol`,
+	"Sequence":       `Expression`,
+	"ContinueClause": `"continue" "loop"`,
+	"BreakClause":    `"break" "loop"`,
+	"ReturnClause":   `"return" Result`,
+	"Result":         `Expression`,
+	"ThrowClause":    `"throw" Exception`,
+	"Exception":      `Expression`,
+	"LetClause":      `("let" Recipient (":=" | "?=" | "+=" | "-=" | "*=" | "/="))? Expression`,
+	"Recipient": `
+    symbol
+    Attribute`,
+	"Attribute":      `Variable "[" Indices "]"`,
+	"PostClause":     `"post" Message "to" Bag`,
+	"Message":        `Expression`,
+	"Bag":            `Expression`,
+	"RetrieveClause": `"retrieve" Recipient "from" Bag`,
+	"AcceptClause":   `"accept" Message`,
+	"RejectClause":   `"reject" Message`,
+	"PublishClause":  `"publish" Event`,
+	"Event":          `Expression`,
+	"CheckoutClause": `"checkout" Recipient ("at" "level" Level)? "from" Citation`,
+	"Level":          `Expression`,
+	"Citation":       `Expression`,
+	"SaveClause":     `"save" Draft "as" Citation`,
+	"Draft":          `Expression`,
+	"DiscardClause":  `"discard" Draft`,
+	"NotarizeClause": `"notarize" Draft "as" Citation`,
+	"OnClause":       `"on" Failure Matching+`,
+	"Failure":        `symbol`,
+	"Expression": `
+    Component
+    Intrinsic
+    Variable
+    Precedence
+    Dereference
+    Invocation
+    Subcomponent
+    Chaining
+    Exponential
+    Inversion
+    Arithmetic
+    Magnitude
+    Comparison
+    Complement
+    Logical`,
+	"Intrinsic":    `Function "(" Arguments? ")"`,
+	"Function":     `identifier`,
+	"Arguments":    `Argument ("," Argument)*`,
+	"Argument":     `Expression`,
+	"Variable":     `identifier`,
+	"Precedence":   `"(" Expression ")"`,
+	"Dereference":  `"@" Expression`,
+	"Invocation":   `Target ("." | "<-") Method "(" Arguments? ")"`,
+	"Method":       `identifier`,
+	"Subcomponent": `Composite "[" Indices "]"`,
+	"Composite":    `Expression`,
+	"Indices":      `Index ("," Index)*`,
+	"Index":        `Expression`,
+	"Chaining":     `Expression "&" Expression`,
+	"Exponential":  `Expression "^" Expression`,
+	"Inversion":    `("-" | "/" | "*") Expression`,
+	"Arithmetic":   `Expression ("*" | "/" | "//" | "+" | "-") Expression`,
+	"Magnitude":    `"|" Expression "|"`,
+	"Comparison":   `Expression ("<" | "=" | ">" | "≠" | "IS" | "MATCHES") Expression`,
+	"Complement":   `"NOT" Expression`,
+	"Logical":      `Expression ("AND" | "SANS" | "OR" | "XOR") Expression`,
 }
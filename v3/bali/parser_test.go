@@ -0,0 +1,387 @@
+/*
+................................................................................
+.                   Copyright (c) 2024.  All Rights Reserved.                  .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package bali_test
+
+import (
+	ctx "context"
+	bal "github.com/bali-nebula/go-component-framework/v3/bali"
+	ass "github.com/stretchr/testify/assert"
+	io "io"
+	run "runtime"
+	sts "strings"
+	tes "testing"
+	iot "testing/iotest"
+	tim "time"
+)
+
+const header = `!>
+................................................................................
+.                   Copyright (c) 2024.  All Rights Reserved.                  .
+................................................................................
+<!
+
+`
+
+func parseLines(t *tes.T, source string) []bal.LineLike {
+	var document = bal.Parser().Make().ParseSource(header + source)
+	var entity = document.GetComponent().GetEntity()
+	var procedure = entity.GetProcedure()
+	ass.NotNil(t, procedure)
+	return procedure.GetLines().GetLines().AsArray()
+}
+
+func parseExpression(t *tes.T, source string) bal.ExpressionLike {
+	var lines = parseLines(t, "{ "+source+" }")
+	ass.Equal(t, 1, len(lines))
+	var mainClause = lines[0].GetStatement().GetMainClause()
+	return mainClause.GetAssignment().GetLetClause().GetExpression()
+}
+
+func TestParseComponents(t *tes.T) {
+	var document = bal.Parser().Make().ParseSource(header + `[
+    $first: [1..5)  ! A note.
+    $second: "two" ($type: /bali/types/Quote, $size: 3)
+    $third: [ ]
+    $fourth: [:]
+    $fifth: [~π, <2009-04-01>, #ABCD]
+]
+`)
+	ass.NotNil(t, document.GetHeader())
+	var component = document.GetComponent()
+	ass.Nil(t, component.GetContext())
+	var collection = component.GetEntity().GetCollection()
+	ass.Nil(t, collection.GetValues())
+	var associations = collection.GetAssociations().GetAssociations().AsArray()
+	ass.Equal(t, 5, len(associations))
+
+	var range_ = associations[0].GetValue().GetComponent().GetEntity().GetRange()
+	ass.Equal(t, "[", range_.GetLeftBracket())
+	ass.Equal(t, 2, range_.GetPrimitives().GetSize())
+	ass.Equal(t, ")", range_.GetRightBracket())
+	ass.Equal(t, "! A note.", associations[0].GetNote())
+
+	var context = associations[1].GetValue().GetComponent().GetContext()
+	ass.Equal(t, 2, context.GetParameters().GetParameters().GetSize())
+
+	var empty = associations[2].GetValue().GetComponent().GetEntity().GetCollection()
+	ass.Equal(t, 0, empty.GetValues().GetValues().GetSize())
+	empty = associations[3].GetValue().GetComponent().GetEntity().GetCollection()
+	ass.Equal(t, 0, empty.GetAssociations().GetAssociations().GetSize())
+
+	var values = associations[4].GetValue().GetComponent().GetEntity().GetCollection()
+	ass.Equal(t, 3, values.GetValues().GetValues().GetSize())
+}
+
+func TestParseStatements(t *tes.T) {
+	var lines = parseLines(t, `{
+    ! This is an annotation.
+    if true do { }
+
+    select $x matching 1 do { break loop } matching 2 do { continue loop }
+    with each $item in [1..3] do {
+        let list[$item] += 1  ! A trailing note.
+    }
+    post message to bag
+    retrieve $message from bag on $failure matching any do { }
+    checkout $draft at level 2 from /bali/drafts/Draft
+    save draft as $citation
+    !>
+        A commented out statement.
+    <!
+}
+`)
+	ass.Equal(t, 9, len(lines))
+
+	var annotation = lines[0].GetAnnotation()
+	ass.Equal(t, "! This is an annotation.", annotation.GetNote())
+	ass.NotNil(t, lines[0].GetStatement().GetMainClause().GetFlow().GetIfClause())
+
+	ass.Nil(t, lines[1].GetAnnotation())
+	ass.Nil(t, lines[1].GetStatement())
+
+	var selectClause = lines[2].GetStatement().GetMainClause().GetFlow().GetSelectClause()
+	ass.Equal(t, 2, selectClause.GetMatchings().GetSize())
+
+	var withClause = lines[3].GetStatement().GetMainClause().GetFlow().GetWithClause()
+	ass.Equal(t, "$item", withClause.GetItem().GetSymbol())
+	var inner = withClause.GetProcedure().GetLines().GetLines().AsArray()
+	ass.Equal(t, "! A trailing note.", inner[0].GetNote())
+	var letClause = inner[0].GetStatement().GetMainClause().GetAssignment().GetLetClause()
+	ass.Equal(t, "+=", letClause.GetOperator())
+	ass.NotNil(t, letClause.GetRecipient().GetAttribute())
+
+	ass.NotNil(t, lines[4].GetStatement().GetMainClause().GetMessaging().GetPostClause())
+	var statement = lines[5].GetStatement()
+	ass.NotNil(t, statement.GetMainClause().GetMessaging().GetRetrieveClause())
+	ass.Equal(t, "$failure", statement.GetOnClause().GetFailure().GetSymbol())
+
+	var checkoutClause = lines[6].GetStatement().GetMainClause().GetRepository().GetCheckoutClause()
+	ass.NotNil(t, checkoutClause.GetLevel())
+	ass.NotNil(t, lines[7].GetStatement().GetMainClause().GetRepository().GetSaveClause())
+
+	ass.NotEqual(t, "", lines[8].GetAnnotation().GetComment())
+	ass.Nil(t, lines[8].GetStatement())
+}
+
+func TestParseExpressions(t *tes.T) {
+	// Multiplication takes precedence over addition.
+	var expression = parseExpression(t, "a + b * c - d")
+	var arithmetic = expression.GetArithmetic()
+	ass.Equal(t, "-", arithmetic.GetOperator())
+	var operands = arithmetic.GetExpressions().AsArray()
+	ass.Equal(t, "+", operands[0].GetArithmetic().GetOperator())
+	ass.Equal(t, "d", operands[1].GetVariable().GetIdentifier())
+	operands = operands[0].GetArithmetic().GetExpressions().AsArray()
+	ass.Equal(t, "*", operands[1].GetArithmetic().GetOperator())
+
+	// Repeated operators are collected into a single operation.
+	expression = parseExpression(t, "a < b OR b = c OR NOT p")
+	var logical = expression.GetLogical()
+	ass.Equal(t, "OR", logical.GetOperator())
+	operands = logical.GetExpressions().AsArray()
+	ass.Equal(t, 3, len(operands))
+	ass.Equal(t, "<", operands[0].GetComparison().GetOperator())
+	ass.NotNil(t, operands[2].GetComplement())
+
+	// Exponentiation and chaining bind tighter than inversion.
+	expression = parseExpression(t, "-/*x ^ y & z")
+	var inversion = expression.GetInversion()
+	ass.Equal(t, "-", inversion.GetOperator())
+	inversion = inversion.GetExpression().GetInversion()
+	ass.Equal(t, "/", inversion.GetOperator())
+	inversion = inversion.GetExpression().GetInversion()
+	ass.Equal(t, "*", inversion.GetOperator())
+	var exponential = inversion.GetExpression().GetExponential()
+	operands = exponential.GetExpressions().AsArray()
+	ass.Equal(t, 2, len(operands[1].GetChaining().GetExpressions().AsArray()))
+
+	// Postfix operations are applied from left to right.
+	expression = parseExpression(t, "list.getItem(1)[$key]<-notify(@event, |z|)")
+	var invocation = expression.GetInvocation()
+	ass.Equal(t, "<-", invocation.GetOperator())
+	ass.Equal(t, 2, invocation.GetArguments().GetArguments().GetSize())
+	var subcomponent = invocation.GetTarget().GetExpression().GetSubcomponent()
+	invocation = subcomponent.GetComposite().GetExpression().GetInvocation()
+	ass.Equal(t, ".", invocation.GetOperator())
+	ass.Equal(t, "getItem", invocation.GetMethod().GetIdentifier())
+
+	// Components, intrinsics and precedence.
+	expression = parseExpression(t, "(sum(a, b) + ~π($units: $radians)) IS [1..2]")
+	var comparison = expression.GetComparison()
+	ass.Equal(t, "IS", comparison.GetOperator())
+	operands = comparison.GetExpressions().AsArray()
+	var precedence = operands[0].GetPrecedence()
+	operands = precedence.GetExpression().GetArithmetic().GetExpressions().AsArray()
+	ass.Equal(t, "sum", operands[0].GetIntrinsic().GetFunction().GetIdentifier())
+	ass.NotNil(t, operands[1].GetComponent().GetContext())
+}
+
+func TestParseErrors(t *tes.T) {
+	var sources = []string{
+		"[1, 2",
+		"{ if true { } }",
+		"{ let := 5 }",
+		"[$key: ]",
+		"(1..",
+		"{ a + }",
+		"5 6",
+	}
+	for _, source := range sources {
+		ass.Panics(t, func() {
+			bal.Parser().Make().ParseSource(header + source)
+		}, source)
+	}
+	ass.Panics(t, func() {
+		bal.Parser().Make().ParseSource("[1, 2]")
+	}, "missing header")
+}
+
+// The following tests cover the v2 syntax that v3 does not accept. In each case
+// the v2 form must be rejected and the v3 form must round trip.
+func checkSyntax(t *tes.T, v2 string, v3 string) {
+	var _, err = bal.Parser().Make().TryParseSource(header + v2 + "\n")
+	ass.Error(t, err, v2)
+	var source = header + v3 + "\n"
+	var document = bal.Parser().Make().ParseSource(source)
+	ass.Equal(t, source, bal.Formatter().Make().FormatDocument(document), v3)
+}
+
+func TestLowercaseExponents(t *tes.T) {
+	checkSyntax(t, "~1.23456789E-10", "~1.23456789e-10")
+	checkSyntax(t, "6.02E+23", "6.02e+23")
+}
+
+func TestImaginaryValues(t *tes.T) {
+	checkSyntax(t, "(1, -i)", "(1, -1i)")
+	checkSyntax(t, "(1, i)", "(1, 1i)")
+}
+
+func TestNameVersions(t *tes.T) {
+	checkSyntax(t, "/bali/tests/Procedures/v1.2", "/bali/tests/Procedures/v1")
+	checkSyntax(t, "[$type: /bali/abstractions/Sequential/v1.2.3]",
+		"[$type: /bali/abstractions/Sequential/v1]")
+}
+
+func TestEmptyBinaries(t *tes.T) {
+	checkSyntax(t, "'>\n<'", "'>\n    1234abcd\n<'")
+}
+
+func TestTryParseSource(t *tes.T) {
+	var parser = bal.Parser().Make()
+	var document, err = parser.TryParseSource(header + "[1, 2\n")
+	ass.Nil(t, document)
+	var parseError, ok = err.(bal.ParseErrorLike)
+	ass.True(t, ok)
+	ass.Equal(t, 7, parseError.GetLine())
+	ass.Equal(t, 6, parseError.GetPosition())
+	ass.Equal(t, bal.EOLToken, parseError.GetToken().GetType())
+	ass.Equal(t, "]", parseError.GetExpected())
+	ass.Equal(t, "Collection", parseError.GetRules().GetValue(1))
+	ass.NotContains(t, err.Error(), "\033[")
+	ass.Contains(t, parseError.Colorized(), "\033[36m")
+
+	document, err = bal.Parser().Make().TryParseSource(header + "[1, 2]\n")
+	ass.Nil(t, err)
+	ass.NotNil(t, document)
+
+	ass.PanicsWithError(t, parseError.Error(), func() {
+		bal.Parser().Make().ParseSource(header + "[1, 2\n")
+	})
+}
+
+func TestParseSpans(t *tes.T) {
+	var source = `{
+    let $x := 1 + (2 * 3)
+    return $x
+}
+`
+	var document = bal.Parser().Make().ParseSource(header + source)
+	var span = document.GetComponent().GetSpan()
+	ass.Equal(t, "7:1", span.AsString())
+	ass.Equal(t, 10, span.GetEndLine())
+	ass.Equal(t, 2, span.GetEndPosition())
+	ass.Equal(t, len(header), span.GetStartOffset())
+	ass.Equal(t, len(header)+len(source)-1, span.GetEndOffset())
+
+	var procedure = document.GetComponent().GetEntity().GetProcedure()
+	var lines = procedure.GetLines().GetLines().AsArray()
+	var statement = lines[0].GetStatement()
+	span = statement.GetSpan()
+	ass.Equal(t, "8:5", span.AsString())
+	ass.Equal(t, 8, span.GetEndLine())
+	ass.Equal(t, 26, span.GetEndPosition())
+	ass.Equal(t, len(header)+6, span.GetStartOffset())
+	ass.Equal(t, len(header)+27, span.GetEndOffset())
+
+	var letClause = statement.GetMainClause().GetAssignment().GetLetClause()
+	ass.Equal(t, "8:5", letClause.GetSpan().AsString())
+	var expression = letClause.GetExpression()
+	span = expression.GetSpan()
+	ass.Equal(t, "8:15", span.AsString())
+	ass.Equal(t, 26, span.GetEndPosition())
+	var operands = expression.GetArithmetic().GetExpressions().AsArray()
+	span = operands[1].GetSpan()
+	ass.Equal(t, "8:19", span.AsString())
+	ass.Equal(t, 26, span.GetEndPosition())
+	span = operands[1].GetPrecedence().GetExpression().GetSpan()
+	ass.Equal(t, "8:20", span.AsString())
+	ass.Equal(t, 25, span.GetEndPosition())
+
+	var returnClause = lines[1].GetStatement().GetMainClause().GetFlow().GetReturnClause()
+	span = returnClause.GetSpan()
+	ass.Equal(t, "9:5", span.AsString())
+	ass.Equal(t, 14, span.GetEndPosition())
+	span = returnClause.GetResult().GetExpression().GetSpan()
+	ass.Equal(t, "9:12", span.AsString())
+
+	// A span that ends with an EOL token ends just past the newline character,
+	// not past the "<EOLN>" marker that replaced it.
+	var first = bal.Token().MakeWithAttributes(9, 5, len(header)+32, 6, bal.DelimiterToken, "return")
+	var last = bal.Token().MakeWithAttributes(9, 14, len(header)+41, 1, bal.EOLToken, "<EOLN>")
+	span = bal.Span().MakeFromTokens(first, last)
+	ass.Equal(t, "9:5", span.AsString())
+	ass.Equal(t, 9, span.GetEndLine())
+	ass.Equal(t, 15, span.GetEndPosition())
+	ass.Equal(t, len(header)+42, span.GetEndOffset())
+}
+
+func TestParseReader(t *tes.T) {
+	for filename, source := range readDocuments() {
+		// Reading a byte at a time must produce the same document.
+		source = header + source
+		var reader = iot.OneByteReader(sts.NewReader(source))
+		var document = bal.Parser().Make().ParseReader(reader)
+		ass.Equal(t, source, bal.Formatter().Make().FormatDocument(document), filename)
+	}
+
+	var reader = iot.TimeoutReader(sts.NewReader(header + "[1, 2]\n"))
+	ass.Panics(t, func() {
+		bal.Parser().Make().ParseReader(reader)
+	})
+}
+
+// This type is a reader of an endless list of values.
+type endless struct{}
+
+func (v endless) Read(bytes []byte) (int, error) {
+	var line = "    1\n"
+	for index := range bytes {
+		bytes[index] = line[index%len(line)]
+	}
+	return len(bytes) - len(bytes)%len(line), nil
+}
+
+// Like goleak, this function waits briefly for any scanner Go routines to exit
+// and fails the test if any remain.
+func checkScanners(t *tes.T) {
+	var buffer = make([]byte, 1<<20)
+	for range 100 {
+		var stacks = string(buffer[:run.Stack(buffer, true)])
+		if !sts.Contains(stacks, "bali.(*scanner_)") {
+			return
+		}
+		tim.Sleep(10 * tim.Millisecond)
+	}
+	t.Error("A scanner Go routine was left behind.")
+}
+
+func TestParseWithContext(t *tes.T) {
+	// The parser is kept alive so that its token queue remains reachable.
+	var parser = bal.Parser().Make()
+	defer run.KeepAlive(parser)
+
+	// A parse error stops the scanner even when the token queue is full.
+	var source = header + "[1 2]\n" + sts.Repeat("[1, 2, 3]\n", 100)
+	var document, err = parser.ParseSourceWithContext(ctx.Background(), source)
+	ass.Nil(t, document)
+	var _, ok = err.(bal.ParseErrorLike)
+	ass.True(t, ok)
+	checkScanners(t)
+
+	// A canceled context stops the parsing.
+	var context, cancel = ctx.WithCancel(ctx.Background())
+	cancel()
+	document, err = parser.ParseSourceWithContext(context, header+"[1, 2]\n")
+	ass.Nil(t, document)
+	ass.Equal(t, ctx.Canceled, err)
+	checkScanners(t)
+
+	// A deadline stops the parsing of an endless document.
+	context, cancel = ctx.WithTimeout(ctx.Background(), 50*tim.Millisecond)
+	defer cancel()
+	var reader = io.MultiReader(sts.NewReader(header+"[\n"), endless{})
+	document, err = parser.ParseReaderWithContext(context, reader)
+	ass.Nil(t, document)
+	ass.Equal(t, ctx.DeadlineExceeded, err)
+	checkScanners(t)
+}
@@ -43,10 +43,12 @@ type postClauseClass_ struct {
 func (c *postClauseClass_) MakeWithAttributes(
 	message MessageLike,
 	bag BagLike,
+	span SpanLike,
 ) PostClauseLike {
 	return &postClause_{
 		message_: message,
 		bag_:     bag,
+		span_:    span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *postClauseClass_) MakeWithAttributes(
 type postClause_ struct {
 	message_ MessageLike
 	bag_     BagLike
+	span_    SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *postClause_) GetBag() BagLike {
 	return v.bag_
 }
 
+func (v *postClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,9 +40,13 @@ type precedenceClass_ struct {
 
 // Constructors
 
-func (c *precedenceClass_) MakeWithExpression(expression ExpressionLike) PrecedenceLike {
+func (c *precedenceClass_) MakeWithAttributes(
+	expression ExpressionLike,
+	span SpanLike,
+) PrecedenceLike {
 	return &precedence_{
 		expression_: expression,
+		span_:       span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *precedenceClass_) MakeWithExpression(expression ExpressionLike) Precede
 
 type precedence_ struct {
 	expression_ ExpressionLike
+	span_       SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *precedence_) GetExpression() ExpressionLike {
 	return v.expression_
 }
 
+func (v *precedence_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
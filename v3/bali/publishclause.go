@@ -40,9 +40,13 @@ type publishClauseClass_ struct {
 
 // Constructors
 
-func (c *publishClauseClass_) MakeWithEvent(event EventLike) PublishClauseLike {
+func (c *publishClauseClass_) MakeWithAttributes(
+	event EventLike,
+	span SpanLike,
+) PublishClauseLike {
 	return &publishClause_{
 		event_: event,
+		span_:  span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *publishClauseClass_) MakeWithEvent(event EventLike) PublishClauseLike {
 
 type publishClause_ struct {
 	event_ EventLike
+	span_  SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *publishClause_) GetEvent() EventLike {
 	return v.event_
 }
 
+func (v *publishClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -42,9 +42,17 @@ type rangeClass_ struct {
 
 // Constructors
 
-func (c *rangeClass_) MakeWithPrimitives(primitives col.ListLike[PrimitiveLike]) RangeLike {
+func (c *rangeClass_) MakeWithAttributes(
+	leftBracket string,
+	primitives col.ListLike[PrimitiveLike],
+	rightBracket string,
+	span SpanLike,
+) RangeLike {
 	return &range_{
-		primitives_: primitives,
+		leftBracket_:  leftBracket,
+		primitives_:   primitives,
+		rightBracket_: rightBracket,
+		span_:         span,
 	}
 }
 
@@ -55,15 +63,30 @@ func (c *rangeClass_) MakeWithPrimitives(primitives col.ListLike[PrimitiveLike])
 // Target
 
 type range_ struct {
-	primitives_ col.ListLike[PrimitiveLike]
+	leftBracket_  string
+	primitives_   col.ListLike[PrimitiveLike]
+	rightBracket_ string
+	span_         SpanLike
 }
 
 // Attributes
 
+func (v *range_) GetLeftBracket() string {
+	return v.leftBracket_
+}
+
 func (v *range_) GetPrimitives() col.ListLike[PrimitiveLike] {
 	return v.primitives_
 }
 
+func (v *range_) GetRightBracket() string {
+	return v.rightBracket_
+}
+
+func (v *range_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,9 +40,13 @@ type rejectClauseClass_ struct {
 
 // Constructors
 
-func (c *rejectClauseClass_) MakeWithMessage(message MessageLike) RejectClauseLike {
+func (c *rejectClauseClass_) MakeWithAttributes(
+	message MessageLike,
+	span SpanLike,
+) RejectClauseLike {
 	return &rejectClause_{
 		message_: message,
+		span_:    span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *rejectClauseClass_) MakeWithMessage(message MessageLike) RejectClauseLi
 
 type rejectClause_ struct {
 	message_ MessageLike
+	span_    SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *rejectClause_) GetMessage() MessageLike {
 	return v.message_
 }
 
+func (v *rejectClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
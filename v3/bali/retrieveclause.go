@@ -43,10 +43,12 @@ type retrieveClauseClass_ struct {
 func (c *retrieveClauseClass_) MakeWithAttributes(
 	recipient RecipientLike,
 	bag BagLike,
+	span SpanLike,
 ) RetrieveClauseLike {
 	return &retrieveClause_{
 		recipient_: recipient,
 		bag_:       bag,
+		span_:      span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *retrieveClauseClass_) MakeWithAttributes(
 type retrieveClause_ struct {
 	recipient_ RecipientLike
 	bag_       BagLike
+	span_      SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *retrieveClause_) GetBag() BagLike {
 	return v.bag_
 }
 
+func (v *retrieveClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,9 +40,13 @@ type returnClauseClass_ struct {
 
 // Constructors
 
-func (c *returnClauseClass_) MakeWithResult(result ResultLike) ReturnClauseLike {
+func (c *returnClauseClass_) MakeWithAttributes(
+	result ResultLike,
+	span SpanLike,
+) ReturnClauseLike {
 	return &returnClause_{
 		result_: result,
+		span_:   span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *returnClauseClass_) MakeWithResult(result ResultLike) ReturnClauseLike
 
 type returnClause_ struct {
 	result_ ResultLike
+	span_   SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *returnClause_) GetResult() ResultLike {
 	return v.result_
 }
 
+func (v *returnClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -43,10 +43,12 @@ type saveClauseClass_ struct {
 func (c *saveClauseClass_) MakeWithAttributes(
 	draft DraftLike,
 	citation CitationLike,
+	span SpanLike,
 ) SaveClauseLike {
 	return &saveClause_{
 		draft_:    draft,
 		citation_: citation,
+		span_:     span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *saveClauseClass_) MakeWithAttributes(
 type saveClause_ struct {
 	draft_    DraftLike
 	citation_ CitationLike
+	span_     SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *saveClause_) GetCitation() CitationLike {
 	return v.citation_
 }
 
+func (v *saveClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -13,10 +13,15 @@
 package bali
 
 import (
+	bufio "bufio"
+	ctx "context"
 	fmt "fmt"
 	col "github.com/craterdog/go-collection-framework/v3/collection"
+	io "io"
 	reg "regexp"
 	sts "strings"
+	uni "unicode"
+	utf "unicode/utf8"
 )
 
 // CLASS ACCESS
@@ -25,17 +30,112 @@ import (
 
 var scannerClass = &scannerClass_{
 	tokens_: map[TokenType]string{
-		ErrorToken:     "error",
-		DelimiterToken: "delimiter",
-		EOFToken:       "EOF",
-		EOLToken:       "EOL",
-		SpaceToken:     "space",
-		// TBA - Add additional token types.
+		ErrorToken:       "error",
+		AngleToken:       "angle",
+		BinaryToken:      "binary",
+		BooleanToken:     "boolean",
+		BytecodeToken:    "bytecode",
+		CommentToken:     "comment",
+		DelimiterToken:   "delimiter",
+		DurationToken:    "duration",
+		EOFToken:         "EOF",
+		EOLToken:         "EOL",
+		IdentifierToken:  "identifier",
+		MomentToken:      "moment",
+		NameToken:        "name",
+		NarrativeToken:   "narrative",
+		NoteToken:        "note",
+		NumberToken:      "number",
+		PatternToken:     "pattern",
+		PercentageToken:  "percentage",
+		ProbabilityToken: "probability",
+		QuoteToken:       "quote",
+		ResourceToken:    "resource",
+		SpaceToken:       "space",
+		SymbolToken:      "symbol",
+		TagToken:         "tag",
+		VersionToken:     "version",
 	},
-	matchers_: map[TokenType]*reg.Regexp{
-		DelimiterToken: reg.MustCompile("^(?:" + delimiter_ + ")"),
-		EOLToken:       reg.MustCompile("^(?:" + eol_ + ")"),
-		SpaceToken:     reg.MustCompile("^(?:" + space_ + ")"),
+	matchers_: [VersionToken + 1]*reg.Regexp{
+		AngleToken:       reg.MustCompile("^(?:" + angle_ + ")"),
+		BinaryToken:      reg.MustCompile("^(?:" + binary_ + ")"),
+		BooleanToken:     reg.MustCompile("^(?:" + boolean_ + ")"),
+		BytecodeToken:    reg.MustCompile("^(?:" + bytecode_ + ")"),
+		CommentToken:     reg.MustCompile("^(?:" + comment_ + ")"),
+		DelimiterToken:   reg.MustCompile("^(?:" + delimiter_ + ")"),
+		DurationToken:    reg.MustCompile("^(?:" + duration_ + ")"),
+		EOLToken:         reg.MustCompile("^(?:" + eol_ + ")"),
+		IdentifierToken:  reg.MustCompile("^(?:" + identifier_ + ")"),
+		MomentToken:      reg.MustCompile("^(?:" + moment_ + ")"),
+		NameToken:        reg.MustCompile("^(?:" + name_ + ")"),
+		NarrativeToken:   reg.MustCompile("^(?:" + narrative_ + ")"),
+		NoteToken:        reg.MustCompile("^(?:" + note_ + ")"),
+		NumberToken:      reg.MustCompile("^(?:" + number_ + ")"),
+		PatternToken:     reg.MustCompile("^(?:" + pattern_ + ")"),
+		PercentageToken:  reg.MustCompile("^(?:" + percentage_ + ")"),
+		ProbabilityToken: reg.MustCompile("^(?:" + probability_ + ")"),
+		QuoteToken:       reg.MustCompile("^(?:" + quote_ + ")"),
+		ResourceToken:    reg.MustCompile("^(?:" + resource_ + ")"),
+		SpaceToken:       reg.MustCompile("^(?:" + space_ + ")"),
+		SymbolToken:      reg.MustCompile("^(?:" + symbol_ + ")"),
+		TagToken:         reg.MustCompile("^(?:" + tag_ + ")"),
+		VersionToken:     reg.MustCompile("^(?:" + version_ + ")"),
+	},
+	leaders_: [VersionToken + 1]string{
+		AngleToken:       "~",
+		BinaryToken:      "'",
+		BooleanToken:     "ft",
+		BytecodeToken:    "'",
+		CommentToken:     "!",
+		DelimiterToken:   "≠}|{wtsrponmlifedcba^][XSONMIA@?>=<;:/.-,+*)(&",
+		DurationToken:    "~",
+		EOLToken:         "\n",
+		MomentToken:      "<",
+		NameToken:        "/",
+		NarrativeToken:   `"`,
+		NoteToken:        "!",
+		NumberToken:      "(+-0123456789eiptuπτφ∞",
+		PatternToken:     `"an`,
+		PercentageToken:  "+-0123456789eiptuπτφ∞",
+		ProbabilityToken: ".1",
+		QuoteToken:       `"`,
+		ResourceToken:    "<",
+		SpaceToken:       " \t",
+		SymbolToken:      "$",
+		TagToken:         "#",
+		VersionToken:     "v",
+	},
+	order_: []TokenType{
+		// Must be scanned first.
+		SpaceToken,
+		EOLToken,
+		CommentToken,
+		NoteToken,
+
+		// Element and string tokens with unique leading delimiters.
+		AngleToken,
+		DurationToken,
+		MomentToken,
+		ResourceToken,
+		BinaryToken,
+		BytecodeToken,
+		NarrativeToken,
+		PatternToken,
+		QuoteToken,
+		BooleanToken,
+		NameToken,
+		SymbolToken,
+		TagToken,
+		VersionToken,
+
+		// Numeric tokens that share leading characters.
+		PercentageToken,
+		ProbabilityToken,
+		NumberToken,
+
+		// Must be scanned last.
+		DelimiterToken,
+		IdentifierToken,
 	},
 }
 
@@ -51,7 +151,9 @@ func Scanner() ScannerClassLike {
 
 type scannerClass_ struct {
 	tokens_   map[TokenType]string
-	matchers_ map[TokenType]*reg.Regexp
+	matchers_ [VersionToken + 1]*reg.Regexp // Arrays since they are consulted for every token.
+	leaders_  [VersionToken + 1]string      // The runes that may begin each token type.
+	order_    []TokenType                   // The order in which token types are scanned.
 }
 
 // Constructors
@@ -59,13 +161,53 @@ type scannerClass_ struct {
 func (c *scannerClass_) Make(
 	source string,
 	tokens col.QueueLike[TokenLike],
+) ScannerLike {
+	return c.MakeWithContext(ctx.Background(), source, tokens)
+}
+
+func (c *scannerClass_) MakeFromReader(
+	reader io.Reader,
+	tokens col.QueueLike[TokenLike],
+) ScannerLike {
+	return c.MakeFromReaderWithContext(ctx.Background(), reader, tokens)
+}
+
+func (c *scannerClass_) MakeFromReaderWithContext(
+	context ctx.Context,
+	reader io.Reader,
+	tokens col.QueueLike[TokenLike],
+) ScannerLike {
+	var scanner = &scanner_{
+		class_:    c,
+		context_:  context,
+		line_:     1,
+		position_: 1,
+		reader_:   bufio.NewReader(reader),
+		final_:    make(chan TokenLike, 1),
+		handoff_:  make(chan TokenLike, tokens.GetCapacity()),
+		tokens_:   tokens,
+	}
+	go scanner.addTokens()  // Start adding the scanned tokens to the queue.
+	go scanner.scanTokens() // Start scanning tokens in the background.
+	return scanner
+}
+
+func (c *scannerClass_) MakeWithContext(
+	context ctx.Context,
+	source string,
+	tokens col.QueueLike[TokenLike],
 ) ScannerLike {
 	var scanner = &scanner_{
+		class_:    c,
+		context_:  context,
 		line_:     1,
 		position_: 1,
-		runes_:    []rune(source),
+		source_:   source,
+		final_:    make(chan TokenLike, 1),
+		handoff_:  make(chan TokenLike, tokens.GetCapacity()),
 		tokens_:   tokens,
 	}
+	go scanner.addTokens()  // Start adding the scanned tokens to the queue.
 	go scanner.scanTokens() // Start scanning tokens in the background.
 	return scanner
 }
@@ -101,18 +243,42 @@ func (c *scannerClass_) MatchToken(
 // Target
 
 type scanner_ struct {
-	first_    int // A zero based index of the first possible rune in the next token.
-	next_     int // A zero based index of the next possible rune in the next token.
-	line_     int // The line number in the source string of the next rune.
-	position_ int // The position in the current line of the next rune.
-	runes_    []rune
-	tokens_   col.QueueLike[TokenLike]
+	class_     *scannerClass_
+	context_   ctx.Context    // The context that stops the scanning when it is done.
+	first_     int            // A zero based index of the first possible rune in the next token.
+	firstByte_ int            // A zero based index of the first possible byte in the next token.
+	nextByte_  int            // A zero based index of the next possible byte in the next token.
+	lineEnd_   int            // A zero based index of the byte following the current line.
+	line_      int            // The line number in the source string of the next rune.
+	position_  int            // The position in the current line of the next rune.
+	source_    string         // The source string, or when reading, the unscanned lines read so far.
+	reader_    *bufio.Reader  // The reader of any remaining source lines.
+	failure_   error          // Any error that stopped the scanning of the source.
+	handoff_   chan TokenLike // The scanned tokens that have not yet been added to the queue.
+	final_     chan TokenLike // The slot reserved for the final EOF or error token.
+	tokens_    col.QueueLike[TokenLike]
 }
 
 // Private
 
+/*
+This private instance method adds the scanned tokens to the token queue, blocking
+while the queue is full, and then adds the final EOF or error token.  Once the
+context is done, any tokens that are still waiting to be added are discarded so
+that at most one more token precedes the final token.
+*/
+func (v *scanner_) addTokens() {
+	for token := range v.handoff_ {
+		if v.context_.Err() == nil {
+			v.tokens_.AddValue(token) // This will wait for room in the queue.
+		}
+	}
+	v.tokens_.AddValue(<-v.final_)
+}
+
 func (v *scanner_) emitToken(type_ TokenType) {
-	var value = string(v.runes_[v.first_:v.next_])
+	var value = v.source_[v.firstByte_:v.nextByte_]
+	var length = utf.RuneCountInString(value)
 	switch value {
 	case "\x00":
 		value = "<NULL>"
@@ -131,9 +297,34 @@ func (v *scanner_) emitToken(type_ TokenType) {
 	case "\v":
 		value = "<VTAB>"
 	}
-	var token = Token().MakeWithAttributes(v.line_, v.position_, type_, value)
+	var token = Token().MakeWithAttributes(
+		v.line_,
+		v.position_,
+		v.first_,
+		length,
+		type_,
+		value,
+	)
 	//fmt.Println(Scanner().FormatToken(token)) // Uncomment when debugging.
-	v.tokens_.AddValue(token) // This will block if the queue is full.
+	if type_ == EOFToken || type_ == ErrorToken {
+		v.finish(token)
+		return
+	}
+	select {
+	case v.handoff_ <- token:
+	case <-v.context_.Done():
+		// The token is dropped since the scanning stops once the context is done.
+	}
+}
+
+/*
+This private instance method hands off the specified final token using the slot
+reserved for it, so the scanning always finishes without blocking, even if the
+tokens are no longer being drained from the queue.
+*/
+func (v *scanner_) finish(token TokenLike) {
+	v.final_ <- token
+	close(v.handoff_)
 }
 
 func (v *scanner_) foundEOF() {
@@ -141,56 +332,210 @@ func (v *scanner_) foundEOF() {
 }
 
 func (v *scanner_) foundError() {
-	v.next_++
+	var _, size = utf.DecodeRuneInString(v.source_[v.nextByte_:])
+	v.nextByte_ += size
 	v.emitToken(ErrorToken)
 }
 
-func (v *scanner_) foundToken(type_ TokenType) bool {
-	var text = string(v.runes_[v.next_:])
-	var matches = Scanner().MatchToken(type_, text)
-	if !matches.IsEmpty() {
-		var match = matches.GetValue(1)
-		var token = []rune(match)
-		var length = len(token)
-		v.next_ += length
-		if type_ != SpaceToken {
-			v.emitToken(type_)
-		}
-		var count = sts.Count(match, "\n")
-		if count > 0 {
-			v.line_ += count
-			v.position_ = v.indexOfLastEOL(token)
+func (v *scanner_) foundFailure() {
+	var token = Token().MakeWithAttributes(
+		v.line_,
+		v.position_,
+		v.first_,
+		0,
+		ErrorToken,
+		v.failure_.Error(),
+	)
+	v.finish(token)
+}
+
+/*
+This private instance method returns the unscanned source through the end of the
+current line.  Only comments, narratives and binaries span lines, so any other
+token is matched against just this text.  This keeps the text short enough for
+the regular expressions to use their much faster backtracking matcher.
+*/
+func (v *scanner_) currentLine() string {
+	if v.lineEnd_ <= v.nextByte_ {
+		var index = sts.IndexByte(v.source_[v.nextByte_:], '\n')
+		if index < 0 {
+			v.lineEnd_ = len(v.source_)
 		} else {
-			v.position_ += v.next_ - v.first_
+			v.lineEnd_ = v.nextByte_ + index + 1
 		}
-		v.first_ = v.next_
+	}
+	return v.source_[v.nextByte_:v.lineEnd_]
+}
+
+/*
+This private instance method attempts to match a token of the specified type at
+the current position in the source string.  The source string is never copied;
+the anchored regular expression only examines the runes that make up the token
+so each token is matched in time proportional to its length.
+*/
+func (v *scanner_) foundToken(type_ TokenType) bool {
+	var text string
+	switch type_ {
+	case BinaryToken, CommentToken, NarrativeToken:
+		text = v.source_[v.nextByte_:]
+	default:
+		text = v.currentLine()
+	}
+	var location = v.class_.matchers_[type_].FindStringIndex(text)
+	if location == nil {
+		return false
+	}
+	var match = text[:location[1]]
+	if len(match) == 0 || v.isPrefix(type_, match) {
+		return false
+	}
+	v.nextByte_ += len(match)
+	if type_ != SpaceToken {
+		v.emitToken(type_)
+	}
+	var index = sts.LastIndex(match, "\n")
+	if index < 0 {
+		v.position_ += utf.RuneCountInString(match)
+	} else {
+		v.line_ += sts.Count(match, "\n")
+		v.position_ = utf.RuneCountInString(match[index+1:]) + 1
+	}
+	v.first_ += utf.RuneCountInString(match)
+	v.firstByte_ = v.nextByte_
+	return true
+}
+
+/*
+This private instance method determines whether or not there are any unscanned
+runes remaining in the source.  When reading the source, the next line is read
+once all previously read lines have been scanned.
+*/
+func (v *scanner_) hasMore() bool {
+	if v.nextByte_ == len(v.source_) {
+		v.readLines("")
+	}
+	return v.nextByte_ < len(v.source_)
+}
+
+/*
+This private instance method determines whether or not the specified token,
+which matched the regular expression for the specified token type, is really
+just the first part of a longer token (e.g. "in" within "index") or a
+probability that is actually the first part of a range (e.g. "1..5").
+*/
+func (v *scanner_) isPrefix(type_ TokenType, token string) bool {
+	var next = v.nextByte_ + len(token)
+	if next == len(v.source_) {
+		return false
+	}
+
+	// Word-like tokens must not be followed by a letter or digit.
+	var last, _ = utf.DecodeLastRuneInString(token)
+	var following, _ = utf.DecodeRuneInString(v.source_[next:])
+	if isAlphanumeric(last) && isAlphanumeric(following) {
 		return true
 	}
+
+	// A probability of "1." must not be followed by a digit ("1.5") or by a
+	// range delimiter ("1..5"), but may be followed by one ("1...5").
+	if type_ == ProbabilityToken && token == "1." {
+		var rest = v.source_[next:]
+		switch {
+		case uni.IsDigit(following):
+			return true
+		case sts.HasPrefix(rest, ".."):
+			return false
+		case sts.HasPrefix(rest, "."):
+			return true
+		}
+	}
+
 	return false
 }
 
-func (v *scanner_) indexOfLastEOL(runes []rune) int {
-	var length = len(runes)
-	for index := length; index > 0; index-- {
-		if runes[index-1] == '\n' {
-			return length - index + 1
+/*
+This private instance method determines whether or not a token of the specified
+type may begin with the specified leading rune.  It allows the scanner to skip
+the matchers for all token types that cannot possibly match.
+*/
+func (v *scanner_) mayBeginWith(type_ TokenType, leading rune) bool {
+	if type_ == IdentifierToken {
+		return uni.IsLower(leading) || uni.IsUpper(leading)
+	}
+	return sts.ContainsRune(v.class_.leaders_[type_], leading)
+}
+
+/*
+This private instance method reads the next line of source from the reader (if
+there is one) and appends it to the unscanned source.  If a closing delimiter is
+specified, lines are read until one containing the closing delimiter has been
+read.  The source that has already been scanned is discarded.  It returns true
+if any lines were read.
+*/
+func (v *scanner_) readLines(closing string) bool {
+	if v.reader_ == nil {
+		return false
+	}
+	var lines sts.Builder
+	for v.reader_ != nil {
+		var line, err = v.reader_.ReadString('\n')
+		if err != nil {
+			if err != io.EOF {
+				v.failure_ = err
+			}
+			v.reader_ = nil // There is nothing left to read.
 		}
+		lines.WriteString(line)
+		if sts.Contains(line, closing) {
+			break
+		}
+	}
+	if lines.Len() == 0 {
+		return false
 	}
-	return 0
+	v.source_ = v.source_[v.firstByte_:] + lines.String()
+	v.nextByte_ -= v.firstByte_
+	v.firstByte_ = 0
+	v.lineEnd_ = 0 // The current line may have been extended.
+	return true
 }
 
+/*
+This private instance method scans the source for tokens, dispatching on the
+leading rune of each token to the matchers for only those token types that may
+begin with it.  The scanning is linear in the length of the source.  When
+reading the source, it is scanned a line at a time, except for multi-line
+tokens (comments, narratives and binaries) which are read through their closing
+delimiters.  Since the scanned tokens are handed off only as quickly as they are
+added to the token queue, the source is only read as quickly as the parser
+consumes the tokens.  The scanning ends with exactly one EOF or error token, and
+stops early with an error token once the context is done, even if the tokens are
+no longer being drained.  The error token is added to the queue once the tokens
+that precede it have been drained.
+*/
 func (v *scanner_) scanTokens() {
 loop:
-	for v.next_ < len(v.runes_) {
-		switch {
-		// TBA - Add additional token types.
-		case v.foundToken(DelimiterToken):
-		case v.foundToken(EOLToken):
-		case v.foundToken(SpaceToken):
-		default:
-			v.foundError()
-			break loop
+	for v.hasMore() {
+		var err = v.context_.Err()
+		if err != nil {
+			v.failure_ = err
+			break
+		}
+		var leading, _ = utf.DecodeRuneInString(v.source_[v.nextByte_:])
+		for _, type_ := range v.class_.order_ {
+			if v.mayBeginWith(type_, leading) && v.foundToken(type_) {
+				continue loop
+			}
 		}
+		if sts.ContainsRune(`!"'`, leading) && v.readLines("<"+string(leading)) {
+			continue // The rest of a multi-line token was read.
+		}
+		v.foundError()
+		return
+	}
+	if v.failure_ != nil {
+		v.foundFailure()
+		return
 	}
 	v.foundEOF()
 }
@@ -204,17 +549,87 @@ way.  We append an underscore to each name to lessen the chance of a name
 collision with other private Go class constants in this package.
 */
 const (
-	any_       = `.|` + eol_
-	base16_    = `[0-9a-f]`
-	control_   = `\p{Cc}`
-	delimiter_ = `[:;,\.=]`
-	digit_     = `\p{Nd}`
-	eof_       = `\z`
-	eol_       = `\n`
-	escape_    = `\\(?:(?:` + unicode_ + `)|[abfnrtv'"\\])`
-	lower_     = `\p{Ll}`
-	space_     = `[ \t]+`
-	unicode_   = `x` + base16_ + `{2}|u` + base16_ + `{4}|U` + base16_ + `{8}`
-	upper_     = `\p{Lu}`
-	// TBA - Add additional regular expression definitions.
+	angle_          = `~(?:` + magnitudeValue_ + `|` + zero_ + `)`
+	any_            = `.|` + eol_
+	authority_      = `[^/` + control_ + `]+`
+	base10_         = `[0-9]`
+	base16_         = `[0-9a-f]`
+	base32_         = `[0-9A-DF-HJ-NP-TV-Z]`
+	base64_         = `[0-9A-Za-z+/]`
+	binary_         = `'>` + eol_ + `(?:(?:` + space_ + `)?` + base64_ + `+` + eol_ + `)+(?:` + space_ + `)?<'`
+	boolean_        = `false|true`
+	bytecode_       = `'(?:` + instruction_ + `(?:` + space_ + instruction_ + `)*)*'`
+	character_      = escape_ + `|[^"` + control_ + `]`
+	comment_        = `!>(?:` + any_ + `)*?<!`
+	complex_        = `\((?:` + rectangular_ + `|` + polar_ + `)\)`
+	control_        = `\p{Cc}`
+	day_            = `0[1-9]|[1-2][0-9]|3[0-1]`
+	days_           = `(?:` + timespan_ + `)D`
+	delimiter_      = `≠|\}|\||\{|with|while|to|throw|select|save|return|retrieve|reject|publish|post|on|notarize|matching|loop|level|let|in|if|from|each|do|discard|continue|checkout|break|at|as|accept|\^|\]|\[|XOR|SANS|OR|NOT|MATCHES|IS|AND|@|\?=|>|=|<-|<|;|:=|:|/=|//|/|\.\.|\.|-=|-|,|\+=|\+|\*=|\*|\)|\(|&`
+	digit_          = `\p{Nd}`
+	duration_       = `~` + sign_ + `?P(?:` + weeks_ + `|(?:` + years_ + `)?(?:` + months_ + `)?(?:` + days_ + `)?(?:T(?:` + hours_ + `)?(?:` + minutes_ + `)?(?:` + seconds_ + `)?)?)`
+	e_              = `e`
+	eof_            = `\z`
+	eol_            = `\n`
+	escape_         = `\\(?:(?:` + unicode_ + `)|[abfnrtv'"\\])`
+	exponent_       = `e` + sign_ + `?` + ordinal_
+	float_          = sign_ + `?(?:` + magnitudeValue_ + `)`
+	fraction_       = `\.` + base10_ + `+`
+	fragment_       = `[^>` + control_ + `]*`
+	hour_           = `[0-1][0-9]|2[0-3]`
+	hours_          = `(?:` + timespan_ + `)H`
+	identifier_     = `(?:` + letter_ + `)(?:` + letter_ + `|` + digit_ + `)*`
+	imaginary_      = sign_ + `?(?:` + magnitudeValue_ + `)?i`
+	infinity_       = sign_ + `?(?:infinity|∞)`
+	instruction_    = base16_ + `{4}`
+	letter_         = lower_ + `|` + upper_
+	lower_          = `\p{Ll}`
+	magnitudeValue_ = e_ + `|` + pi_ + `|` + phi_ + `|` + tau_ + `|` + scalar_
+	minute_         = `[0-5][0-9]`
+	minutes_        = `(?:` + timespan_ + `)M`
+	moment_         = `<` + sign_ + `?(?:` + year_ + `)(?:-(?:` + month_ + `)(?:-(?:` + day_ + `)(?:T(?:` + hour_ + `)(?::(?:` + minute_ + `)(?::(?:` + second_ + `)(?:` + fraction_ + `)?)?)?)?)?)?>`
+	month_          = `0[1-9]|1[0-2]`
+	months_         = `(?:` + timespan_ + `)M`
+	name_           = `(?:/(?:` + identifier_ + `))+`
+	narrative_      = `">` + eol_ + `(?:` + any_ + `)*?` + eol_ + `(?:` + space_ + `)?<"`
+	note_           = `! [^` + control_ + `]*`
+	number_         = `(?:` + complex_ + `)|(?:` + infinity_ + `)|(?:` + imaginary_ + `)|(?:` + real_ + `)`
+	ordinal_        = `[1-9][0-9]*`
+	path_           = `[^?#>` + control_ + `]*`
+	pattern_        = `none|` + regex_ + `|any`
+	percentage_     = `(?:` + real_ + `)%`
+	phi_            = `phi|φ`
+	pi_             = `pi|π`
+	polar_          = `(?:` + magnitudeValue_ + `)e\^` + angle_ + `i`
+	probability_    = fraction_ + `|1\.`
+	query_          = `[^#>` + control_ + `]*`
+	quote_          = `"(?:` + character_ + `)*"`
+	real_           = `(?:` + float_ + `)|` + zero_ + `|(?:` + infinity_ + `)|` + undefined_
+	rectangular_    = `(?:` + float_ + `), (?:` + float_ + `)i`
+	regex_          = `"(?:` + character_ + `)+"\?`
+	resource_       = `<` + scheme_ + `:(?://` + authority_ + `)?/` + path_ + `(?:\?` + query_ + `)?(?:#` + fragment_ + `)?>`
+	scalar_         = `(?:` + zero_ + fraction_ + `|` + ordinal_ + `(?:` + fraction_ + `)?)(?:` + exponent_ + `)?`
+	scheme_         = `[a-zA-Z][0-9a-zA-Z+\-.]*`
+	second_         = `[0-5][0-9]|6[0-1]`
+	seconds_        = `(?:` + timespan_ + `)S`
+	sign_           = `[+-]`
+	space_          = `[ \t]+`
+	symbol_         = `\$(?:` + identifier_ + `)`
+	tag_            = `#` + base32_ + `+`
+	tau_            = `tau|τ`
+	timespan_       = zero_ + `|` + ordinal_ + `(?:` + fraction_ + `)?`
+	undefined_      = `undefined`
+	unicode_        = `x` + base16_ + `{2}|u` + base16_ + `{4}|U` + base16_ + `{8}`
+	upper_          = `\p{Lu}`
+	version_        = `v` + ordinal_ + `(?:\.` + ordinal_ + `)*`
+	weeks_          = `(?:` + timespan_ + `)W`
+	year_           = zero_ + `|` + ordinal_
+	years_          = `(?:` + timespan_ + `)Y`
+	zero_           = `0`
 )
+
+// PRIVATE FUNCTIONS
+
+func isAlphanumeric(character rune) bool {
+	return uni.IsLetter(character) || uni.IsDigit(character)
+}
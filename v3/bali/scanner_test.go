@@ -0,0 +1,291 @@
+/*
+................................................................................
+.                   Copyright (c) 2024.  All Rights Reserved.                  .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package bali_test
+
+import (
+	ctx "context"
+	fmt "fmt"
+	bal "github.com/bali-nebula/go-component-framework/v3/bali"
+	col "github.com/craterdog/go-collection-framework/v3/collection"
+	ass "github.com/stretchr/testify/assert"
+	sts "strings"
+	tes "testing"
+	iot "testing/iotest"
+	tim "time"
+)
+
+type scanned struct {
+	type_ bal.TokenType
+	value string
+}
+
+func scanSource(source string) []scanned {
+	var tokens = col.Queue[bal.TokenLike]().MakeWithCapacity(16)
+	bal.Scanner().Make(source, tokens)
+	var result []scanned
+	for {
+		var token, _ = tokens.RemoveHead()
+		result = append(result, scanned{token.GetType(), token.GetValue()})
+		switch token.GetType() {
+		case bal.EOFToken, bal.ErrorToken:
+			return result
+		}
+	}
+}
+
+func TestScanElements(t *tes.T) {
+	var tokens = scanSource("~π ~P3M4DT5H <2009-04-01T01> <https://google.com/path?foo=bar#top> " +
+		"-1.7% .25 1. (3, 4i) (5e^~πi) -ei infinity undefined true none \"c[aou]+t\"?")
+	ass.Equal(t, []scanned{
+		{bal.AngleToken, "~π"},
+		{bal.DurationToken, "~P3M4DT5H"},
+		{bal.MomentToken, "<2009-04-01T01>"},
+		{bal.ResourceToken, "<https://google.com/path?foo=bar#top>"},
+		{bal.PercentageToken, "-1.7%"},
+		{bal.ProbabilityToken, ".25"},
+		{bal.ProbabilityToken, "1."},
+		{bal.NumberToken, "(3, 4i)"},
+		{bal.NumberToken, "(5e^~πi)"},
+		{bal.NumberToken, "-ei"},
+		{bal.NumberToken, "infinity"},
+		{bal.NumberToken, "undefined"},
+		{bal.BooleanToken, "true"},
+		{bal.PatternToken, "none"},
+		{bal.PatternToken, "\"c[aou]+t\"?"},
+		{bal.EOFToken, ""},
+	}, tokens)
+}
+
+func TestScanMoments(t *tes.T) {
+	var tokens = scanSource("<2024-03-01> <2024-03-10> <2024-03-19> <2024-03-20> <2024-03-31>")
+	ass.Equal(t, []scanned{
+		{bal.MomentToken, "<2024-03-01>"},
+		{bal.MomentToken, "<2024-03-10>"},
+		{bal.MomentToken, "<2024-03-19>"},
+		{bal.MomentToken, "<2024-03-20>"},
+		{bal.MomentToken, "<2024-03-31>"},
+		{bal.EOFToken, ""},
+	}, tokens)
+	for _, source := range []string{"<2024-03-00>", "<2024-03-32>"} {
+		tokens = scanSource(source)
+		ass.NotEqual(t, bal.MomentToken, tokens[0].type_, source)
+	}
+}
+
+func TestScanStrings(t *tes.T) {
+	var tokens = scanSource("'>\n    YWJj\n<' '1a2b 3c4d' /bali/Type v1.2.3 $symbol #A3GHK57Z " +
+		"\">\n    a narrative\n<\" \"a \\\"quote\\\"\"")
+	ass.Equal(t, []scanned{
+		{bal.BinaryToken, "'>\n    YWJj\n<'"},
+		{bal.BytecodeToken, "'1a2b 3c4d'"},
+		{bal.NameToken, "/bali/Type"},
+		{bal.VersionToken, "v1.2.3"},
+		{bal.SymbolToken, "$symbol"},
+		{bal.TagToken, "#A3GHK57Z"},
+		{bal.NarrativeToken, "\">\n    a narrative\n<\""},
+		{bal.QuoteToken, "\"a \\\"quote\\\"\""},
+		{bal.EOFToken, ""},
+	}, tokens)
+}
+
+func TestScanAnnotations(t *tes.T) {
+	var tokens = scanSource("!>\n    A comment.\n<!\n[  ! A note.\n]")
+	ass.Equal(t, []scanned{
+		{bal.CommentToken, "!>\n    A comment.\n<!"},
+		{bal.EOLToken, "<EOLN>"},
+		{bal.DelimiterToken, "["},
+		{bal.NoteToken, "! A note."},
+		{bal.EOLToken, "<EOLN>"},
+		{bal.DelimiterToken, "]"},
+		{bal.EOFToken, ""},
+	}, tokens)
+}
+
+func TestScanAmbiguities(t *tes.T) {
+	var tokens = scanSource("[1..5] (.25...75) [1...5] with each $index in indices do")
+	ass.Equal(t, []scanned{
+		{bal.DelimiterToken, "["},
+		{bal.NumberToken, "1"},
+		{bal.DelimiterToken, ".."},
+		{bal.NumberToken, "5"},
+		{bal.DelimiterToken, "]"},
+		{bal.DelimiterToken, "("},
+		{bal.ProbabilityToken, ".25"},
+		{bal.DelimiterToken, ".."},
+		{bal.ProbabilityToken, ".75"},
+		{bal.DelimiterToken, ")"},
+		{bal.DelimiterToken, "["},
+		{bal.ProbabilityToken, "1."},
+		{bal.DelimiterToken, ".."},
+		{bal.NumberToken, "5"},
+		{bal.DelimiterToken, "]"},
+		{bal.DelimiterToken, "with"},
+		{bal.DelimiterToken, "each"},
+		{bal.SymbolToken, "$index"},
+		{bal.DelimiterToken, "in"},
+		{bal.IdentifierToken, "indices"},
+		{bal.DelimiterToken, "do"},
+		{bal.EOFToken, ""},
+	}, tokens)
+}
+
+func TestScanErrors(t *tes.T) {
+	var tokens = scanSource("[~1.5E-10]")
+	ass.Equal(t, []scanned{
+		{bal.DelimiterToken, "["},
+		{bal.ErrorToken, "~"},
+	}, tokens)
+
+	// A binary string must contain at least one line of base 64 characters.
+	tokens = scanSource("'>\n<'")
+	ass.Equal(t, []scanned{
+		{bal.ErrorToken, "'"},
+	}, tokens)
+
+	// The imaginary part of a rectangular complex number must have a value.
+	tokens = scanSource("(1, i)")
+	ass.Equal(t, []scanned{
+		{bal.DelimiterToken, "("},
+		{bal.NumberToken, "1"},
+		{bal.DelimiterToken, ","},
+		{bal.NumberToken, "i"},
+		{bal.DelimiterToken, ")"},
+		{bal.EOFToken, ""},
+	}, tokens)
+}
+
+// The scanning rate (ns/byte) should remain constant as the document grows.
+func BenchmarkScanner(b *tes.B) {
+	var catalog = readDocument(testDirectory + "benchmark/catalog.bali")
+	var sizes = []int{1 << 10, 10 << 10, 100 << 10, 1 << 20, 10 << 20}
+	var rates = make([]float64, len(sizes))
+	for index, size := range sizes {
+		// Whole catalogs are repeated so that no token is ever split.
+		var source = sts.Repeat(catalog, max(1, size/len(catalog)))
+		b.Run(fmt.Sprintf("%dKB", size>>10), func(b *tes.B) {
+			b.SetBytes(int64(len(source)))
+			for i := 0; i < b.N; i++ {
+				var tokens = col.Queue[bal.TokenLike]().MakeWithCapacity(16)
+				bal.Scanner().Make(source, tokens)
+				for {
+					var token, _ = tokens.RemoveHead()
+					var type_ = token.GetType()
+					if type_ == bal.ErrorToken {
+						b.Fatalf("Unexpected token: %v", bal.Scanner().FormatToken(token))
+					}
+					if type_ == bal.EOFToken {
+						break
+					}
+				}
+			}
+			var elapsed = b.Elapsed().Nanoseconds()
+			var rate = float64(elapsed) / float64(b.N*len(source))
+			b.ReportMetric(rate, "ns/byte")
+			rates[index] = rate // The last run uses the most iterations.
+		})
+	}
+
+	// The slowest rate must be within twice the fastest one.
+	var fastest, slowest float64
+	for _, rate := range rates {
+		if rate == 0 {
+			continue // The size was not benchmarked.
+		}
+		if fastest == 0 || rate < fastest {
+			fastest = rate
+		}
+		slowest = max(slowest, rate)
+	}
+	if slowest > 2*fastest {
+		b.Errorf("The scanning rate is not constant: %v ns/byte", rates)
+	}
+}
+
+func TestScanLengths(t *tes.T) {
+	// The length of a token is its length in the source, not of its value.
+	var tokens = col.Queue[bal.TokenLike]().MakeWithCapacity(16)
+	bal.Scanner().Make("[π]\n", tokens)
+	var lengths []int
+	var values []string
+	for {
+		var token, _ = tokens.RemoveHead()
+		lengths = append(lengths, token.GetLength())
+		values = append(values, token.GetValue())
+		if token.GetType() == bal.EOFToken {
+			break
+		}
+	}
+	ass.Equal(t, []string{"[", "π", "]", "<EOLN>", ""}, values)
+	ass.Equal(t, []int{1, 1, 1, 1, 0}, lengths)
+}
+
+func TestScanReader(t *tes.T) {
+	var source = "!>\n    A comment.\n<!\n[\n    \">\n        A narrative.\n    <\"\n    '>\n        YWJj\n    <'\n]\n"
+	var expected = scanSource(source)
+	var tokens = col.Queue[bal.TokenLike]().MakeWithCapacity(16)
+	bal.Scanner().MakeFromReader(iot.HalfReader(sts.NewReader(source)), tokens)
+	var result []scanned
+	for {
+		var token, _ = tokens.RemoveHead()
+		result = append(result, scanned{token.GetType(), token.GetValue()})
+		if token.GetType() == bal.EOFToken {
+			break
+		}
+	}
+	ass.Equal(t, expected, result)
+	ass.Equal(t, bal.NarrativeToken, result[4].type_)
+}
+
+func TestScanWithContext(t *tes.T) {
+	// Wait for the scanner to fill the token queue.
+	var context, cancel = ctx.WithCancel(ctx.Background())
+	var tokens = col.Queue[bal.TokenLike]().MakeWithCapacity(16)
+	bal.Scanner().MakeWithContext(context, sts.Repeat("[1, 2, 3]\n", 100), tokens)
+	for tokens.GetSize() < 15 {
+		tim.Sleep(tim.Millisecond)
+	}
+
+	// Once canceled, the scanner emits at most one more token and an error.
+	cancel()
+	var count int
+	var token bal.TokenLike
+	for count = 0; count < 100; count++ {
+		token, _ = tokens.RemoveHead()
+		if token.GetType() == bal.ErrorToken {
+			break
+		}
+	}
+	ass.True(t, count <= 17)
+	ass.Equal(t, "context canceled", token.GetValue())
+	checkScanners(t)
+
+	// A canceled scanner stops scanning even while its tokens are not being
+	// drained, and its error token follows the tokens that were already queued.
+	context, cancel = ctx.WithCancel(ctx.Background())
+	tokens = col.Queue[bal.TokenLike]().MakeWithCapacity(16)
+	bal.Scanner().MakeWithContext(context, sts.Repeat("[1, 2, 3]\n", 100), tokens)
+	for tokens.GetSize() < 16 {
+		tim.Sleep(tim.Millisecond)
+	}
+	cancel()
+	tim.Sleep(10 * tim.Millisecond)
+	for count = 0; count < 100; count++ {
+		token, _ = tokens.RemoveHead()
+		if token.GetType() == bal.ErrorToken {
+			break
+		}
+	}
+	ass.True(t, count <= 17)
+	ass.Equal(t, "context canceled", token.GetValue())
+	checkScanners(t)
+}
@@ -12,7 +12,9 @@
 
 package bali
 
-import ()
+import (
+	col "github.com/craterdog/go-collection-framework/v3/collection"
+)
 
 // CLASS ACCESS
 
@@ -42,13 +44,13 @@ type selectClauseClass_ struct {
 
 func (c *selectClauseClass_) MakeWithAttributes(
 	target TargetLike,
-	template TemplateLike,
-	procedure ProcedureLike,
+	matchings col.ListLike[MatchingLike],
+	span SpanLike,
 ) SelectClauseLike {
 	return &selectClause_{
 		target_:    target,
-		template_:  template,
-		procedure_: procedure,
+		matchings_: matchings,
+		span_:      span,
 	}
 }
 
@@ -60,8 +62,8 @@ func (c *selectClauseClass_) MakeWithAttributes(
 
 type selectClause_ struct {
 	target_    TargetLike
-	template_  TemplateLike
-	procedure_ ProcedureLike
+	matchings_ col.ListLike[MatchingLike]
+	span_      SpanLike
 }
 
 // Attributes
@@ -70,12 +72,12 @@ func (v *selectClause_) GetTarget() TargetLike {
 	return v.target_
 }
 
-func (v *selectClause_) GetTemplate() TemplateLike {
-	return v.template_
+func (v *selectClause_) GetMatchings() col.ListLike[MatchingLike] {
+	return v.matchings_
 }
 
-func (v *selectClause_) GetProcedure() ProcedureLike {
-	return v.procedure_
+func (v *selectClause_) GetSpan() SpanLike {
+	return v.span_
 }
 
 // Public
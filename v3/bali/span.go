@@ -0,0 +1,150 @@
+/*
+................................................................................
+.                   Copyright (c) 2024.  All Rights Reserved.                  .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package bali
+
+import (
+	fmt "fmt"
+	sts "strings"
+	uni "unicode/utf8"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var spanClass = &spanClass_{
+	// This class has no private constants to initialize.
+}
+
+// Function
+
+func Span() SpanClassLike {
+	return spanClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type spanClass_ struct {
+	// This class has no private constants.
+}
+
+// Constants
+
+// Constructors
+
+func (c *spanClass_) MakeWithAttributes(
+	startLine int,
+	startPosition int,
+	startOffset int,
+	endLine int,
+	endPosition int,
+	endOffset int,
+) SpanLike {
+	return &span_{
+		startLine_:     startLine,
+		startPosition_: startPosition,
+		startOffset_:   startOffset,
+		endLine_:       endLine,
+		endPosition_:   endPosition,
+		endOffset_:     endOffset,
+	}
+}
+
+func (c *spanClass_) MakeFromTokens(first, last TokenLike) SpanLike {
+	// The end of the span is the position just past the last token which may
+	// itself span multiple lines (e.g. a narrative).
+	var value = last.GetValue()
+	var length = last.GetLength()
+	var endLine = last.GetLine()
+	var endPosition = last.GetPosition()
+	var endOffset = last.GetOffset() + length
+	var index = sts.LastIndex(value, "\n")
+	if index < 0 || uni.RuneCountInString(value) != length {
+		// The scanner may have replaced the value of a single control character
+		// (e.g. a newline) with a marker like "<EOLN>".
+		endPosition += length
+	} else {
+		endLine += sts.Count(value, "\n")
+		endPosition = uni.RuneCountInString(value[index+1:]) + 1
+	}
+	return c.MakeWithAttributes(
+		first.GetLine(),
+		first.GetPosition(),
+		first.GetOffset(),
+		endLine,
+		endPosition,
+		endOffset,
+	)
+}
+
+func (c *spanClass_) MakeFromSpans(first, last SpanLike) SpanLike {
+	return c.MakeWithAttributes(
+		first.GetStartLine(),
+		first.GetStartPosition(),
+		first.GetStartOffset(),
+		last.GetEndLine(),
+		last.GetEndPosition(),
+		last.GetEndOffset(),
+	)
+}
+
+// Functions
+
+// INSTANCE METHODS
+
+// Target
+
+type span_ struct {
+	startLine_     int
+	startPosition_ int
+	startOffset_   int
+	endLine_       int
+	endPosition_   int
+	endOffset_     int
+}
+
+// Attributes
+
+func (v *span_) GetStartLine() int {
+	return v.startLine_
+}
+
+func (v *span_) GetStartPosition() int {
+	return v.startPosition_
+}
+
+func (v *span_) GetStartOffset() int {
+	return v.startOffset_
+}
+
+func (v *span_) GetEndLine() int {
+	return v.endLine_
+}
+
+func (v *span_) GetEndPosition() int {
+	return v.endPosition_
+}
+
+func (v *span_) GetEndOffset() int {
+	return v.endOffset_
+}
+
+// Public
+
+func (v *span_) AsString() string {
+	return fmt.Sprintf("%d:%d", v.startLine_, v.startPosition_)
+}
+
+// Private
@@ -43,10 +43,12 @@ type statementClass_ struct {
 func (c *statementClass_) MakeWithAttributes(
 	mainClause MainClauseLike,
 	onClause OnClauseLike,
+	span SpanLike,
 ) StatementLike {
 	return &statement_{
 		mainClause_: mainClause,
 		onClause_:   onClause,
+		span_:       span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *statementClass_) MakeWithAttributes(
 type statement_ struct {
 	mainClause_ MainClauseLike
 	onClause_   OnClauseLike
+	span_       SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *statement_) GetOnClause() OnClauseLike {
 	return v.onClause_
 }
 
+func (v *statement_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -43,10 +43,12 @@ type subcomponentClass_ struct {
 func (c *subcomponentClass_) MakeWithAttributes(
 	composite CompositeLike,
 	indices IndicesLike,
+	span SpanLike,
 ) SubcomponentLike {
 	return &subcomponent_{
 		composite_: composite,
 		indices_:   indices,
+		span_:      span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *subcomponentClass_) MakeWithAttributes(
 type subcomponent_ struct {
 	composite_ CompositeLike
 	indices_   IndicesLike
+	span_      SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *subcomponent_) GetIndices() IndicesLike {
 	return v.indices_
 }
 
+func (v *subcomponent_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
@@ -40,9 +40,13 @@ type throwClauseClass_ struct {
 
 // Constructors
 
-func (c *throwClauseClass_) MakeWithException(exception ExceptionLike) ThrowClauseLike {
+func (c *throwClauseClass_) MakeWithAttributes(
+	exception ExceptionLike,
+	span SpanLike,
+) ThrowClauseLike {
 	return &throwClause_{
 		exception_: exception,
+		span_:      span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *throwClauseClass_) MakeWithException(exception ExceptionLike) ThrowClau
 
 type throwClause_ struct {
 	exception_ ExceptionLike
+	span_      SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *throwClause_) GetException() ExceptionLike {
 	return v.exception_
 }
 
+func (v *throwClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
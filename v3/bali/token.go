@@ -43,12 +43,16 @@ type tokenClass_ struct {
 func (c *tokenClass_) MakeWithAttributes(
 	line int,
 	position int,
+	offset int,
+	length int,
 	type_ TokenType,
 	value string,
 ) TokenLike {
 	return &token_{
 		line_:     line,
 		position_: position,
+		offset_:   offset,
+		length_:   length,
 		type_:     type_,
 		value_:    value,
 	}
@@ -63,6 +67,8 @@ func (c *tokenClass_) MakeWithAttributes(
 type token_ struct {
 	line_     int
 	position_ int
+	offset_   int
+	length_   int // The number of runes in the source, which may differ from the value.
 	type_     TokenType
 	value_    string
 }
@@ -77,6 +83,14 @@ func (v *token_) GetPosition() int {
 	return v.position_
 }
 
+func (v *token_) GetOffset() int {
+	return v.offset_
+}
+
+func (v *token_) GetLength() int {
+	return v.length_
+}
+
 func (v *token_) GetType() TokenType {
 	return v.type_
 }
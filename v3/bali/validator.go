@@ -12,7 +12,13 @@
 
 package bali
 
-import ()
+import (
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v3/collection"
+	mat "math"
+	stc "strconv"
+	sts "strings"
+)
 
 // CLASS ACCESS
 
@@ -39,9 +45,7 @@ type validatorClass_ struct {
 // Constructors
 
 func (c *validatorClass_) Make() ValidatorLike {
-	return &validator_{
-		// TBA - Initialize private instance attributes.
-	}
+	return &validator_{}
 }
 
 // INSTANCE METHODS
@@ -49,13 +53,582 @@ func (c *validatorClass_) Make() ValidatorLike {
 // Target
 
 type validator_ struct {
-	// TBA - Add private instance attributes.
+	loops_      int                         // The depth of the enclosing loops.
+	variables_  map[string]bool             // The variables defined so far.
+	violations_ col.ListLike[ViolationLike] // The violations found so far.
 }
 
 // Public
 
-func (v *validator_) ValidateDocument(document DocumentLike) {
-	// TBA - Add method implementation.
+func (v *validator_) ValidateDocument(
+	document DocumentLike,
+) col.ListLike[ViolationLike] {
+	v.loops_ = 0
+	v.variables_ = make(map[string]bool)
+	v.violations_ = col.List[ViolationLike]().Make()
+	v.validateComponent(document.GetComponent())
+	return v.violations_
 }
 
 // Private
+
+/*
+This private instance method records a violation of a semantic rule at the
+specified location in the source code.
+*/
+func (v *validator_) appendViolation(span SpanLike, message string) {
+	var violation = Violation().MakeWithAttributes(span, message)
+	v.violations_.AppendValue(violation)
+}
+
+/*
+This private instance method defines the variable that is named by the specified
+symbol so that subsequent statements may refer to it.
+*/
+func (v *validator_) defineVariable(symbol string) {
+	v.variables_[sts.TrimPrefix(symbol, "$")] = true
+}
+
+func (v *validator_) validateArguments(arguments ArgumentsLike) {
+	if arguments == nil {
+		return
+	}
+	var iterator = arguments.GetArguments().GetIterator()
+	for iterator.HasNext() {
+		v.validateExpression(iterator.GetNext().GetExpression())
+	}
+}
+
+func (v *validator_) validateAssociations(associations AssociationsLike) {
+	var keys = make(map[string]bool)
+	var iterator = associations.GetAssociations().GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var _, key = primitiveValue(association.GetKey().GetPrimitive())
+		if keys[key] {
+			var message = fmt.Sprintf(
+				"The key %v appears more than once in the same collection.",
+				key,
+			)
+			v.appendViolation(association.GetSpan(), message)
+		}
+		keys[key] = true
+		v.validateComponent(association.GetValue().GetComponent())
+	}
+}
+
+func (v *validator_) validateAttribute(attribute AttributeLike) {
+	var identifier = attribute.GetVariable().GetIdentifier()
+	if !v.variables_[identifier] {
+		var message = fmt.Sprintf(
+			"The attribute indexes an undefined variable: %v",
+			identifier,
+		)
+		v.appendViolation(attribute.GetSpan(), message)
+	}
+	v.validateIndices(attribute.GetIndices())
+}
+
+func (v *validator_) validateComponent(component ComponentLike) {
+	var entity = component.GetEntity()
+	switch {
+	case entity.GetRange() != nil:
+		v.validateRange(entity.GetRange())
+	case entity.GetCollection() != nil:
+		var collection = entity.GetCollection()
+		if collection.GetAssociations() != nil {
+			v.validateAssociations(collection.GetAssociations())
+		} else {
+			var iterator = collection.GetValues().GetValues().GetIterator()
+			for iterator.HasNext() {
+				v.validateComponent(iterator.GetNext().GetComponent())
+			}
+		}
+	case entity.GetProcedure() != nil:
+		v.validateProcedure(entity.GetProcedure())
+	}
+	var context = component.GetContext()
+	if context != nil {
+		v.validateParameters(context.GetParameters())
+	}
+}
+
+func (v *validator_) validateExpression(expression ExpressionLike) {
+	switch {
+	case expression.GetComponent() != nil:
+		v.validateComponent(expression.GetComponent())
+	case expression.GetIntrinsic() != nil:
+		v.validateArguments(expression.GetIntrinsic().GetArguments())
+	case expression.GetPrecedence() != nil:
+		v.validateExpression(expression.GetPrecedence().GetExpression())
+	case expression.GetDereference() != nil:
+		v.validateExpression(expression.GetDereference().GetExpression())
+	case expression.GetInvocation() != nil:
+		var invocation = expression.GetInvocation()
+		v.validateExpression(invocation.GetTarget().GetExpression())
+		v.validateArguments(invocation.GetArguments())
+	case expression.GetSubcomponent() != nil:
+		var subcomponent = expression.GetSubcomponent()
+		v.validateExpression(subcomponent.GetComposite().GetExpression())
+		v.validateIndices(subcomponent.GetIndices())
+	case expression.GetChaining() != nil:
+		v.validateExpressions(expression.GetChaining().GetExpressions())
+	case expression.GetExponential() != nil:
+		v.validateExpressions(expression.GetExponential().GetExpressions())
+	case expression.GetInversion() != nil:
+		v.validateExpression(expression.GetInversion().GetExpression())
+	case expression.GetArithmetic() != nil:
+		v.validateExpressions(expression.GetArithmetic().GetExpressions())
+	case expression.GetMagnitude() != nil:
+		v.validateExpression(expression.GetMagnitude().GetExpression())
+	case expression.GetComparison() != nil:
+		v.validateExpressions(expression.GetComparison().GetExpressions())
+	case expression.GetComplement() != nil:
+		v.validateExpression(expression.GetComplement().GetExpression())
+	case expression.GetLogical() != nil:
+		v.validateExpressions(expression.GetLogical().GetExpressions())
+	}
+}
+
+func (v *validator_) validateExpressions(expressions col.ListLike[ExpressionLike]) {
+	var iterator = expressions.GetIterator()
+	for iterator.HasNext() {
+		v.validateExpression(iterator.GetNext())
+	}
+}
+
+func (v *validator_) validateFlow(flow FlowLike) {
+	switch {
+	case flow.GetIfClause() != nil:
+		var ifClause = flow.GetIfClause()
+		v.validateExpression(ifClause.GetCondition().GetExpression())
+		v.validateLines(ifClause.GetProcedure().GetLines())
+	case flow.GetSelectClause() != nil:
+		var selectClause = flow.GetSelectClause()
+		v.validateExpression(selectClause.GetTarget().GetExpression())
+		v.validateMatchings(selectClause.GetMatchings())
+	case flow.GetWhileClause() != nil:
+		var whileClause = flow.GetWhileClause()
+		v.validateExpression(whileClause.GetCondition().GetExpression())
+		v.loops_++
+		v.validateLines(whileClause.GetProcedure().GetLines())
+		v.loops_--
+	case flow.GetWithClause() != nil:
+		var withClause = flow.GetWithClause()
+		v.validateExpression(withClause.GetSequence().GetExpression())
+		v.defineVariable(withClause.GetItem().GetSymbol())
+		v.loops_++
+		v.validateLines(withClause.GetProcedure().GetLines())
+		v.loops_--
+	case flow.GetContinueClause() != nil:
+		if v.loops_ == 0 {
+			var message = "A continue loop clause must be inside a while or with each loop."
+			v.appendViolation(flow.GetContinueClause().GetSpan(), message)
+		}
+	case flow.GetBreakClause() != nil:
+		if v.loops_ == 0 {
+			var message = "A break loop clause must be inside a while or with each loop."
+			v.appendViolation(flow.GetBreakClause().GetSpan(), message)
+		}
+	case flow.GetReturnClause() != nil:
+		v.validateExpression(flow.GetReturnClause().GetResult().GetExpression())
+	case flow.GetThrowClause() != nil:
+		v.validateExpression(flow.GetThrowClause().GetException().GetExpression())
+	}
+}
+
+func (v *validator_) validateIndices(indices IndicesLike) {
+	var iterator = indices.GetIndexs().GetIterator()
+	for iterator.HasNext() {
+		v.validateExpression(iterator.GetNext().GetExpression())
+	}
+}
+
+func (v *validator_) validateLines(lines LinesLike) {
+	var iterator = lines.GetLines().GetIterator()
+	for iterator.HasNext() {
+		var statement = iterator.GetNext().GetStatement()
+		if statement != nil {
+			v.validateStatement(statement)
+		}
+	}
+}
+
+func (v *validator_) validateMainClause(mainClause MainClauseLike) {
+	switch {
+	case mainClause.GetFlow() != nil:
+		v.validateFlow(mainClause.GetFlow())
+	case mainClause.GetAssignment() != nil:
+		var letClause = mainClause.GetAssignment().GetLetClause()
+		v.validateExpression(letClause.GetExpression())
+		var recipient = letClause.GetRecipient()
+		if recipient != nil {
+			v.validateRecipient(recipient)
+		}
+	case mainClause.GetMessaging() != nil:
+		v.validateMessaging(mainClause.GetMessaging())
+	case mainClause.GetRepository() != nil:
+		v.validateRepository(mainClause.GetRepository())
+	}
+}
+
+func (v *validator_) validateMatchings(matchings col.ListLike[MatchingLike]) {
+	var iterator = matchings.GetIterator()
+	for iterator.HasNext() {
+		var matching = iterator.GetNext()
+		v.validateExpression(matching.GetTemplate().GetExpression())
+		v.validateLines(matching.GetProcedure().GetLines())
+	}
+}
+
+func (v *validator_) validateMessaging(messaging MessagingLike) {
+	switch {
+	case messaging.GetPostClause() != nil:
+		var postClause = messaging.GetPostClause()
+		v.validateExpression(postClause.GetMessage().GetExpression())
+		v.validateExpression(postClause.GetBag().GetExpression())
+	case messaging.GetRetrieveClause() != nil:
+		var retrieveClause = messaging.GetRetrieveClause()
+		v.validateExpression(retrieveClause.GetBag().GetExpression())
+		v.validateRecipient(retrieveClause.GetRecipient())
+	case messaging.GetAcceptClause() != nil:
+		var acceptClause = messaging.GetAcceptClause()
+		v.validateExpression(acceptClause.GetMessage().GetExpression())
+	case messaging.GetRejectClause() != nil:
+		var rejectClause = messaging.GetRejectClause()
+		v.validateExpression(rejectClause.GetMessage().GetExpression())
+	case messaging.GetPublishClause() != nil:
+		var publishClause = messaging.GetPublishClause()
+		v.validateExpression(publishClause.GetEvent().GetExpression())
+	}
+}
+
+func (v *validator_) validateParameters(parameters ParametersLike) {
+	var symbols = make(map[string]bool)
+	var iterator = parameters.GetParameters().GetIterator()
+	for iterator.HasNext() {
+		var parameter = iterator.GetNext()
+		var symbol = parameter.GetSymbol()
+		if symbols[symbol] {
+			var message = fmt.Sprintf(
+				"The parameter %v appears more than once in the same context.",
+				symbol,
+			)
+			v.appendViolation(parameter.GetSpan(), message)
+		}
+		symbols[symbol] = true
+		v.validateComponent(parameter.GetComponent())
+	}
+}
+
+/*
+This private instance method validates a procedure that is defined as a
+component.  Its statements run in their own frame so any enclosing loops and
+variables do not apply to it.
+*/
+func (v *validator_) validateProcedure(procedure ProcedureLike) {
+	var loops = v.loops_
+	var variables = v.variables_
+	v.loops_ = 0
+	v.variables_ = make(map[string]bool)
+	v.validateLines(procedure.GetLines())
+	v.loops_ = loops
+	v.variables_ = variables
+}
+
+func (v *validator_) validateRange(range_ RangeLike) {
+	var primitives = range_.GetPrimitives()
+	var firstType, first = primitiveValue(primitives.GetValue(1))
+	var lastType, last = primitiveValue(primitives.GetValue(2))
+	if firstType != lastType {
+		var message = fmt.Sprintf(
+			"The range endpoints %v and %v are not of the same type.",
+			first,
+			last,
+		)
+		v.appendViolation(range_.GetSpan(), message)
+		return
+	}
+	var comparison, ok = compareEndpoints(firstType, first, last)
+	if ok && comparison > 0 {
+		var message = fmt.Sprintf(
+			"The first range endpoint %v is greater than the last endpoint %v.",
+			first,
+			last,
+		)
+		v.appendViolation(range_.GetSpan(), message)
+	}
+}
+
+/*
+This private instance method validates a recipient of a value.  A symbol defines
+a new variable but an attribute must index a variable that is already defined.
+*/
+func (v *validator_) validateRecipient(recipient RecipientLike) {
+	var attribute = recipient.GetAttribute()
+	if attribute != nil {
+		v.validateAttribute(attribute)
+		return
+	}
+	v.defineVariable(recipient.GetSymbol())
+}
+
+func (v *validator_) validateRepository(repository RepositoryLike) {
+	switch {
+	case repository.GetCheckoutClause() != nil:
+		var checkoutClause = repository.GetCheckoutClause()
+		var level = checkoutClause.GetLevel()
+		if level != nil {
+			v.validateExpression(level.GetExpression())
+		}
+		v.validateExpression(checkoutClause.GetCitation().GetExpression())
+		v.validateRecipient(checkoutClause.GetRecipient())
+	case repository.GetSaveClause() != nil:
+		var saveClause = repository.GetSaveClause()
+		v.validateExpression(saveClause.GetDraft().GetExpression())
+		v.validateExpression(saveClause.GetCitation().GetExpression())
+	case repository.GetDiscardClause() != nil:
+		var discardClause = repository.GetDiscardClause()
+		v.validateExpression(discardClause.GetDraft().GetExpression())
+	case repository.GetNotarizeClause() != nil:
+		var notarizeClause = repository.GetNotarizeClause()
+		v.validateExpression(notarizeClause.GetDraft().GetExpression())
+		v.validateExpression(notarizeClause.GetCitation().GetExpression())
+	}
+}
+
+func (v *validator_) validateStatement(statement StatementLike) {
+	v.validateMainClause(statement.GetMainClause())
+	var onClause = statement.GetOnClause()
+	if onClause != nil {
+		v.defineVariable(onClause.GetFailure().GetSymbol())
+		v.validateMatchings(onClause.GetMatchings())
+	}
+}
+
+/*
+This private function compares the values of two range endpoints of the
+specified type.  It returns false if values of that type have no natural
+ordering (or cannot be compared).
+*/
+func compareEndpoints(type_, first, last string) (comparison int, ok bool) {
+	switch type_ {
+	case "angle":
+		return compareReals(sts.TrimPrefix(first, "~"), sts.TrimPrefix(last, "~"))
+	case "number":
+		return compareReals(first, last)
+	case "percentage":
+		return compareReals(sts.TrimSuffix(first, "%"), sts.TrimSuffix(last, "%"))
+	case "probability":
+		return compareReals(first, last)
+	case "duration":
+		var firstDays, firstOk = durationDays(first)
+		var lastDays, lastOk = durationDays(last)
+		return compareFloats(firstDays, lastDays), firstOk && lastOk
+	case "moment":
+		return compareTuples(momentFields(first), momentFields(last)), true
+	case "version":
+		return compareTuples(versionFields(first), versionFields(last)), true
+	case "boolean", "name", "quote", "resource", "symbol", "tag":
+		return sts.Compare(first, last), true
+	default:
+		// Binary strings, bytecode, narratives and patterns are unordered.
+		return 0, false
+	}
+}
+
+func compareFloats(first, last float64) int {
+	switch {
+	case first < last:
+		return -1
+	case first > last:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareReals(first, last string) (comparison int, ok bool) {
+	var firstReal, firstOk = realValue(first)
+	var lastReal, lastOk = realValue(last)
+	return compareFloats(firstReal, lastReal), firstOk && lastOk
+}
+
+func compareTuples(first, last []float64) int {
+	for index := 0; index < len(first) && index < len(last); index++ {
+		var comparison = compareFloats(first[index], last[index])
+		if comparison != 0 {
+			return comparison
+		}
+	}
+	return compareFloats(float64(len(first)), float64(len(last)))
+}
+
+/*
+This private function returns the approximate number of days in the specified
+duration (e.g. "~P1Y2M3DT4H5M6.7S").  Years and months use their average
+lengths in the Gregorian calendar.
+*/
+func durationDays(duration string) (days float64, ok bool) {
+	var sign = 1.0
+	duration = sts.TrimPrefix(duration, "~")
+	switch {
+	case sts.HasPrefix(duration, "-"):
+		sign = -1.0
+		duration = duration[1:]
+	case sts.HasPrefix(duration, "+"):
+		duration = duration[1:]
+	}
+	var isTime bool
+	var timespan string
+	for _, character := range sts.TrimPrefix(duration, "P") {
+		var scale float64
+		switch {
+		case character == 'T':
+			isTime = true
+			continue
+		case character == 'W':
+			scale = 7.0
+		case character == 'Y':
+			scale = 365.2425
+		case character == 'M' && !isTime:
+			scale = 365.2425 / 12.0
+		case character == 'D':
+			scale = 1.0
+		case character == 'H':
+			scale = 1.0 / 24.0
+		case character == 'M':
+			scale = 1.0 / 1440.0
+		case character == 'S':
+			scale = 1.0 / 86400.0
+		default:
+			timespan += string(character)
+			continue
+		}
+		var value, err = stc.ParseFloat(timespan, 64)
+		if err != nil {
+			return 0, false
+		}
+		days += value * scale
+		timespan = ""
+	}
+	return sign * days, true
+}
+
+/*
+This private function returns the numeric fields of the specified moment (e.g.
+"<-2000-04-01T10:30:15.5>") from the year down to the second.  Missing fields
+take the earliest possible value.
+*/
+func momentFields(moment string) []float64 {
+	moment = sts.Trim(moment, "<>")
+	var sign = 1.0
+	switch {
+	case sts.HasPrefix(moment, "-"):
+		sign = -1.0
+		moment = moment[1:]
+	case sts.HasPrefix(moment, "+"):
+		moment = moment[1:]
+	}
+	var fields = []float64{0, 1, 1, 0, 0, 0}
+	var values = sts.FieldsFunc(moment, func(character rune) bool {
+		return character == '-' || character == 'T' || character == ':'
+	})
+	for index := 0; index < len(values) && index < len(fields); index++ {
+		fields[index], _ = stc.ParseFloat(values[index], 64)
+	}
+	fields[0] *= sign
+	return fields
+}
+
+/*
+This private function returns the type and canonical text of the specified
+primitive value.
+*/
+func primitiveValue(primitive PrimitiveLike) (type_ string, value string) {
+	var element = primitive.GetElement()
+	if element != nil {
+		switch {
+		case len(element.GetAngle()) > 0:
+			return "angle", element.GetAngle()
+		case len(element.GetBoolean()) > 0:
+			return "boolean", element.GetBoolean()
+		case len(element.GetDuration()) > 0:
+			return "duration", element.GetDuration()
+		case len(element.GetMoment()) > 0:
+			return "moment", element.GetMoment()
+		case len(element.GetNumber()) > 0:
+			return "number", element.GetNumber()
+		case len(element.GetPattern()) > 0:
+			return "pattern", element.GetPattern()
+		case len(element.GetPercentage()) > 0:
+			return "percentage", element.GetPercentage()
+		case len(element.GetProbability()) > 0:
+			return "probability", element.GetProbability()
+		case len(element.GetResource()) > 0:
+			return "resource", element.GetResource()
+		}
+	}
+	var string_ = primitive.GetString()
+	switch {
+	case len(string_.GetBinary()) > 0:
+		return "binary", string_.GetBinary()
+	case len(string_.GetBytecode()) > 0:
+		return "bytecode", string_.GetBytecode()
+	case len(string_.GetName()) > 0:
+		return "name", string_.GetName()
+	case len(string_.GetNarrative()) > 0:
+		return "narrative", string_.GetNarrative()
+	case len(string_.GetQuote()) > 0:
+		return "quote", string_.GetQuote()
+	case len(string_.GetSymbol()) > 0:
+		return "symbol", string_.GetSymbol()
+	case len(string_.GetTag()) > 0:
+		return "tag", string_.GetTag()
+	default:
+		return "version", string_.GetVersion()
+	}
+}
+
+/*
+This private function returns the value of the specified real number.  Complex
+and undefined numbers have no real value.
+*/
+func realValue(real string) (value float64, ok bool) {
+	var sign = 1.0
+	switch {
+	case sts.HasPrefix(real, "-"):
+		sign = -1.0
+		real = real[1:]
+	case sts.HasPrefix(real, "+"):
+		real = real[1:]
+	}
+	switch real {
+	case "∞", "infinity":
+		value = mat.Inf(1)
+	case "e":
+		value = mat.E
+	case "pi", "π":
+		value = mat.Pi
+	case "phi", "φ":
+		value = mat.Phi
+	case "tau", "τ":
+		value = 2.0 * mat.Pi
+	default:
+		var err error
+		value, err = stc.ParseFloat(real, 64)
+		if err != nil {
+			return 0, false
+		}
+	}
+	return sign * value, true
+}
+
+func versionFields(version string) []float64 {
+	var fields []float64
+	for _, ordinal := range sts.Split(sts.TrimPrefix(version, "v"), ".") {
+		var field, _ = stc.ParseFloat(ordinal, 64)
+		fields = append(fields, field)
+	}
+	return fields
+}
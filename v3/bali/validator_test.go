@@ -0,0 +1,113 @@
+/*
+................................................................................
+.                   Copyright (c) 2024.  All Rights Reserved.                  .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package bali_test
+
+import (
+	bal "github.com/bali-nebula/go-component-framework/v3/bali"
+	ass "github.com/stretchr/testify/assert"
+	tes "testing"
+)
+
+func validateSource(source string) []bal.ViolationLike {
+	var document = bal.Parser().Make().ParseSource(header + source)
+	return bal.Validator().Make().ValidateDocument(document).AsArray()
+}
+
+func TestValidDocuments(t *tes.T) {
+	for filename, source := range readDocuments() {
+		var violations = validateSource(source)
+		ass.Equal(t, 0, len(violations), filename)
+	}
+}
+
+func TestValidateLoops(t *tes.T) {
+	var violations = validateSource(`{
+    while true do {
+        if done do {
+            break loop
+        }
+    }
+    with each $item in items do {
+        select $item matching none do {
+            continue loop
+        }
+    }
+    break loop
+    let $procedure := {
+        continue loop
+    }
+}
+`)
+	ass.Equal(t, 2, len(violations))
+	var span = violations[0].GetSpan()
+	ass.Equal(t, 18, span.GetStartLine())
+	ass.Equal(t, 5, span.GetStartPosition())
+	ass.Equal(t, 18, span.GetEndLine())
+	ass.Equal(t, 15, span.GetEndPosition())
+	ass.Equal(t, "20:9", violations[1].GetSpan().AsString())
+}
+
+func TestValidateDuplicates(t *tes.T) {
+	var violations = validateSource(`[
+    $first: 1
+    $second: 2 ($type: $Number, $units: $none, $type: $Integer)
+    $first: 3
+]
+`)
+	ass.Equal(t, 2, len(violations))
+	ass.Equal(t, "9:48", violations[0].GetSpan().AsString())
+	ass.Equal(t, "10:5", violations[1].GetSpan().AsString())
+	ass.Equal(t, 14, violations[1].GetSpan().GetEndPosition())
+}
+
+func TestValidateRanges(t *tes.T) {
+	var violations = validateSource(`[
+    [1..5]
+    [5..1)
+    [1.."five"]
+    [<2024-04-01>..<2024-03-31>]
+    [~P1D..~PT25H]
+    [~P1W..~P6D]
+    [v1.10..v1.9]
+    [-∞..π]
+    [(1, 2i)..5]
+    ["none"?.."any"?]
+]
+`)
+	ass.Equal(t, 5, len(violations))
+	ass.Equal(t, "9:5", violations[0].GetSpan().AsString())
+	ass.Equal(t, "10:5", violations[1].GetSpan().AsString())
+	ass.Equal(t, "11:5", violations[2].GetSpan().AsString())
+	ass.Equal(t, "13:5", violations[3].GetSpan().AsString())
+	ass.Equal(t, "14:5", violations[4].GetSpan().AsString())
+}
+
+func TestValidateRecipients(t *tes.T) {
+	var violations = validateSource(`{
+    let list[1] := 5
+    let $list := [ ]
+    let list[1] := 5
+    retrieve queue[$next] from bag
+    with each $item in list do {
+        checkout item[1] from /bali/drafts/Draft
+    }
+    throw $error on $exception matching any do {
+        let exception[$message] := "failed"
+    }
+}
+`)
+	ass.Equal(t, 2, len(violations))
+	ass.Equal(t, "8:9", violations[0].GetSpan().AsString())
+	ass.Equal(t, "11:14", violations[1].GetSpan().AsString())
+	ass.Equal(t, 26, violations[1].GetSpan().GetEndPosition())
+}
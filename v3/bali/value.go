@@ -40,9 +40,13 @@ type valueClass_ struct {
 
 // Constructors
 
-func (c *valueClass_) MakeWithComponent(component ComponentLike) ValueLike {
+func (c *valueClass_) MakeWithAttributes(
+	component ComponentLike,
+	note string,
+) ValueLike {
 	return &value_{
 		component_: component,
+		note_:      note,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *valueClass_) MakeWithComponent(component ComponentLike) ValueLike {
 
 type value_ struct {
 	component_ ComponentLike
+	note_      string
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *value_) GetComponent() ComponentLike {
 	return v.component_
 }
 
+func (v *value_) GetNote() string {
+	return v.note_
+}
+
 // Public
 
 // Private
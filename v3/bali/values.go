@@ -48,16 +48,6 @@ func (c *valuesClass_) MakeWithValues(values col.ListLike[ValueLike]) ValuesLike
 	}
 }
 
-func (c *valuesClass_) MakeWithAttributes(
-	value ValueLike,
-	note string,
-) ValuesLike {
-	return &values_{
-		value_: value,
-		note_:  note,
-	}
-}
-
 // Functions
 
 // INSTANCE METHODS
@@ -66,8 +56,6 @@ func (c *valuesClass_) MakeWithAttributes(
 
 type values_ struct {
 	values_ col.ListLike[ValueLike]
-	note_   string
-	value_  ValueLike
 }
 
 // Attributes
@@ -76,10 +64,6 @@ func (v *values_) GetValues() col.ListLike[ValueLike] {
 	return v.values_
 }
 
-func (v *values_) GetNote() string {
-	return v.note_
-}
-
 // Public
 
 // Private
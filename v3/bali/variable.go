@@ -40,9 +40,13 @@ type variableClass_ struct {
 
 // Constructors
 
-func (c *variableClass_) MakeWithIdentifier(identifier string) VariableLike {
+func (c *variableClass_) MakeWithAttributes(
+	identifier string,
+	span SpanLike,
+) VariableLike {
 	return &variable_{
 		identifier_: identifier,
+		span_:       span,
 	}
 }
 
@@ -54,6 +58,7 @@ func (c *variableClass_) MakeWithIdentifier(identifier string) VariableLike {
 
 type variable_ struct {
 	identifier_ string
+	span_       SpanLike
 }
 
 // Attributes
@@ -62,6 +67,10 @@ func (v *variable_) GetIdentifier() string {
 	return v.identifier_
 }
 
+func (v *variable_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
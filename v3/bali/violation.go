@@ -0,0 +1,80 @@
+/*
+................................................................................
+.                   Copyright (c) 2024.  All Rights Reserved.                  .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package bali
+
+import ()
+
+// CLASS ACCESS
+
+// Reference
+
+var violationClass = &violationClass_{
+	// This class has no private constants to initialize.
+}
+
+// Function
+
+func Violation() ViolationClassLike {
+	return violationClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type violationClass_ struct {
+	// This class has no private constants.
+}
+
+// Constants
+
+// Constructors
+
+func (c *violationClass_) MakeWithAttributes(
+	span SpanLike,
+	message string,
+) ViolationLike {
+	return &violation_{
+		span_:    span,
+		message_: message,
+	}
+}
+
+// Functions
+
+// INSTANCE METHODS
+
+// Target
+
+type violation_ struct {
+	span_    SpanLike
+	message_ string
+}
+
+// Attributes
+
+func (v *violation_) GetSpan() SpanLike {
+	return v.span_
+}
+
+func (v *violation_) GetMessage() string {
+	return v.message_
+}
+
+// Public
+
+func (v *violation_) AsString() string {
+	return v.span_.AsString() + ": " + v.message_
+}
+
+// Private
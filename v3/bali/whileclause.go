@@ -43,10 +43,12 @@ type whileClauseClass_ struct {
 func (c *whileClauseClass_) MakeWithAttributes(
 	condition ConditionLike,
 	procedure ProcedureLike,
+	span SpanLike,
 ) WhileClauseLike {
 	return &whileClause_{
 		condition_: condition,
 		procedure_: procedure,
+		span_:      span,
 	}
 }
 
@@ -59,6 +61,7 @@ func (c *whileClauseClass_) MakeWithAttributes(
 type whileClause_ struct {
 	condition_ ConditionLike
 	procedure_ ProcedureLike
+	span_      SpanLike
 }
 
 // Attributes
@@ -71,6 +74,10 @@ func (v *whileClause_) GetProcedure() ProcedureLike {
 	return v.procedure_
 }
 
+func (v *whileClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private
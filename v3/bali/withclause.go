@@ -44,11 +44,13 @@ func (c *withClauseClass_) MakeWithAttributes(
 	item ItemLike,
 	sequence SequenceLike,
 	procedure ProcedureLike,
+	span SpanLike,
 ) WithClauseLike {
 	return &withClause_{
 		item_:      item,
 		sequence_:  sequence,
 		procedure_: procedure,
+		span_:      span,
 	}
 }
 
@@ -62,6 +64,7 @@ type withClause_ struct {
 	item_      ItemLike
 	sequence_  SequenceLike
 	procedure_ ProcedureLike
+	span_      SpanLike
 }
 
 // Attributes
@@ -78,6 +81,10 @@ func (v *withClause_) GetProcedure() ProcedureLike {
 	return v.procedure_
 }
 
+func (v *withClause_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Public
 
 // Private